@@ -119,6 +119,9 @@ type BindPlane interface {
 	// Agent TODO(doc)
 	Agent(ctx context.Context, id string) (*model.Agent, error)
 	DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error)
+	// AgentDuplicates returns the agents that were assigned a new ID because they connected using the ID of another
+	// agent. If agentID is not empty, only the duplicates of that agent are returned.
+	AgentDuplicates(ctx context.Context, agentID string) ([]*model.Agent, error)
 
 	// Configurations TODO(doc)
 	Configurations(ctx context.Context) ([]*model.Configuration, error)
@@ -128,6 +131,8 @@ type BindPlane interface {
 	DeleteConfiguration(ctx context.Context, name string) error
 	// RawConfiguration TODO(doc)
 	RawConfiguration(ctx context.Context, name string) (string, error)
+	// ApplyFlowControl replaces the flow control of a source or destination in the configuration with the specified name
+	ApplyFlowControl(ctx context.Context, configuration string, kind model.Kind, name string, flowControl *model.FlowControl) (*model.FlowControlResponse, error)
 
 	Sources(ctx context.Context) ([]*model.Source, error)
 	Source(ctx context.Context, name string) (*model.Source, error)
@@ -153,6 +158,18 @@ type BindPlane interface {
 	DestinationType(ctx context.Context, name string) (*model.DestinationType, error)
 	DeleteDestinationType(ctx context.Context, name string) error
 
+	ConfigurationTemplates(ctx context.Context) ([]*model.ConfigurationTemplate, error)
+	ConfigurationTemplate(ctx context.Context, name string) (*model.ConfigurationTemplate, error)
+	DeleteConfigurationTemplate(ctx context.Context, name string) error
+
+	ConfigurationInstances(ctx context.Context) ([]*model.ConfigurationInstance, error)
+	ConfigurationInstance(ctx context.Context, name string) (*model.ConfigurationInstance, error)
+	DeleteConfigurationInstance(ctx context.Context, name string) error
+
+	LabelRules(ctx context.Context) ([]*model.LabelRule, error)
+	LabelRule(ctx context.Context, name string) (*model.LabelRule, error)
+	DeleteLabelRule(ctx context.Context, name string) error
+
 	// Apply TODO(doc)
 	Apply(ctx context.Context, r []*model.AnyResource) ([]*model.AnyResourceStatus, error)
 	// Delete TODO(doc)
@@ -171,6 +188,15 @@ type BindPlane interface {
 	// ApplyAgentLabels applies the specified labels to an agent, merging the specified labels with the existing labels
 	// and returning the labels of the agent
 	ApplyAgentLabels(ctx context.Context, id string, labels *model.Labels, override bool) (*model.Labels, error)
+
+	// AgentAdmission returns the admission control status of agent connections on the server
+	AgentAdmission(ctx context.Context) (*model.AgentAdmissionResponse, error)
+	// DrainAgents rejects new agent connections and asks connected agents to reconnect gradually over the duration
+	DrainAgents(ctx context.Context, duration time.Duration) (*model.AgentAdmissionResponse, error)
+	// CancelDrain cancels a drain started with DrainAgents
+	CancelDrain(ctx context.Context) (*model.AgentAdmissionResponse, error)
+	// MigrateAgents offers new connection settings to the connected agents matching the selector in the request
+	MigrateAgents(ctx context.Context, request *model.PostMigrateRequest) (*model.MigrateResponse, error)
 }
 
 type bindplaneClient struct {
@@ -249,6 +275,16 @@ func (c *bindplaneClient) DeleteAgents(ctx context.Context, ids []string) ([]*mo
 	return result.Agents, c.statusError(resp, err, "unable to delete agents")
 }
 
+// AgentDuplicates returns the agents that were assigned a new ID because they connected using the ID of another agent
+func (c *bindplaneClient) AgentDuplicates(ctx context.Context, agentID string) ([]*model.Agent, error) {
+	result := &model.AgentDuplicatesResponse{}
+	resp, err := c.client.R().
+		SetResult(result).
+		SetQueryParam("id", agentID).
+		Get("/agents/duplicates")
+	return result.Agents, c.statusError(resp, err, "unable to get duplicate agents")
+}
+
 // Configurations TODO(doc)
 func (c *bindplaneClient) Configurations(ctx context.Context) ([]*model.Configuration, error) {
 	c.Debug("Configurations called")
@@ -281,6 +317,35 @@ func (c *bindplaneClient) RawConfiguration(ctx context.Context, name string) (st
 	return result.Raw, err
 }
 
+// ApplyFlowControl replaces the flow control of a source or destination in the configuration with the specified name
+func (c *bindplaneClient) ApplyFlowControl(ctx context.Context, configuration string, kind model.Kind, name string, flowControl *model.FlowControl) (*model.FlowControlResponse, error) {
+	var components string
+	switch kind {
+	case model.KindSource:
+		components = "sources"
+	case model.KindDestination:
+		components = "destinations"
+	default:
+		return nil, fmt.Errorf("flow control is only supported for sources and destinations, not %s", kind)
+	}
+
+	result := &model.FlowControlResponse{}
+	errorResponse := &rest.ErrorResponse{}
+	endpoint := fmt.Sprintf("/configurations/%s/%s/%s/flow-control", configuration, components, name)
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBody(flowControl).
+		SetResult(result).
+		SetError(errorResponse).
+		Put(endpoint)
+
+	err = c.statusError(resp, err, "unable to apply flow control")
+	if err != nil && len(errorResponse.Errors) > 0 {
+		return nil, fmt.Errorf("unable to apply flow control: %s", errorResponse.Errors[0])
+	}
+	return result, err
+}
+
 // ----------------------------------------------------------------------
 
 func (c *bindplaneClient) Sources(ctx context.Context) ([]*model.Source, error) {
@@ -391,6 +456,60 @@ func (c *bindplaneClient) DeleteDestinationType(ctx context.Context, name string
 
 // ----------------------------------------------------------------------
 
+func (c *bindplaneClient) ConfigurationTemplates(ctx context.Context) ([]*model.ConfigurationTemplate, error) {
+	result := model.ConfigurationTemplatesResponse{}
+	err := c.resources(ctx, "/configuration-templates", &result)
+	return result.ConfigurationTemplates, err
+}
+
+func (c *bindplaneClient) ConfigurationTemplate(ctx context.Context, name string) (*model.ConfigurationTemplate, error) {
+	result := model.ConfigurationTemplateResponse{}
+	err := c.resource(ctx, "/configuration-templates", name, &result)
+	return result.ConfigurationTemplate, err
+}
+
+func (c *bindplaneClient) DeleteConfigurationTemplate(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/configuration-templates", name)
+}
+
+// ----------------------------------------------------------------------
+
+func (c *bindplaneClient) ConfigurationInstances(ctx context.Context) ([]*model.ConfigurationInstance, error) {
+	result := model.ConfigurationInstancesResponse{}
+	err := c.resources(ctx, "/configuration-instances", &result)
+	return result.ConfigurationInstances, err
+}
+
+func (c *bindplaneClient) ConfigurationInstance(ctx context.Context, name string) (*model.ConfigurationInstance, error) {
+	result := model.ConfigurationInstanceResponse{}
+	err := c.resource(ctx, "/configuration-instances", name, &result)
+	return result.ConfigurationInstance, err
+}
+
+func (c *bindplaneClient) DeleteConfigurationInstance(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/configuration-instances", name)
+}
+
+// ----------------------------------------------------------------------
+
+func (c *bindplaneClient) LabelRules(ctx context.Context) ([]*model.LabelRule, error) {
+	result := model.LabelRulesResponse{}
+	err := c.resources(ctx, "/label-rules", &result)
+	return result.LabelRules, err
+}
+
+func (c *bindplaneClient) LabelRule(ctx context.Context, name string) (*model.LabelRule, error) {
+	result := model.LabelRuleResponse{}
+	err := c.resource(ctx, "/label-rules", name, &result)
+	return result.LabelRule, err
+}
+
+func (c *bindplaneClient) DeleteLabelRule(ctx context.Context, name string) error {
+	return c.deleteResource(ctx, "/label-rules", name)
+}
+
+// ----------------------------------------------------------------------
+
 // Apply TODO(doc)
 func (c *bindplaneClient) Apply(ctx context.Context, resources []*model.AnyResource) ([]*model.AnyResourceStatus, error) {
 	c.Debug("Apply called")
@@ -546,6 +665,43 @@ func (c *bindplaneClient) ApplyAgentLabels(ctx context.Context, id string, label
 	return response.Labels, err
 }
 
+// AgentAdmission returns the admission control status of agent connections on the server
+func (c *bindplaneClient) AgentAdmission(ctx context.Context) (*model.AgentAdmissionResponse, error) {
+	c.Debug("AgentAdmission called")
+
+	result := &model.AgentAdmissionResponse{}
+	resp, err := c.client.R().SetContext(ctx).SetResult(result).Get("/opamp/admission")
+	return result, c.statusError(resp, err, "unable to get agent admission")
+}
+
+// DrainAgents rejects new agent connections and asks connected agents to reconnect gradually over the duration
+func (c *bindplaneClient) DrainAgents(ctx context.Context, duration time.Duration) (*model.AgentAdmissionResponse, error) {
+	c.Debug("DrainAgents called")
+
+	body := &model.PostDrainRequest{Duration: duration.String()}
+	result := &model.AgentAdmissionResponse{}
+	resp, err := c.client.R().SetContext(ctx).SetBody(body).SetResult(result).Post("/opamp/drain")
+	return result, c.statusError(resp, err, "unable to drain agents")
+}
+
+// CancelDrain cancels a drain started with DrainAgents
+func (c *bindplaneClient) CancelDrain(ctx context.Context) (*model.AgentAdmissionResponse, error) {
+	c.Debug("CancelDrain called")
+
+	result := &model.AgentAdmissionResponse{}
+	resp, err := c.client.R().SetContext(ctx).SetResult(result).Delete("/opamp/drain")
+	return result, c.statusError(resp, err, "unable to cancel drain")
+}
+
+// MigrateAgents offers new connection settings to the connected agents matching the selector in the request
+func (c *bindplaneClient) MigrateAgents(ctx context.Context, request *model.PostMigrateRequest) (*model.MigrateResponse, error) {
+	c.Debug("MigrateAgents called")
+
+	result := &model.MigrateResponse{}
+	resp, err := c.client.R().SetContext(ctx).SetBody(request).SetResult(result).Post("/opamp/migrate")
+	return result, c.statusError(resp, err, "unable to migrate agents")
+}
+
 // ----------------------------------------------------------------------
 
 // resources gets the resources from the REST server and stores them in the provided result.
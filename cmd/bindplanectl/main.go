@@ -21,12 +21,16 @@ import (
 	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/cli"
 	"github.com/observiq/bindplane-op/internal/cli/commands"
+	"github.com/observiq/bindplane-op/internal/cli/commands/agents"
 	"github.com/observiq/bindplane-op/internal/cli/commands/apply"
 	"github.com/observiq/bindplane-op/internal/cli/commands/delete"
+	"github.com/observiq/bindplane-op/internal/cli/commands/drain"
 	"github.com/observiq/bindplane-op/internal/cli/commands/get"
+	"github.com/observiq/bindplane-op/internal/cli/commands/importcmd"
 	"github.com/observiq/bindplane-op/internal/cli/commands/initialize"
 	"github.com/observiq/bindplane-op/internal/cli/commands/install"
 	"github.com/observiq/bindplane-op/internal/cli/commands/label"
+	"github.com/observiq/bindplane-op/internal/cli/commands/pause"
 	"github.com/observiq/bindplane-op/internal/cli/commands/profile"
 	"github.com/observiq/bindplane-op/internal/cli/commands/validate"
 	"github.com/observiq/bindplane-op/internal/cli/commands/version"
@@ -56,7 +60,12 @@ func main() {
 	rootCmd.AddCommand(
 		apply.Command(bindplane),
 		get.Command(bindplane),
+		importcmd.Command(bindplane),
+		drain.Command(bindplane),
+		agents.Command(bindplane),
 		label.Command(bindplane),
+		pause.Command(bindplane),
+		pause.ResumeCommand(bindplane),
 		delete.Command(bindplane),
 		profile.Command(h),
 		version.Command(bindplane),
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 )
 
 const (
@@ -35,6 +36,23 @@ const (
 	DefaultProfileName = "default"
 )
 
+const (
+	// DefaultAgentCleanupInterval is the default interval of the job that removes disconnected agents
+	DefaultAgentCleanupInterval = time.Minute
+	// DefaultAgentCleanupTTL is the default time that a disconnected agent is kept before it is removed
+	DefaultAgentCleanupTTL = 15 * time.Minute
+	// DefaultAgentHeartbeatInterval is the default interval of the heartbeat sent to agents to keep the websocket live
+	DefaultAgentHeartbeatInterval = 30 * time.Second
+	// DefaultAgentStatusFlushInterval is the default interval at which buffered agent status changes are written to the
+	// store
+	DefaultAgentStatusFlushInterval = time.Second
+	// DefaultAgentAdmissionRetryAfter is the default minimum time that agents rejected by admission control wait before
+	// reconnecting
+	DefaultAgentAdmissionRetryAfter = 10 * time.Second
+	// DefaultAgentAdmissionJitter is the default maximum random time added to the retry time of rejected agents
+	DefaultAgentAdmissionJitter = 20 * time.Second
+)
+
 // LogOutput is an enum of possible values for the LogOutput configuration setting
 type LogOutput string
 
@@ -182,9 +200,88 @@ type Server struct {
 	// SessionSecret is used to encode the user sessions cookies.  It should be a uuid.
 	SessionsSecret string `mapstructure:"sessionsSecret,omitempty" yaml:"sessionsSecret,omitempty"`
 
+	// EncryptionKey is used to encrypt the values of secret parameters before they are stored. If it is not specified,
+	// SessionsSecret is used.
+	EncryptionKey string `mapstructure:"encryptionKey,omitempty" yaml:"encryptionKey,omitempty"`
+
+	// PreviousEncryptionKeys are keys that were previously used to encrypt secret parameters. Secrets encrypted with
+	// these keys can still be decrypted and are re-encrypted with EncryptionKey when the server starts.
+	PreviousEncryptionKeys []string `mapstructure:"previousEncryptionKeys,omitempty" yaml:"previousEncryptionKeys,omitempty"`
+
+	// PreviousSecretKeys are secret keys that are still accepted from agents while they are offered the SecretKey, e.g.
+	// with bindplane agents migrate --secret-key
+	PreviousSecretKeys []string `mapstructure:"previousSecretKeys,omitempty" yaml:"previousSecretKeys,omitempty"`
+
+	// AgentCleanup configures the background job that removes agents that have been disconnected longer than their TTL
+	AgentCleanup AgentCleanup `mapstructure:"agentCleanup,omitempty" yaml:"agentCleanup,omitempty"`
+
+	// AgentHeartbeatInterval is the interval of the heartbeat sent to connected agents, defaulting to 30s
+	AgentHeartbeatInterval time.Duration `mapstructure:"agentHeartbeatInterval,omitempty" yaml:"agentHeartbeatInterval,omitempty"`
+
+	// AgentStatusFlushInterval is the interval at which buffered agent status changes are written to the store,
+	// defaulting to 1s. Disconnects and errors are always written immediately.
+	AgentStatusFlushInterval time.Duration `mapstructure:"agentStatusFlushInterval,omitempty" yaml:"agentStatusFlushInterval,omitempty"`
+
+	// AgentAdmission limits the agent connections accepted at the same time, e.g. when every agent reconnects after a
+	// restart
+	AgentAdmission AgentAdmission `mapstructure:"agentAdmission,omitempty" yaml:"agentAdmission,omitempty"`
+
+	// SecretProviders enables references to secrets stored on the server, e.g. ${bindplane:env:NAME}, in the values of
+	// secret parameters. References are resolved when configurations are rendered for agents.
+	SecretProviders SecretProviders `mapstructure:"secretProviders,omitempty" yaml:"secretProviders,omitempty"`
+
 	Common `yaml:",inline" mapstructure:",squash"`
 }
 
+// SecretProviders configures the secrets on the server that can be referenced by secret parameters. Each provider is
+// disabled unless prefixes are specified because anyone who can apply resources could otherwise send any environment
+// variable or file readable by the server to an agent.
+type SecretProviders struct {
+	// EnvPrefixes are the prefixes of the names of environment variables that can be referenced with
+	// ${bindplane:env:NAME}, e.g. BINDPLANE_SECRET_
+	EnvPrefixes []string `mapstructure:"envPrefixes,omitempty" yaml:"envPrefixes,omitempty"`
+
+	// PathPrefixes are the directories containing files that can be referenced with ${bindplane:file:/path}, e.g.
+	// /etc/bindplane/secrets
+	PathPrefixes []string `mapstructure:"pathPrefixes,omitempty" yaml:"pathPrefixes,omitempty"`
+}
+
+// AgentCleanup configures the background job that removes disconnected agents. When multiple servers share a store,
+// the job only runs on the server elected as leader.
+type AgentCleanup struct {
+	// Interval is the interval between runs of the job, defaulting to 1m
+	Interval time.Duration `mapstructure:"interval,omitempty" yaml:"interval,omitempty"`
+
+	// TTL is the time that a disconnected agent is kept before it is removed, defaulting to 15m
+	TTL time.Duration `mapstructure:"ttl,omitempty" yaml:"ttl,omitempty"`
+
+	// Retention overrides the TTL for agents matching a selector. The first policy matching an agent is used.
+	Retention []AgentRetention `mapstructure:"retention,omitempty" yaml:"retention,omitempty"`
+}
+
+// AgentRetention is the TTL of disconnected agents matching a label selector, e.g. env=prod
+type AgentRetention struct {
+	Selector string        `mapstructure:"selector" yaml:"selector"`
+	TTL      time.Duration `mapstructure:"ttl" yaml:"ttl"`
+}
+
+// AgentAdmission configures the admission control of agent connections. Agents are rejected with 429 Too Many Requests
+// when the rate is exceeded or 503 Service Unavailable when too many connections are pending and asked to retry after
+// RetryAfter plus a random jitter.
+type AgentAdmission struct {
+	// MaxPending is the maximum number of agent connections being established at the same time, 0 is unlimited
+	MaxPending int `mapstructure:"maxPending,omitempty" yaml:"maxPending,omitempty"`
+
+	// Rate is the maximum number of new agent connections accepted per second, 0 is unlimited
+	Rate float64 `mapstructure:"rate,omitempty" yaml:"rate,omitempty"`
+
+	// RetryAfter is the minimum time that rejected agents wait before reconnecting, defaulting to 10s
+	RetryAfter time.Duration `mapstructure:"retryAfter,omitempty" yaml:"retryAfter,omitempty"`
+
+	// Jitter is the maximum random time added to RetryAfter to spread out reconnects, defaulting to 20s
+	Jitter time.Duration `mapstructure:"jitter,omitempty" yaml:"jitter,omitempty"`
+}
+
 // GoogleCloudDatastore contains the configuration for google cloud datastore
 type GoogleCloudDatastore struct {
 	ProjectID       string `mapstructure:"projectID,omitempty" yaml:"projectID,omitempty"`
@@ -255,6 +352,15 @@ func (c *Server) WebsocketURL() string {
 	return fmt.Sprintf("%s://%s:%s", c.WebsocketScheme(), c.Host, c.Port)
 }
 
+// SecretEncryptionKey returns the key used to encrypt the values of secret parameters, falling back to SessionsSecret
+// if EncryptionKey is not specified
+func (c *Server) SecretEncryptionKey() string {
+	if c.EncryptionKey != "" {
+		return c.EncryptionKey
+	}
+	return c.SessionsSecret
+}
+
 // BoltDatabasePath returns the path to the bolt database file
 func (c *Server) BoltDatabasePath() string {
 	if c.StorageFilePath != "" {
@@ -263,6 +369,58 @@ func (c *Server) BoltDatabasePath() string {
 	return path.Join(c.BindPlaneHomePath(), BoldDatabaseName)
 }
 
+// AgentCleanupInterval returns the interval of the agent cleanup job, defaulting to DefaultAgentCleanupInterval
+func (c *Server) AgentCleanupInterval() time.Duration {
+	if c.AgentCleanup.Interval > 0 {
+		return c.AgentCleanup.Interval
+	}
+	return DefaultAgentCleanupInterval
+}
+
+// AgentCleanupTTL returns the time that disconnected agents not matching a retention policy are kept, defaulting to
+// DefaultAgentCleanupTTL
+func (c *Server) AgentCleanupTTL() time.Duration {
+	if c.AgentCleanup.TTL > 0 {
+		return c.AgentCleanup.TTL
+	}
+	return DefaultAgentCleanupTTL
+}
+
+// AgentHeartbeat returns the interval of the heartbeat sent to agents, defaulting to DefaultAgentHeartbeatInterval
+func (c *Server) AgentHeartbeat() time.Duration {
+	if c.AgentHeartbeatInterval > 0 {
+		return c.AgentHeartbeatInterval
+	}
+	return DefaultAgentHeartbeatInterval
+}
+
+// AgentStatusFlush returns the interval at which buffered agent status changes are written to the store, defaulting to
+// DefaultAgentStatusFlushInterval
+func (c *Server) AgentStatusFlush() time.Duration {
+	if c.AgentStatusFlushInterval > 0 {
+		return c.AgentStatusFlushInterval
+	}
+	return DefaultAgentStatusFlushInterval
+}
+
+// AgentAdmissionRetryAfter returns the minimum time that agents rejected by admission control wait before
+// reconnecting, defaulting to DefaultAgentAdmissionRetryAfter
+func (c *Server) AgentAdmissionRetryAfter() time.Duration {
+	if c.AgentAdmission.RetryAfter > 0 {
+		return c.AgentAdmission.RetryAfter
+	}
+	return DefaultAgentAdmissionRetryAfter
+}
+
+// AgentAdmissionJitter returns the maximum random time added to the retry time of rejected agents, defaulting to
+// DefaultAgentAdmissionJitter
+func (c *Server) AgentAdmissionJitter() time.Duration {
+	if c.AgentAdmission.Jitter > 0 {
+		return c.AgentAdmission.Jitter
+	}
+	return DefaultAgentAdmissionJitter
+}
+
 // BindPlaneDownloadsPath returns the path to the directory where downloads are cached
 func (c *Server) BindPlaneDownloadsPath() string {
 	if c.DownloadsFolderPath != "" {
@@ -16,6 +16,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -315,3 +316,33 @@ func TestServerScheme(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentJobIntervals(t *testing.T) {
+	server := &Server{}
+	require.Equal(t, DefaultAgentCleanupInterval, server.AgentCleanupInterval())
+	require.Equal(t, DefaultAgentCleanupTTL, server.AgentCleanupTTL())
+	require.Equal(t, DefaultAgentHeartbeatInterval, server.AgentHeartbeat())
+	require.Equal(t, DefaultAgentStatusFlushInterval, server.AgentStatusFlush())
+
+	server = &Server{
+		AgentCleanup:             AgentCleanup{Interval: 5 * time.Minute, TTL: time.Hour},
+		AgentHeartbeatInterval:   10 * time.Second,
+		AgentStatusFlushInterval: 5 * time.Second,
+	}
+	require.Equal(t, 5*time.Minute, server.AgentCleanupInterval())
+	require.Equal(t, time.Hour, server.AgentCleanupTTL())
+	require.Equal(t, 10*time.Second, server.AgentHeartbeat())
+	require.Equal(t, 5*time.Second, server.AgentStatusFlush())
+}
+
+func TestAgentAdmissionRetry(t *testing.T) {
+	server := &Server{}
+	require.Equal(t, DefaultAgentAdmissionRetryAfter, server.AgentAdmissionRetryAfter())
+	require.Equal(t, DefaultAgentAdmissionJitter, server.AgentAdmissionJitter())
+
+	server = &Server{
+		AgentAdmission: AgentAdmission{RetryAfter: time.Minute, Jitter: 5 * time.Second},
+	}
+	require.Equal(t, time.Minute, server.AgentAdmissionRetryAfter())
+	require.Equal(t, 5*time.Second, server.AgentAdmissionJitter())
+}
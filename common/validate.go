@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -71,6 +72,26 @@ func (s *Server) validate() (errGroup error) {
 		}
 	}
 
+	if err := s.AgentCleanup.validate(); err != nil {
+		errGroup = multierror.Append(errGroup, err)
+	}
+
+	if s.AgentHeartbeatInterval < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent heartbeat interval must not be negative"))
+	}
+
+	if s.AgentStatusFlushInterval < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent status flush interval must not be negative"))
+	}
+
+	if err := s.AgentAdmission.validate(); err != nil {
+		errGroup = multierror.Append(errGroup, err)
+	}
+
+	if err := s.SecretProviders.validate(); err != nil {
+		errGroup = multierror.Append(errGroup, err)
+	}
+
 	if err := s.Common.validate(); err != nil {
 		errGroup = multierror.Append(errGroup, err)
 	}
@@ -78,6 +99,54 @@ func (s *Server) validate() (errGroup error) {
 	return errGroup
 }
 
+func (a *AgentCleanup) validate() (errGroup error) {
+	if a.Interval < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent cleanup interval must not be negative"))
+	}
+	if a.TTL < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent cleanup ttl must not be negative"))
+	}
+	for i, retention := range a.Retention {
+		if retention.Selector == "" {
+			errGroup = multierror.Append(errGroup, fmt.Errorf("agent retention %d must specify a selector", i))
+		}
+		if retention.TTL <= 0 {
+			errGroup = multierror.Append(errGroup, fmt.Errorf("agent retention %d must specify a positive ttl", i))
+		}
+	}
+	return errGroup
+}
+
+func (a *AgentAdmission) validate() (errGroup error) {
+	if a.MaxPending < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent admission max pending must not be negative"))
+	}
+	if a.Rate < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent admission rate must not be negative"))
+	}
+	if a.RetryAfter < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent admission retry after must not be negative"))
+	}
+	if a.Jitter < 0 {
+		errGroup = multierror.Append(errGroup, errors.New("agent admission jitter must not be negative"))
+	}
+	return errGroup
+}
+
+func (p *SecretProviders) validate() (errGroup error) {
+	for _, prefix := range p.EnvPrefixes {
+		if prefix == "" {
+			errGroup = multierror.Append(errGroup, errors.New("secret provider env prefixes must not be empty"))
+		}
+	}
+	for _, path := range p.PathPrefixes {
+		if !filepath.IsAbs(path) {
+			errGroup = multierror.Append(errGroup, fmt.Errorf("secret provider path prefix %s must be an absolute path", path))
+		}
+	}
+	return errGroup
+}
+
 func (c *Client) validate() (errGroup error) {
 	return c.Common.validate()
 }
@@ -16,6 +16,7 @@ package common
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -301,6 +302,40 @@ func TestValidate(t *testing.T) {
 			},
 			"failed to validate secret key: invalid UUID ",
 		},
+		{
+			"valid-secret-providers",
+			Config{
+				Server: Server{
+					SecretProviders: SecretProviders{
+						EnvPrefixes:  []string{"BINDPLANE_SECRET_"},
+						PathPrefixes: []string{"/etc/bindplane/secrets"},
+					},
+				},
+			},
+			"",
+		},
+		{
+			"invalid-secret-providers-env-prefix",
+			Config{
+				Server: Server{
+					SecretProviders: SecretProviders{
+						EnvPrefixes: []string{""},
+					},
+				},
+			},
+			"secret provider env prefixes must not be empty",
+		},
+		{
+			"invalid-secret-providers-path-prefix",
+			Config{
+				Server: Server{
+					SecretProviders: SecretProviders{
+						PathPrefixes: []string{"secrets"},
+					},
+				},
+			},
+			"secret provider path prefix secrets must be an absolute path",
+		},
 		{
 			"invalid-remote-url",
 			Config{
@@ -497,6 +532,56 @@ func TestValidate(t *testing.T) {
 			},
 			"failed to validate agents service url ws://github.com:3000: scheme ws is invalid: valid schemes are [http https]",
 		},
+		{
+			"valid-agent-retention",
+			Config{
+				Server: Server{
+					AgentCleanup: AgentCleanup{
+						TTL:       time.Hour,
+						Retention: []AgentRetention{{Selector: "env=prod", TTL: 30 * 24 * time.Hour}},
+					},
+				},
+			},
+			"",
+		},
+		{
+			"invalid-agent-retention",
+			Config{
+				Server: Server{
+					AgentCleanup: AgentCleanup{
+						Retention: []AgentRetention{{Selector: "env=ci"}},
+					},
+				},
+			},
+			"agent retention 0 must specify a positive ttl",
+		},
+		{
+			"negative-agent-cleanup-interval",
+			Config{
+				Server: Server{
+					AgentCleanup: AgentCleanup{Interval: -time.Minute},
+				},
+			},
+			"agent cleanup interval must not be negative",
+		},
+		{
+			"negative-agent-admission-rate",
+			Config{
+				Server: Server{
+					AgentAdmission: AgentAdmission{MaxPending: 100, Rate: -1},
+				},
+			},
+			"agent admission rate must not be negative",
+		},
+		{
+			"negative-agent-status-flush-interval",
+			Config{
+				Server: Server{
+					AgentStatusFlushInterval: -time.Second,
+				},
+			},
+			"agent status flush interval must not be negative",
+		},
 	}
 
 	for _, tc := range cases {
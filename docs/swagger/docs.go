@@ -142,6 +142,37 @@ const docTemplate = `{
                 }
             }
         },
+        "/agents/duplicates": {
+            "get": {
+                "description": "Agents cloned from the same VM image report the same ID. Each duplicate is assigned a new ID and records the original ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List agents that were assigned a new ID because they connected using the ID of another agent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "only list the duplicates of the agent with this id",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentDuplicatesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/agents/labels": {
             "patch": {
                 "produces": [
@@ -438,17 +469,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/configurations": {
+        "/configuration-instances": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "List Configurations",
+                "summary": "List configuration instances",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ConfigurationsResponse"
+                            "$ref": "#/definitions/model.ConfigurationInstancesResponse"
                         }
                     },
                     "500": {
@@ -460,16 +491,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/configurations/{name}": {
+        "/configuration-instances/{name}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get configuration by name",
+                "summary": "Get configuration instance by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the configuration",
+                        "description": "the name of the configuration instance",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -479,37 +510,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.ConfigurationResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.ConfigurationInstanceResponse"
                         }
-                    }
-                }
-            },
-            "delete": {
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Delete configuration by name",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "the name of the configuration to delete",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "Successful Delete, no content"
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
@@ -521,84 +526,24 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/configurations/{name}/duplicate": {
-            "post": {
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Duplicate an existing configuration",
+                "summary": "Delete configuration instance by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the configuration to duplicate",
+                        "description": "the name of the configuration instance to delete",
                         "name": "name",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "the desired name of the duplicate configuration",
-                        "name": "name",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Successful Duplication, created"
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/delete": {
-            "post": {
-                "description": "/delete endpoint will try to parse resources\nand delete them from the store.  Additionally\nit will send reconfigure tasks to affected agents.",
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Delete multiple resources",
-                "parameters": [
-                    {
-                        "description": "Resources",
-                        "name": "resources",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.AnyResource"
-                            }
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.DeleteResponse"
-                        }
+                    "204": {
+                        "description": "Successful Delete, no content"
                     },
                     "404": {
                         "description": "Not Found",
@@ -615,17 +560,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/destination-types": {
+        "/configuration-templates": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "List destination types",
+                "summary": "List configuration templates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationTypesResponse"
+                            "$ref": "#/definitions/model.ConfigurationTemplatesResponse"
                         }
                     },
                     "500": {
@@ -637,16 +582,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/destination-types/{name}": {
+        "/configuration-templates/{name}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get destination type by name",
+                "summary": "Get configuration template by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination type",
+                        "description": "the name of the configuration template",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -656,7 +601,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationTypeResponse"
+                            "$ref": "#/definitions/model.ConfigurationTemplateResponse"
                         }
                     },
                     "401": {
@@ -677,11 +622,11 @@ const docTemplate = `{
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete destination type by name",
+                "summary": "Delete configuration template by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination type to delete",
+                        "description": "the name of the configuration template to delete",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -697,6 +642,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -706,17 +657,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/destinations": {
+        "/configurations": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "List destinations",
+                "summary": "List Configurations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationsResponse"
+                            "$ref": "#/definitions/model.ConfigurationsResponse"
                         }
                     },
                     "500": {
@@ -728,32 +679,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/destinations/{name}": {
+        "/configurations/{name}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get destination by name",
+                "summary": "Get configuration by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination",
+                        "description": "the name of the configuration",
                         "name": "name",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "render the configuration for agents on this platform, e.g. windows",
+                        "name": "platform",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.DestinationResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/rest.ErrorResponse"
+                            "$ref": "#/definitions/model.ConfigurationResponse"
                         }
                     },
                     "500": {
@@ -768,11 +719,11 @@ const docTemplate = `{
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Delete destination by name",
+                "summary": "Delete configuration by name",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the destination to delete",
+                        "description": "the name of the configuration to delete",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -797,83 +748,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/downloads/{agent}/{version}/{platform}/{type}/{file}": {
-            "get": {
-                "description": "Get the agent download with the specified parameters",
+        "/configurations/{name}/destinations/{component}/flow-control": {
+            "put": {
+                "description": "Pauses or samples the telemetry flowing through a source or destination without\napplying the entire configuration. Inline sources and destinations are identified by their position,\ne.g. source0 or destination1.",
                 "produces": [
-                    "application/octet-stream"
+                    "application/json"
                 ],
-                "summary": "Get Agent Download",
+                "summary": "Replace the flow control of a source or destination in a configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "observiq-agent",
-                        "name": "agent",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "2.1.1",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "darwin-arm64",
-                        "name": "platform",
+                        "description": "the name of the configuration",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "installer",
-                        "name": "type",
+                        "description": "the name of the source or destination",
+                        "name": "component",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "observiq-agent-installer.sh",
-                        "name": "file",
-                        "in": "path",
-                        "required": true
+                        "description": "the flow control to apply",
+                        "name": "flowControl",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FlowControl"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "file"
+                            "$ref": "#/definitions/model.FlowControlResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/rest.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/processor-types": {
-            "get": {
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "List processor types",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/model.ProcessorTypesResponse"
+                            "$ref": "#/definitions/rest.ErrorResponse"
                         }
                     },
                     "500": {
@@ -885,27 +814,651 @@ const docTemplate = `{
                 }
             }
         },
-        "/processor-types/{name}": {
-            "get": {
+        "/configurations/{name}/duplicate": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get processor type by name",
+                "summary": "Duplicate an existing configuration",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "the name of the processor type",
+                        "description": "the name of the configuration to duplicate",
                         "name": "name",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.ProcessorTypeResponse"
-                        }
+                    },
+                    {
+                        "description": "the desired name of the duplicate configuration",
+                        "name": "name",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful Duplication, created"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/configurations/{name}/sources/{component}/flow-control": {
+            "put": {
+                "description": "Pauses or samples the telemetry flowing through a source or destination without\napplying the entire configuration. Inline sources and destinations are identified by their position,\ne.g. source0 or destination1.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Replace the flow control of a source or destination in a configuration",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the configuration",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of the source or destination",
+                        "name": "component",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "the flow control to apply",
+                        "name": "flowControl",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.FlowControl"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.FlowControlResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/delete": {
+            "post": {
+                "description": "/delete endpoint will try to parse resources\nand delete them from the store.  Additionally\nit will send reconfigure tasks to affected agents.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete multiple resources",
+                "parameters": [
+                    {
+                        "description": "Resources",
+                        "name": "resources",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.AnyResource"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DeleteResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List destination types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationTypesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destination-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get destination type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationTypeResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete destination type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination type to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List destinations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/destinations/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get destination by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.DestinationResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete destination by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the destination to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/downloads/{agent}/{version}/{platform}/{type}/{file}": {
+            "get": {
+                "description": "Get the agent download with the specified parameters",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "summary": "Get Agent Download",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "observiq-agent",
+                        "name": "agent",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "2.1.1",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "darwin-arm64",
+                        "name": "platform",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "installer",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "observiq-agent-installer.sh",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/label-rules": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List label rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LabelRulesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/label-rules/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get label rule by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the label rule",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.LabelRuleResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Labels set by the rule are not removed from agents.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete label rule by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the label rule to delete",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful Delete, no content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/opamp/admission": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get the admission control status of agent connections on this server",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentAdmissionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/opamp/drain": {
+            "post": {
+                "description": "New agent connections are rejected and connected agents are asked to reconnect gradually over the duration.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Drain agent connections from this server before maintenance",
+                "parameters": [
+                    {
+                        "description": "drain duration",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PostDrainRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentAdmissionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Cancel the drain of agent connections from this server",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.AgentAdmissionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/opamp/migrate": {
+            "post": {
+                "description": "Connected agents matching the selector, or all connected agents if all is set, are sent the OpAMP connection settings, e.g. to move them to another server or rotate the secret key. The settings are accepted when the agent connects using them.",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Offer new connection settings to agents connected to this server",
+                "parameters": [
+                    {
+                        "description": "connection settings",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.PostMigrateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.MigrateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List processor types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorTypesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/rest.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/processor-types/{name}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get processor type by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the name of the processor type",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.ProcessorTypeResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -1252,15 +1805,27 @@ const docTemplate = `{
                 "arch": {
                     "type": "string"
                 },
+                "attributes": {
+                    "description": "Attributes are all of the attributes reported in the agent description",
+                    "$ref": "#/definitions/model.AgentAttributes"
+                },
                 "configuration": {
                     "description": "tracked by BindPlane"
                 },
                 "connectedAt": {
                     "type": "string"
                 },
+                "connectionSettings": {
+                    "description": "ConnectionSettings are the connection settings most recently offered to the agent, e.g. to move it to another\nserver or rotate the secret key",
+                    "$ref": "#/definitions/model.AgentConnectionSettings"
+                },
                 "disconnectedAt": {
                     "type": "string"
                 },
+                "duplicate": {
+                    "description": "Duplicate is set if the agent connected using the ID of another agent and was assigned a new ID",
+                    "$ref": "#/definitions/model.AgentDuplicate"
+                },
                 "errorMessage": {
                     "type": "string"
                 },
@@ -1308,6 +1873,117 @@ const docTemplate = `{
                 }
             }
         },
+        "model.AgentAdmissionResponse": {
+            "type": "object",
+            "properties": {
+                "admitted": {
+                    "description": "Admitted is the number of connections admitted since the server started",
+                    "type": "integer"
+                },
+                "asked": {
+                    "description": "Asked is the number of connected agents that have been asked to reconnect by the current drain",
+                    "type": "integer"
+                },
+                "drainEnds": {
+                    "description": "DrainEnds is the time when all connected agents will have been asked to reconnect",
+                    "type": "string"
+                },
+                "draining": {
+                    "description": "Draining is true if agents are being asked to reconnect to another server",
+                    "type": "boolean"
+                },
+                "maxPending": {
+                    "description": "MaxPending is the maximum number of pending connections, 0 is unlimited",
+                    "type": "integer"
+                },
+                "pending": {
+                    "description": "Pending is the number of agent connections being established",
+                    "type": "integer"
+                },
+                "rate": {
+                    "description": "Rate is the maximum number of new connections accepted per second, 0 is unlimited",
+                    "type": "number"
+                },
+                "rejected": {
+                    "description": "Rejected is the number of connections rejected since the server started by reason: rate, pending, or draining",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "model.AgentAttributes": {
+            "type": "object",
+            "properties": {
+                "identifying": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "nonIdentifying": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.AgentConnectionSettings": {
+            "type": "object",
+            "properties": {
+                "acceptedAt": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "type": "string"
+                },
+                "hash": {
+                    "description": "Hash identifies the offered endpoint and header values",
+                    "type": "string"
+                },
+                "headers": {
+                    "description": "Headers are the names of the offered headers",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "offeredAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentDuplicate": {
+            "type": "object",
+            "properties": {
+                "detectedAt": {
+                    "type": "string"
+                },
+                "originalId": {
+                    "description": "OriginalID is the ID used by the agent before it was assigned a new ID",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.AgentDuplicatesResponse": {
+            "type": "object",
+            "properties": {
+                "agents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Agent"
+                    }
+                }
+            }
+        },
         "model.AgentLabelsPayload": {
             "type": "object",
             "properties": {
@@ -1342,7 +2018,15 @@ const docTemplate = `{
             }
         },
         "model.AgentSelector": {
-            "type": "object"
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.MatchExpression"
+                    }
+                }
+            }
         },
         "model.AgentsResponse": {
             "type": "object",
@@ -1413,39 +2097,213 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ConfigurationInstance": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.ConfigurationInstanceSpec"
+                }
+            }
+        },
+        "model.ConfigurationInstanceResponse": {
+            "type": "object",
+            "properties": {
+                "configurationInstance": {
+                    "$ref": "#/definitions/model.ConfigurationInstance"
+                }
+            }
+        },
+        "model.ConfigurationInstanceSpec": {
+            "type": "object",
+            "properties": {
+                "parameters": {
+                    "description": "Parameters are the values of the parameters of the template",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Parameter"
+                    }
+                },
+                "template": {
+                    "description": "Template is the name of the ConfigurationTemplate",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ConfigurationInstancesResponse": {
+            "type": "object",
+            "properties": {
+                "configurationInstances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ConfigurationInstance"
+                    }
+                }
+            }
+        },
+        "model.ConfigurationRemovals": {
+            "type": "object",
+            "properties": {
+                "destinations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "processors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.ConfigurationResponse": {
             "type": "object",
             "properties": {
                 "configuration": {
                     "$ref": "#/definitions/model.Configuration"
                 },
+                "overrides": {
+                    "description": "Overrides are the parameter overrides of the configuration that apply to the agent for GET\n/v1/agents/:id/configuration",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterOverride"
+                    }
+                },
+                "raw": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.ConfigurationSpec": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "destinations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceConfiguration"
+                    }
+                },
+                "extends": {
+                    "description": "Extends lists the names of base configurations whose sources, destinations, processors, routes, and overrides are\ninherited by this configuration",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fragment": {
+                    "description": "Fragment is raw OpenTelemetry configuration with receivers, processors, exporters, extensions, and pipelines that\nare merged into the configuration generated from the sources and destinations. Pipelines in the fragment can\nreference generated components by their ids. Fragments are not inherited by configurations that extend this one.",
+                    "type": "string"
+                },
+                "overrides": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterOverride"
+                    }
+                },
+                "processors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceConfiguration"
+                    }
+                },
                 "raw": {
                     "type": "string"
+                },
+                "remove": {
+                    "description": "Remove lists the inherited sources, destinations, and processors that are not included in this configuration",
+                    "$ref": "#/definitions/model.ConfigurationRemovals"
+                },
+                "replace": {
+                    "description": "Replace replaces the values of parameters of inherited sources and destinations",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.InheritedParameters"
+                    }
+                },
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Route"
+                    }
+                },
+                "selector": {
+                    "$ref": "#/definitions/model.AgentSelector"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ResourceConfiguration"
+                    }
+                }
+            }
+        },
+        "model.ConfigurationTemplate": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.ConfigurationTemplateSpec"
+                }
+            }
+        },
+        "model.ConfigurationTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "configurationTemplate": {
+                    "$ref": "#/definitions/model.ConfigurationTemplate"
+                }
+            }
+        },
+        "model.ConfigurationTemplateSpec": {
+            "type": "object",
+            "properties": {
+                "configuration": {
+                    "description": "Configuration is the spec of the Configurations generated by the template",
+                    "$ref": "#/definitions/model.ConfigurationSpec"
+                },
+                "parameters": {
+                    "description": "Parameters are the parameters that are specified by each ConfigurationInstance",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterDefinition"
+                    }
                 }
             }
         },
-        "model.ConfigurationSpec": {
+        "model.ConfigurationTemplatesResponse": {
             "type": "object",
             "properties": {
-                "contentType": {
-                    "type": "string"
-                },
-                "destinations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.ResourceConfiguration"
-                    }
-                },
-                "raw": {
-                    "type": "string"
-                },
-                "selector": {
-                    "$ref": "#/definitions/model.AgentSelector"
-                },
-                "sources": {
+                "configurationTemplates": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/model.ResourceConfiguration"
+                        "$ref": "#/definitions/model.ConfigurationTemplate"
                     }
                 }
             }
@@ -1562,6 +2420,47 @@ const docTemplate = `{
                 }
             }
         },
+        "model.FlowControl": {
+            "type": "object",
+            "properties": {
+                "paused": {
+                    "description": "Paused drops all telemetry flowing through the source or destination",
+                    "type": "boolean"
+                },
+                "samplingPercentage": {
+                    "description": "SamplingPercentage is the percentage of telemetry to keep, between 0 and 100. If not specified, all telemetry is\nkept.",
+                    "type": "number"
+                }
+            }
+        },
+        "model.FlowControlResponse": {
+            "type": "object",
+            "properties": {
+                "flowControl": {
+                    "$ref": "#/definitions/model.FlowControl"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.InheritedParameters": {
+            "type": "object",
+            "properties": {
+                "destination": {
+                    "type": "string"
+                },
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Parameter"
+                    }
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
         "model.InstallCommandResponse": {
             "type": "object",
             "properties": {
@@ -1570,9 +2469,99 @@ const docTemplate = `{
                 }
             }
         },
+        "model.LabelRule": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/model.Metadata"
+                },
+                "spec": {
+                    "$ref": "#/definitions/model.LabelRuleSpec"
+                }
+            }
+        },
+        "model.LabelRuleMatch": {
+            "type": "object",
+            "properties": {
+                "hostname": {
+                    "description": "HostName is a regular expression matched against the hostname of the agent, e.g. ^web-\\d+$",
+                    "type": "string"
+                },
+                "platform": {
+                    "description": "Platform is the platform of the agent, e.g. linux, windows, or macos",
+                    "type": "string"
+                },
+                "remoteAddress": {
+                    "description": "RemoteAddress is a CIDR matched against the address of the agent connection, e.g. 10.10.0.0/16",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is a range of agent versions, e.g. \"\u003e= 1.8.0, \u003c 2.0.0\"",
+                    "type": "string"
+                }
+            }
+        },
+        "model.LabelRuleResponse": {
+            "type": "object",
+            "properties": {
+                "labelRule": {
+                    "$ref": "#/definitions/model.LabelRule"
+                }
+            }
+        },
+        "model.LabelRuleSpec": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "description": "Labels are set on matching agents, replacing any existing values",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "match": {
+                    "description": "Match determines which agents are labeled. All of the specified conditions must match.",
+                    "$ref": "#/definitions/model.LabelRuleMatch"
+                }
+            }
+        },
+        "model.LabelRulesResponse": {
+            "type": "object",
+            "properties": {
+                "labelRules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.LabelRule"
+                    }
+                }
+            }
+        },
         "model.Labels": {
             "type": "object"
         },
+        "model.MatchExpression": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "operator": {
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.Metadata": {
             "type": "object",
             "properties": {
@@ -1596,6 +2585,25 @@ const docTemplate = `{
                 }
             }
         },
+        "model.MigrateResponse": {
+            "type": "object",
+            "properties": {
+                "offered": {
+                    "description": "Offered are the IDs of the agents sent the connection settings",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "skipped": {
+                    "description": "Skipped is the reason that each selected agent was not sent the connection settings by agent ID",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.Parameter": {
             "type": "object",
             "properties": {
@@ -1607,14 +2615,43 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ParameterConstraints": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "description": "Format is the format of a \"string\" parameter or each value of a \"strings\" parameter. One of \"host:port\",\n\"duration\", or \"url\".",
+                    "type": "string"
+                },
+                "max": {
+                    "description": "Max is the maximum value of an \"int\" or \"float\" parameter",
+                    "type": "number"
+                },
+                "min": {
+                    "description": "Min is the minimum value of an \"int\" or \"float\" parameter",
+                    "type": "number"
+                },
+                "minItems": {
+                    "description": "MinItems is the minimum number of values of a \"strings\" or \"enums\" parameter",
+                    "type": "integer"
+                },
+                "regex": {
+                    "description": "Regex must match the value of a \"string\" parameter or each value of a \"strings\" parameter",
+                    "type": "string"
+                }
+            }
+        },
         "model.ParameterDefinition": {
             "type": "object",
             "properties": {
                 "advancedConfig": {
                     "type": "boolean"
                 },
+                "constraints": {
+                    "description": "Constraints restrict the values of the parameter beyond its Type",
+                    "$ref": "#/definitions/model.ParameterConstraints"
+                },
                 "default": {
-                    "description": "Must be valid according to Type \u0026 ValidValues"
+                    "description": "Must be valid according to Type, ValidValues, \u0026 Constraints"
                 },
                 "description": {
                     "type": "string"
@@ -1628,6 +2665,13 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "properties": {
+                    "description": "Properties describe the fields of each object of an \"objects\" parameter",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ParameterDefinition"
+                    }
+                },
                 "relevantIf": {
                     "type": "array",
                     "items": {
@@ -1638,7 +2682,7 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "type": {
-                    "description": "\"string\", \"int\", \"bool\", \"strings\", or \"enum\"",
+                    "description": "\"string\", \"int\", \"bool\", \"strings\", \"enum\", \"enums\", \"map\", \"yaml\", \"secret\", \"float\", \"duration\", \"timezone\",\n\"text\", or \"objects\"",
                     "type": "string"
                 },
                 "validValues": {
@@ -1650,6 +2694,34 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ParameterOverride": {
+            "type": "object",
+            "properties": {
+                "agentId": {
+                    "description": "AgentID is the ID of the agent that receives the override",
+                    "type": "string"
+                },
+                "destination": {
+                    "description": "Destination is the name of the destination with the parameters to replace",
+                    "type": "string"
+                },
+                "parameters": {
+                    "description": "Parameters are the parameter values used instead of the values in the Configuration",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Parameter"
+                    }
+                },
+                "selector": {
+                    "description": "Selector matches the labels of the agents that receive the override",
+                    "$ref": "#/definitions/model.AgentSelector"
+                },
+                "source": {
+                    "description": "Source is the name of the source with the parameters to replace",
+                    "type": "string"
+                }
+            }
+        },
         "model.ParameterizedSpec": {
             "type": "object",
             "properties": {
@@ -1670,6 +2742,53 @@ const docTemplate = `{
                 }
             }
         },
+        "model.PostDrainRequest": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "description": "Duration is the time over which connected agents are asked to reconnect, e.g. 10m",
+                    "type": "string"
+                }
+            }
+        },
+        "model.PostMigrateRequest": {
+            "type": "object",
+            "properties": {
+                "all": {
+                    "description": "All selects all connected agents and cannot be used with Selector",
+                    "type": "boolean"
+                },
+                "certificate": {
+                    "description": "Certificate, PrivateKey, and CertificateAuthority are the PEM-encoded client certificate used by the agent to\nconnect and the certificate authority that signed it",
+                    "type": "string"
+                },
+                "certificateAuthority": {
+                    "type": "string"
+                },
+                "endpoint": {
+                    "description": "Endpoint is the OpAMP endpoint, e.g. wss://bindplane.example.com/v1/opamp. It defaults to the endpoint of the\nserver handling the request.",
+                    "type": "string"
+                },
+                "headers": {
+                    "description": "Headers are sent by the agent when it connects, e.g. Authorization",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "privateKey": {
+                    "type": "string"
+                },
+                "secretKey": {
+                    "description": "SecretKey is sent by the agent in the Authorization header when it connects",
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "Selector selects the agents, e.g. env=production. It is required unless All is set.",
+                    "type": "string"
+                }
+            }
+        },
         "model.Processor": {
             "type": "object",
             "properties": {
@@ -1750,6 +2869,7 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "operator": {
+                    "description": "\"equals\", \"notEquals\", \"in\", \"notIn\", \"containsAny\", or \"greaterThan\". The value of \"in\", \"notIn\", and\n\"containsAny\" is a list of values.",
                     "type": "string"
                 },
                 "value": {
@@ -1760,6 +2880,9 @@ const docTemplate = `{
         "model.ResourceConfiguration": {
             "type": "object",
             "properties": {
+                "flowControl": {
+                    "$ref": "#/definitions/model.FlowControl"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -1813,6 +2936,35 @@ const docTemplate = `{
                 }
             }
         },
+        "model.ResourceTypePlatformSpec": {
+            "type": "object",
+            "properties": {
+                "logs": {
+                    "description": "individual",
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "logs+metrics": {
+                    "description": "pairs (alphabetical order)",
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "logs+metrics+traces": {
+                    "description": "all three (alphabetical order)",
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "logs+traces": {
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "metrics": {
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "metrics+traces": {
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                },
+                "traces": {
+                    "$ref": "#/definitions/model.ResourceTypeOutput"
+                }
+            }
+        },
         "model.ResourceTypeSpec": {
             "type": "object",
             "properties": {
@@ -1844,6 +2996,13 @@ const docTemplate = `{
                         "$ref": "#/definitions/model.ParameterDefinition"
                     }
                 },
+                "platforms": {
+                    "description": "Platforms overrides the outputs for agents on specific platforms, e.g. to read the event log on windows instead\nof journald on linux. Each template specified for a platform replaces the corresponding template above.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/model.ResourceTypePlatformSpec"
+                    }
+                },
                 "supportedPlatforms": {
                     "type": "array",
                     "items": {
@@ -1858,6 +3017,29 @@ const docTemplate = `{
                 }
             }
         },
+        "model.Route": {
+            "type": "object",
+            "properties": {
+                "destinations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "telemetryTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "model.Source": {
             "type": "object",
             "properties": {
@@ -0,0 +1,190 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package agents provides the agents command which manages the connections of agents to the server.
+package agents
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane agents cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agents",
+		Short: "Manage the connections of agents to the server",
+	}
+	cmd.AddCommand(MigrateCommand(bindplane))
+	return cmd
+}
+
+// MigrateCommand returns the BindPlane agents migrate cobra command.
+func MigrateCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var selectorFlag string
+	var toFlag string
+	var headerFlags []string
+	var secretKeyFlag string
+	var certFlag string
+	var keyFlag string
+	var caFlag string
+	var statusFlag bool
+	var allFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Offer new connection settings to agents",
+		Long: `Connected agents matching the selector are sent new OpAMP connection settings, e.g. to move them to another
+BindPlane server or rotate the secret key. Agents verify the settings by connecting with them before using them. The
+settings are offered to the agents connected to the server handling the request. Use --all instead of --selector to
+offer the settings to every connected agent. Use --status to show the connection settings offered to the agents
+matching the selector.`,
+		Example: `  bindplane agents migrate --selector env=production --to wss://bindplane.example.com/v1/opamp
+  bindplane agents migrate --all --secret-key 5b3f1a0e-3c8e-4f55-9d52-1f2b0c9a7e61
+  bindplane agents migrate --selector env=production --status`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			if statusFlag {
+				agents, err := c.Agents(cmd.Context(), client.WithSelector(selectorFlag))
+				if err != nil {
+					return err
+				}
+				printConnectionSettings(cmd.OutOrStdout(), agents)
+				return nil
+			}
+
+			request, err := migrateRequest(selectorFlag, allFlag, toFlag, headerFlags, secretKeyFlag)
+			if err != nil {
+				return err
+			}
+			if err := readPEM(certFlag, &request.Certificate); err != nil {
+				return err
+			}
+			if err := readPEM(keyFlag, &request.PrivateKey); err != nil {
+				return err
+			}
+			if err := readPEM(caFlag, &request.CertificateAuthority); err != nil {
+				return err
+			}
+
+			response, err := c.MigrateAgents(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			printMigrateResponse(cmd.OutOrStdout(), response)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&selectorFlag, "selector", "", "label selector of the agents, e.g. env=production")
+	cmd.Flags().BoolVar(&allFlag, "all", false, "offer the connection settings to all connected agents instead of the agents matching a selector")
+	cmd.Flags().StringVar(&toFlag, "to", "", "OpAMP endpoint used by the agents, e.g. wss://bindplane.example.com/v1/opamp. Defaults to this server.")
+	cmd.Flags().StringArrayVar(&headerFlags, "header", []string{}, "header sent by the agents when they connect in the form name=value, may be repeated")
+	cmd.Flags().StringVar(&secretKeyFlag, "secret-key", "", "secret key used by the agents when they connect")
+	cmd.Flags().StringVar(&certFlag, "tls-cert", "", "file containing the PEM-encoded client certificate used by the agents")
+	cmd.Flags().StringVar(&keyFlag, "tls-key", "", "file containing the PEM-encoded private key of the client certificate")
+	cmd.Flags().StringVar(&caFlag, "tls-ca", "", "file containing the PEM-encoded certificate authority that signed the client certificate")
+	cmd.Flags().BoolVar(&statusFlag, "status", false, "show the connection settings offered to the agents without sending new settings")
+
+	return cmd
+}
+
+func migrateRequest(selector string, all bool, endpoint string, headers []string, secretKey string) (*model.PostMigrateRequest, error) {
+	switch {
+	case selector == "" && !all:
+		return nil, errors.New("specify agents with --selector or use --all to select all connected agents")
+	case selector != "" && all:
+		return nil, errors.New("--selector and --all cannot be used together")
+	}
+	request := &model.PostMigrateRequest{
+		Selector:  selector,
+		All:       all,
+		Endpoint:  endpoint,
+		SecretKey: secretKey,
+	}
+	for _, header := range headers {
+		name, value, ok := strings.Cut(header, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid header %s, expected name=value", header)
+		}
+		if request.Headers == nil {
+			request.Headers = map[string]string{}
+		}
+		request.Headers[name] = value
+	}
+	return request, nil
+}
+
+// readPEM reads the file into value if a file is specified
+func readPEM(file string, value *string) error {
+	if file == "" {
+		return nil
+	}
+	data, err := os.ReadFile(file) // #nosec G304, user provides the file path via a flag
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", file, err)
+	}
+	*value = string(data)
+	return nil
+}
+
+func printMigrateResponse(out io.Writer, response *model.MigrateResponse) {
+	fmt.Fprintf(out, "offered connection settings to %d agents\n", len(response.Offered))
+
+	agentIDs := make([]string, 0, len(response.Skipped))
+	for agentID := range response.Skipped {
+		agentIDs = append(agentIDs, agentID)
+	}
+	sort.Strings(agentIDs)
+	for _, agentID := range agentIDs {
+		fmt.Fprintf(out, "skipped %s: %s\n", agentID, response.Skipped[agentID])
+	}
+}
+
+func printConnectionSettings(out io.Writer, agents []*model.Agent) {
+	writer := tabwriter.NewWriter(out, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tSTATUS\tENDPOINT\tOFFERED\tACCEPTED")
+	for _, agent := range agents {
+		settings := agent.ConnectionSettings
+		if settings == nil {
+			fmt.Fprintf(writer, "%s\t%s\t-\t-\t-\t-\n", agent.ID, agent.Name)
+			continue
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", agent.ID, agent.Name, settings.Status, settings.Endpoint, formatTime(settings.OfferedAt), formatTime(settings.AcceptedAt))
+	}
+	writer.Flush()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,75 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agents
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestMigrateRequest(t *testing.T) {
+	request, err := migrateRequest("env=production", false, "wss://bindplane.example.com/v1/opamp", []string{"X-Tenant=one", "X-Token=a=b"}, "new")
+	require.NoError(t, err)
+	require.Equal(t, &model.PostMigrateRequest{
+		Selector:  "env=production",
+		Endpoint:  "wss://bindplane.example.com/v1/opamp",
+		Headers:   map[string]string{"X-Tenant": "one", "X-Token": "a=b"},
+		SecretKey: "new",
+	}, request)
+
+	_, err = migrateRequest("", true, "", []string{"X-Tenant"}, "")
+	require.EqualError(t, err, "invalid header X-Tenant, expected name=value")
+
+	// all agents must be selected explicitly
+	_, err = migrateRequest("", false, "", nil, "new")
+	require.EqualError(t, err, "specify agents with --selector or use --all to select all connected agents")
+	_, err = migrateRequest("env=production", true, "", nil, "new")
+	require.EqualError(t, err, "--selector and --all cannot be used together")
+
+	request, err = migrateRequest("", true, "", nil, "new")
+	require.NoError(t, err)
+	require.Equal(t, &model.PostMigrateRequest{All: true, SecretKey: "new"}, request)
+}
+
+func TestPrintMigrateResponse(t *testing.T) {
+	var out bytes.Buffer
+	printMigrateResponse(&out, &model.MigrateResponse{
+		Offered: []string{"1", "2"},
+		Skipped: map[string]string{"4": "agent does not accept connection settings", "3": "closed"},
+	})
+	require.Equal(t, "offered connection settings to 2 agents\nskipped 3: closed\nskipped 4: agent does not accept connection settings\n", out.String())
+}
+
+func TestPrintConnectionSettings(t *testing.T) {
+	offeredAt := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	var out bytes.Buffer
+	printConnectionSettings(&out, []*model.Agent{
+		{ID: "1", Name: "one", ConnectionSettings: &model.AgentConnectionSettings{
+			Status:    model.ConnectionSettingsOffered,
+			Endpoint:  "wss://bindplane.example.com/v1/opamp",
+			OfferedAt: &offeredAt,
+		}},
+		{ID: "2", Name: "two"},
+	})
+	require.Equal(t, `ID   NAME   STATUS    ENDPOINT                               OFFERED                ACCEPTED
+1    one    offered   wss://bindplane.example.com/v1/opamp   2022-08-01T12:00:00Z   -
+2    two    -         -                                      -                      -
+`, out.String())
+}
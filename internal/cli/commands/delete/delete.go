@@ -66,6 +66,9 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 		deleteResourceCommand(bindplane, "source-type", []string{"source-types", "sourceType", "sourceTypes"}),
 		deleteResourceCommand(bindplane, "destination", []string{"destinations"}),
 		deleteResourceCommand(bindplane, "destination-type", []string{"destination-types", "destinationType", "destinationTypes"}),
+		deleteResourceCommand(bindplane, "configuration-template", []string{"configuration-templates", "configurationTemplate", "configurationTemplates"}),
+		deleteResourceCommand(bindplane, "configuration-instance", []string{"configuration-instances", "configurationInstance", "configurationInstances"}),
+		deleteResourceCommand(bindplane, "label-rule", []string{"label-rules", "labelRule", "labelRules"}),
 	)
 
 	return cmd
@@ -103,6 +106,12 @@ func deleteResourceCommand(bindplane *cli.BindPlane, resourceType string, aliase
 				err = c.DeleteDestination(ctx, name)
 			case "destination-type":
 				err = c.DeleteDestinationType(ctx, name)
+			case "configuration-template":
+				err = c.DeleteConfigurationTemplate(ctx, name)
+			case "configuration-instance":
+				err = c.DeleteConfigurationInstance(ctx, name)
+			case "label-rule":
+				err = c.DeleteLabelRule(ctx, name)
 			default:
 				return fmt.Errorf("unknown type, unable to delete %s '%s'", resourceType, name)
 			}
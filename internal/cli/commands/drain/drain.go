@@ -0,0 +1,94 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package drain provides the drain command which asks agents to reconnect to other servers before maintenance.
+package drain
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane drain cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	var durationFlag time.Duration
+	var cancelFlag bool
+	var statusFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Drain agent connections from the server before maintenance",
+		Long: `New agent connections are rejected and connected agents are asked to reconnect gradually over the duration.
+The drain applies to the server handling the request. Use --status to show the admission control status of agent
+connections without starting a drain.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			var status *model.AgentAdmissionResponse
+			switch {
+			case statusFlag:
+				status, err = client.AgentAdmission(cmd.Context())
+			case cancelFlag:
+				status, err = client.CancelDrain(cmd.Context())
+			default:
+				status, err = client.DrainAgents(cmd.Context(), durationFlag)
+			}
+			if err != nil {
+				return err
+			}
+
+			printStatus(cmd.OutOrStdout(), status)
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&durationFlag, "duration", 10*time.Minute, "time over which connected agents are asked to reconnect")
+	cmd.Flags().BoolVar(&cancelFlag, "cancel", false, "cancel the drain and accept new agent connections")
+	cmd.Flags().BoolVar(&statusFlag, "status", false, "show the admission control status without starting a drain")
+
+	return cmd
+}
+
+func printStatus(out io.Writer, status *model.AgentAdmissionResponse) {
+	if status.Draining {
+		fmt.Fprintf(out, "draining: asked %d agents to reconnect", status.Asked)
+		if status.DrainEnds != nil {
+			fmt.Fprintf(out, ", all agents asked by %s", status.DrainEnds.Format(time.RFC3339))
+		}
+		fmt.Fprintln(out)
+	} else {
+		fmt.Fprintln(out, "not draining")
+	}
+	fmt.Fprintf(out, "pending: %d\n", status.Pending)
+	fmt.Fprintf(out, "admitted: %d\n", status.Admitted)
+
+	reasons := make([]string, 0, len(status.Rejected))
+	for reason := range status.Rejected {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		fmt.Fprintf(out, "rejected (%s): %d\n", reason, status.Rejected[reason])
+	}
+}
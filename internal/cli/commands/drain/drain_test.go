@@ -0,0 +1,52 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestPrintStatus(t *testing.T) {
+	drainEnds := time.Date(2022, 8, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		status *model.AgentAdmissionResponse
+		expect string
+	}{
+		{
+			name:   "not draining",
+			status: &model.AgentAdmissionResponse{Pending: 2, Admitted: 10, Rejected: map[string]int64{"rate": 3, "pending": 1}},
+			expect: "not draining\npending: 2\nadmitted: 10\nrejected (pending): 1\nrejected (rate): 3\n",
+		},
+		{
+			name:   "draining",
+			status: &model.AgentAdmissionResponse{Draining: true, Asked: 4, DrainEnds: &drainEnds, Rejected: map[string]int64{}},
+			expect: "draining: asked 4 agents to reconnect, all agents asked by 2022-08-01T12:00:00Z\npending: 0\nadmitted: 0\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			printStatus(&out, test.status)
+			require.Equal(t, test.expect, out.String())
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package get
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -22,6 +23,8 @@ import (
 	"github.com/observiq/bindplane-op/client"
 	"github.com/observiq/bindplane-op/internal/cli"
 	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/observiq/bindplane-op/model"
+	"golang.org/x/exp/slices"
 )
 
 // AgentsCommand returns the BindPlane get agents cobra command
@@ -31,6 +34,8 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 		query    string
 		limit    int
 		offset   int
+
+		attributes []string
 	)
 	cmd := &cobra.Command{
 		Use:     "agents [id]",
@@ -54,7 +59,7 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 					return fmt.Errorf("no agent found with ID %s", id)
 				}
 
-				printer.PrintResource(bindplane.Printer(), agent)
+				printer.PrintResource(bindplane.Printer(), withAttributeColumns([]*model.Agent{agent}, attributes)[0])
 				return nil
 			}
 
@@ -68,15 +73,57 @@ func AgentsCommand(bindplane *cli.BindPlane) *cobra.Command {
 				return err
 			}
 
-			printer.PrintResources(bindplane.Printer(), agents)
+			printer.PrintResources(bindplane.Printer(), withAttributeColumns(agents, attributes))
 			return nil
 		},
 	}
 
-	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter agents by label, e.g. name=value")
+	cmd.Flags().StringVarP(&selector, "selector", "l", "", "label selector to filter agents by label, e.g. name=value or \"env=prod,region notin (eu)\"")
 	cmd.Flags().StringVarP(&query, "query", "q", "", "search query to filter agents")
 	cmd.Flags().IntVar(&offset, "offset", 0, "number of agents to skip for paging")
 	cmd.Flags().IntVar(&limit, "limit", 100, "maximum number of agents to return")
+	cmd.Flags().StringSliceVarP(&attributes, "attributes", "a", nil, "agent attributes to include as columns in table output, e.g. cloud.region,k8s.cluster.name")
 
 	return cmd
 }
+
+// agentAttributeColumns adds columns for the specified attributes when an agent is printed as a table
+type agentAttributeColumns struct {
+	*model.Agent
+	attributes []string
+}
+
+// PrintableFieldTitles returns the agent titles followed by the attribute names
+func (a *agentAttributeColumns) PrintableFieldTitles() []string {
+	return append(a.Agent.PrintableFieldTitles(), a.attributes...)
+}
+
+// PrintableFieldValue returns the value of the attribute or agent field for the title
+func (a *agentAttributeColumns) PrintableFieldValue(title string) string {
+	if slices.Contains(a.attributes, title) {
+		return a.Attribute(title)
+	}
+	return a.Agent.PrintableFieldValue(title)
+}
+
+// MarshalJSON marshals the agent without the columns
+func (a *agentAttributeColumns) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Agent)
+}
+
+// MarshalYAML marshals the agent without the columns
+func (a *agentAttributeColumns) MarshalYAML() (interface{}, error) {
+	return a.Agent, nil
+}
+
+func withAttributeColumns(agents []*model.Agent, attributes []string) []model.Printable {
+	printables := make([]model.Printable, len(agents))
+	for i, agent := range agents {
+		if len(attributes) == 0 {
+			printables[i] = agent
+		} else {
+			printables[i] = &agentAttributeColumns{Agent: agent, attributes: attributes}
+		}
+	}
+	return printables
+}
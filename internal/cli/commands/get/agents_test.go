@@ -16,9 +16,12 @@ package get
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
 )
 
 func TestAgentsCommand(t *testing.T) {
@@ -54,7 +57,15 @@ func TestAgentsCommand(t *testing.T) {
     "platform": "linux",
     "operatingSystem": "Ubuntu 20.10",
     "macAddress": "00:00:ac:00:00:00",
-    "status": 1
+    "status": 1,
+    "attributes": {
+      "identifying": {
+        "service.name": "com.observiq.collector"
+      },
+      "nonIdentifying": {
+        "cloud.region": "us-east1"
+      }
+    }
   },
   {
     "id": "2",
@@ -95,6 +106,11 @@ platform: linux
 operatingSystem: Ubuntu 20.10
 macAddress: 00:00:ac:00:00:00
 status: 1
+attributes:
+    identifying:
+        service.name: com.observiq.collector
+    nonIdentifying:
+        cloud.region: us-east1
 ---
 id: "2"
 name: Agent 2
@@ -146,7 +162,15 @@ status: 0
   "platform": "linux",
   "operatingSystem": "Ubuntu 20.10",
   "macAddress": "00:00:ac:00:00:00",
-  "status": 1
+  "status": 1,
+  "attributes": {
+    "identifying": {
+      "service.name": "com.observiq.collector"
+    },
+    "nonIdentifying": {
+      "cloud.region": "us-east1"
+    }
+  }
 }`
 
 		executeAndAssertOutput(t, cmd, buffer, expected)
@@ -172,11 +196,45 @@ platform: linux
 operatingSystem: Ubuntu 20.10
 macAddress: 00:00:ac:00:00:00
 status: 1
+attributes:
+    identifying:
+        service.name: com.observiq.collector
+    nonIdentifying:
+        cloud.region: us-east1
 `
 
 		executeAndAssertOutput(t, cmd, buffer, expected)
 	})
 
+	t.Run("can print attributes as columns in a table", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = tableOutput
+
+		cmd := AgentsCommand(bindplane)
+		cmd.SetArgs([]string{"--attributes", "cloud.region,service.name"})
+		cmd.SetOut(buffer)
+		expected := "ID\tNAME   \tVERSION\tSTATUS      \tCONNECTED\tDISCONNECTED\tLABELS\tCLOUD REGION\tSERVICE NAME           \n1 \tAgent 1\t1.0.0  \tConnected   \t-        \t-           \t      \tus-east1    \tcom.observiq.collector\t\n2 \tAgent 2\t1.0.0  \tDisconnected\t-        \t-           \t      \t            \t                      \t\n"
+
+		executeAndAssertOutput(t, cmd, buffer, expected)
+	})
+
+	t.Run("does not print attribute columns as JSON", func(t *testing.T) {
+		buffer := bytes.NewBufferString("")
+		bindplane := setupBindPlane(buffer)
+		bindplane.Config.Output = jsonOutput
+
+		cmd := AgentsCommand(bindplane)
+		cmd.SetArgs([]string{"1", "--attributes", "cloud.region"})
+		cmd.SetOut(buffer)
+		require.NoError(t, cmd.Execute())
+
+		agent := &model.Agent{}
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), agent))
+		require.Equal(t, "1", agent.ID)
+		require.Equal(t, "us-east1", agent.Attribute("cloud.region"))
+	})
+
 	t.Run("returns an error when looking up an invalid agent ID", func(t *testing.T) {
 		buffer := bytes.NewBufferString("")
 		bindplane := setupBindPlane(buffer)
@@ -0,0 +1,63 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/spf13/cobra"
+)
+
+// ConfigurationInstancesCommand returns the BindPlane get configuration-instances cobra command
+func ConfigurationInstancesCommand(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "configuration-instances [id]",
+		Aliases: []string{"configuration-instance"},
+		Short:   "Displays the configuration instances",
+		Long:    `A configuration instance generates a configuration from a configuration template and parameter values.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			if len(args) > 0 {
+				name := args[0]
+				configurationInstance, err := c.ConfigurationInstance(cmd.Context(), name)
+				if err != nil {
+					return err
+				}
+
+				if configurationInstance == nil {
+					return fmt.Errorf("no configuration-instance found with name %s", name)
+				}
+
+				printer.PrintResource(bindplane.Printer(), configurationInstance)
+				return nil
+			}
+
+			configurationInstances, err := c.ConfigurationInstances(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), configurationInstances)
+			return nil
+		},
+	}
+	return cmd
+}
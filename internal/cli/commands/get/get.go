@@ -30,8 +30,11 @@ func Command(bindplane *cli.BindPlane) *cobra.Command {
 	cmd.AddCommand(
 		AgentsCommand(bindplane),
 		ConfigurationsCommand(bindplane),
+		ConfigurationInstancesCommand(bindplane),
+		ConfigurationTemplatesCommand(bindplane),
 		DestinationsCommand(bindplane),
 		DestinationTypesCommand(bindplane),
+		LabelRulesCommand(bindplane),
 		ProcessorsCommand(bindplane),
 		ProcessorTypesCommand(bindplane),
 		SourcesCommand(bindplane),
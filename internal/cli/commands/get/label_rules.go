@@ -0,0 +1,63 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/internal/cli/printer"
+	"github.com/spf13/cobra"
+)
+
+// LabelRulesCommand returns the BindPlane get label-rules cobra command
+func LabelRulesCommand(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "label-rules [id]",
+		Aliases: []string{"label-rule"},
+		Short:   "Displays the label rules",
+		Long:    `A label rule sets labels on the agents that match it.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := bindplane.Client()
+			if err != nil {
+				return fmt.Errorf("error creating client: %w", err)
+			}
+
+			if len(args) > 0 {
+				name := args[0]
+				labelRule, err := c.LabelRule(cmd.Context(), name)
+				if err != nil {
+					return err
+				}
+
+				if labelRule == nil {
+					return fmt.Errorf("no label-rule found with name %s", name)
+				}
+
+				printer.PrintResource(bindplane.Printer(), labelRule)
+				return nil
+			}
+
+			labelRules, err := c.LabelRules(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			printer.PrintResources(bindplane.Printer(), labelRules)
+			return nil
+		},
+	}
+	return cmd
+}
@@ -61,6 +61,10 @@ func (c *mockClient) Agents(ctx context.Context, options ...client.QueryOption)
 			MacAddress:      "00:00:ac:00:00:00",
 			Type:            "stanza",
 			Status:          model.Connected,
+			Attributes: &model.AgentAttributes{
+				Identifying:    map[string]string{"service.name": "com.observiq.collector"},
+				NonIdentifying: map[string]string{"cloud.region": "us-east1"},
+			},
 		},
 		{
 			ID:      "2",
@@ -0,0 +1,215 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package importcmd provides the import command which creates BindPlane resources from existing agent configurations.
+package importcmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/observiq/bindplane-op/client"
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane import cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import resources from existing configurations",
+	}
+	cmd.AddCommand(collectorConfigCommand(bindplane))
+	return cmd
+}
+
+func collectorConfigCommand(bindplane *cli.BindPlane) *cobra.Command {
+	var nameFlag string
+	var dryRunFlag bool
+	var forceFlag bool
+
+	cmd := &cobra.Command{
+		Use:   "collector-config file.yaml",
+		Short: "Import an OpenTelemetry collector configuration as a configuration with sources and destinations",
+		Long: `Receivers and exporters are matched against the source types and destination types and imported as sources
+and destinations with the parameters inferred from their configuration. Processors that do not match a processor type
+are imported as custom processors. Pipelines that cannot be imported and all extensions are kept in the fragment of the
+configuration. A report of how each component was imported is printed. The import fails if any of the resources
+already exist unless --force is used to replace them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importCollectorConfig(cmd.Context(), cmd.OutOrStdout(), args[0], nameFlag, dryRunFlag, forceFlag, bindplane)
+		},
+	}
+
+	cmd.Flags().StringVar(&nameFlag, "name", "", "name of the configuration, defaults to the name of the file")
+	cmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "print the resources instead of applying them")
+	cmd.Flags().BoolVar(&forceFlag, "force", false, "replace existing resources with the same names")
+
+	return cmd
+}
+
+func importCollectorConfig(ctx context.Context, stdout io.Writer, filename string, name string, dryRun bool, force bool, bindplane *cli.BindPlane) error {
+	raw, err := os.ReadFile(filepath.Clean(filename))
+	if err != nil {
+		return fmt.Errorf("unable to read collector configuration: %w", err)
+	}
+	if name == "" {
+		name = configurationName(filename)
+	}
+
+	client, err := bindplane.Client()
+	if err != nil {
+		return fmt.Errorf("error creating client: %w", err)
+	}
+
+	var types model.CollectorImportTypes
+	if types.SourceTypes, err = client.SourceTypes(ctx); err != nil {
+		return err
+	}
+	if types.DestinationTypes, err = client.DestinationTypes(ctx); err != nil {
+		return err
+	}
+	if types.ProcessorTypes, err = client.ProcessorTypes(ctx); err != nil {
+		return err
+	}
+
+	result, err := model.ImportCollectorConfiguration(name, string(raw), types)
+	if err != nil {
+		return err
+	}
+
+	resources, err := marshalResources(result.Resources())
+	if err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Fprint(stdout, resources)
+		fmt.Fprintln(stdout)
+	} else {
+		if !force {
+			existing, err := existingResourceNames(ctx, client)
+			if err != nil {
+				return err
+			}
+			if conflicts := conflictingResources(result.Resources(), existing); len(conflicts) > 0 {
+				return fmt.Errorf("resources already exist: %s, use --force to replace them", strings.Join(conflicts, ", "))
+			}
+		}
+		anyResources, err := model.ResourcesFromReader(strings.NewReader(resources))
+		if err != nil {
+			return err
+		}
+		statuses, err := client.Apply(ctx, anyResources)
+		if err != nil {
+			return err
+		}
+		model.PrintResourceUpdates(stdout, statuses)
+		fmt.Fprintln(stdout)
+	}
+
+	printReport(stdout, result.Components)
+	return nil
+}
+
+// configurationName returns the name of the file without its extension
+func configurationName(filename string) string {
+	base := filepath.Base(filename)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// existingResourceNames returns the names of the existing resources of the kinds created by an import by kind
+func existingResourceNames(ctx context.Context, c client.BindPlane) (map[model.Kind]map[string]bool, error) {
+	names := map[model.Kind]map[string]bool{}
+	add := func(kind model.Kind, name string) {
+		if names[kind] == nil {
+			names[kind] = map[string]bool{}
+		}
+		names[kind][name] = true
+	}
+
+	sources, err := c.Sources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get sources: %w", err)
+	}
+	for _, source := range sources {
+		add(model.KindSource, source.Name())
+	}
+	processors, err := c.Processors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get processors: %w", err)
+	}
+	for _, processor := range processors {
+		add(model.KindProcessor, processor.Name())
+	}
+	destinations, err := c.Destinations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get destinations: %w", err)
+	}
+	for _, destination := range destinations {
+		add(model.KindDestination, destination.Name())
+	}
+	configurations, err := c.Configurations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get configurations: %w", err)
+	}
+	for _, configuration := range configurations {
+		add(model.KindConfiguration, configuration.Name())
+	}
+	return names, nil
+}
+
+// conflictingResources returns the kind and name of each resource that would replace an existing resource
+func conflictingResources(resources []model.Resource, existing map[model.Kind]map[string]bool) []string {
+	var conflicts []string
+	for _, resource := range resources {
+		if existing[resource.GetKind()][resource.Name()] {
+			conflicts = append(conflicts, fmt.Sprintf("%s %s", resource.GetKind(), resource.Name()))
+		}
+	}
+	return conflicts
+}
+
+// marshalResources returns the yaml of the resources separated by ---
+func marshalResources(resources []model.Resource) (string, error) {
+	var buffer bytes.Buffer
+	for _, resource := range resources {
+		bytes, err := yaml.Marshal(resource)
+		if err != nil {
+			return "", fmt.Errorf("unable to marshal %s %s: %w", resource.GetKind(), resource.Name(), err)
+		}
+		buffer.WriteString("---\n")
+		buffer.Write(bytes)
+	}
+	return buffer.String(), nil
+}
+
+func printReport(stdout io.Writer, components []model.ImportedComponent) {
+	writer := tabwriter.NewWriter(stdout, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(writer, "KIND\tID\tSTATUS\tRESOURCE\tTYPE\tNOTE")
+	for _, c := range components {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", c.Kind, c.ID, c.Status, c.Resource, c.ResourceType, c.Note)
+	}
+	writer.Flush()
+}
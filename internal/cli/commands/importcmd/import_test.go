@@ -0,0 +1,73 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importcmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestConfigurationName(t *testing.T) {
+	require.Equal(t, "gateway", configurationName("/etc/otel/gateway.yaml"))
+	require.Equal(t, "edge.collector", configurationName("edge.collector.yml"))
+	require.Equal(t, "config", configurationName("config"))
+}
+
+func TestMarshalResources(t *testing.T) {
+	resources := []model.Resource{
+		model.NewSource("edge-otlp", "otlp", nil),
+		model.NewConfiguration("edge"),
+	}
+	marshaled, err := marshalResources(resources)
+	require.NoError(t, err)
+
+	parsed, err := model.ResourcesFromReader(strings.NewReader(marshaled))
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	require.Equal(t, model.KindSource, parsed[0].Kind)
+	require.Equal(t, "edge", parsed[1].Name())
+}
+
+func TestConflictingResources(t *testing.T) {
+	resources := []model.Resource{
+		model.NewSource("edge-otlp", "otlp", nil),
+		model.NewDestination("edge-otlp", "otlp", nil),
+		model.NewConfiguration("edge"),
+	}
+	require.Empty(t, conflictingResources(resources, map[model.Kind]map[string]bool{}))
+
+	existing := map[model.Kind]map[string]bool{
+		model.KindSource:        {"edge-otlp": true},
+		model.KindConfiguration: {"edge": true, "other": true},
+	}
+	require.Equal(t, []string{"Source edge-otlp", "Configuration edge"}, conflictingResources(resources, existing))
+}
+
+func TestPrintReport(t *testing.T) {
+	var out bytes.Buffer
+	printReport(&out, []model.ImportedComponent{
+		{Kind: "receiver", ID: "otlp", Status: model.ImportStatusMatched, ResourceType: "otlp", Resource: "edge-otlp"},
+		{Kind: "exporter", ID: "logging", Status: model.ImportStatusFragment, Note: "no matching destination type"},
+	})
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Contains(t, lines[1], "edge-otlp")
+	require.Contains(t, lines[2], "no matching destination type")
+}
@@ -0,0 +1,115 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pause provides the pause and resume commands which update the flow control of a source or destination in a
+// configuration without applying the entire configuration.
+package pause
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/observiq/bindplane-op/internal/cli"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// Command returns the BindPlane pause cobra command.
+func Command(bindplane *cli.BindPlane) *cobra.Command {
+	return flowControlCommand(bindplane, "pause", "Pause the telemetry flowing through a source or destination of a configuration", true)
+}
+
+// ResumeCommand returns the BindPlane resume cobra command.
+func ResumeCommand(bindplane *cli.BindPlane) *cobra.Command {
+	return flowControlCommand(bindplane, "resume", "Resume the telemetry flowing through a paused source or destination of a configuration", false)
+}
+
+func flowControlCommand(bindplane *cli.BindPlane, use string, short string, paused bool) *cobra.Command {
+	var configurationFlag string
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s (source|destination) name --configuration name", use),
+		Short: short,
+		Long:  `Inline sources and destinations without a name are identified by their position in the configuration, e.g. source0 or destination1.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setPaused(cmd.Context(), cmd.OutOrStdout(), args, configurationFlag, paused, bindplane)
+		},
+	}
+
+	cmd.Flags().StringVar(&configurationFlag, "configuration", "", "name of the configuration containing the source or destination")
+	_ = cmd.MarkFlagRequired("configuration")
+
+	return cmd
+}
+
+func setPaused(ctx context.Context, stdout io.Writer, args []string, configurationName string, paused bool, bindplane *cli.BindPlane) error {
+	kind, name, err := parseArgs(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := bindplane.Client()
+	if err != nil {
+		return err
+	}
+
+	configuration, err := client.Configuration(ctx, configurationName)
+	if err != nil {
+		return err
+	}
+	if configuration == nil {
+		return fmt.Errorf("no configuration with name %s found", configurationName)
+	}
+
+	// preserve the sampling percentage when pausing and resuming
+	flowControl, err := configuration.FlowControl(kind, name)
+	if err != nil {
+		return err
+	}
+	flowControl.Paused = paused
+
+	response, err := client.ApplyFlowControl(ctx, configurationName, kind, name, flowControl)
+	if err != nil {
+		return err
+	}
+
+	action := "resumed"
+	if paused {
+		action = "paused"
+	}
+	if response.Status == model.StatusUnchanged {
+		action = fmt.Sprintf("already %s", action)
+	}
+	fmt.Fprintf(stdout, "%s %s %s in configuration %s\n", kind, name, action, configurationName)
+	return nil
+}
+
+func parseArgs(args []string) (model.Kind, string, error) {
+	if len(args) == 0 {
+		return model.KindUnknown, "", fmt.Errorf("missing resource type, must be source or destination")
+	}
+	kind := model.ParseKind(args[0])
+	if kind != model.KindSource && kind != model.KindDestination {
+		return model.KindUnknown, "", fmt.Errorf("%s is not supported, must be source or destination", args[0])
+	}
+	if len(args) == 1 {
+		return model.KindUnknown, "", fmt.Errorf("missing %s name", args[0])
+	}
+	if len(args) > 2 {
+		return model.KindUnknown, "", fmt.Errorf("only one %s can be specified", args[0])
+	}
+	return kind, args[1], nil
+}
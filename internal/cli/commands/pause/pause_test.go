@@ -0,0 +1,79 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pause
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		expectKind model.Kind
+		expectName string
+		expectErr  string
+	}{
+		{
+			name:      "no args",
+			args:      []string{},
+			expectErr: "missing resource type, must be source or destination",
+		},
+		{
+			name:       "destination",
+			args:       []string{"destination", "cabin"},
+			expectKind: model.KindDestination,
+			expectName: "cabin",
+		},
+		{
+			name:       "inline source",
+			args:       []string{"source", "source0"},
+			expectKind: model.KindSource,
+			expectName: "source0",
+		},
+		{
+			name:      "missing name",
+			args:      []string{"destination"},
+			expectErr: "missing destination name",
+		},
+		{
+			name:      "unsupported kind",
+			args:      []string{"agent", "1"},
+			expectErr: "agent is not supported, must be source or destination",
+		},
+		{
+			name:      "too many names",
+			args:      []string{"source", "a", "b"},
+			expectErr: "only one source can be specified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kind, name, err := parseArgs(test.args)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectKind, kind)
+			require.Equal(t, test.expectName, name)
+		})
+	}
+}
@@ -88,6 +88,16 @@ func SetCommand(h Helper) *cobra.Command {
 						profile.Spec.Username = f.Value.String()
 					case "password":
 						profile.Spec.Password = f.Value.String()
+					case "encryption-key":
+						profile.Spec.Server.EncryptionKey = f.Value.String()
+					case "previous-encryption-keys":
+						stringValue := f.Value.String()                                // In the case of StringSlice this looks like `"[one,two]"`
+						value := strings.Split(stringValue[1:len(stringValue)-1], ",") // removes the brackets
+						profile.Spec.Server.PreviousEncryptionKeys = value
+					case "previous-secret-keys":
+						stringValue := f.Value.String()                                // In the case of StringSlice this looks like `"[one,two]"`
+						value := strings.Split(stringValue[1:len(stringValue)-1], ",") // removes the brackets
+						profile.Spec.Server.PreviousSecretKeys = value
 					case "storage-file-path":
 						profile.Spec.Server.StorageFilePath = f.Value.String()
 					case "tls-cert":
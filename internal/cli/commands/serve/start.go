@@ -51,6 +51,8 @@ import (
 type Server struct {
 	logger *zap.Logger
 	http   *http.Server
+	// cancel stops the background work of the server when it is stopped
+	cancel context.CancelFunc
 }
 
 // Start starts the BindPlane using the specified Config.
@@ -77,6 +79,15 @@ func (s *Server) Start(bindplane *cli.BindPlane, h profile.Helper, forceConsoleC
 		}
 	}
 
+	// re-encrypt secrets that were encrypted with a previous encryption key
+	secretKeyring, err := store.NewSecretKeyring(config)
+	if err != nil {
+		return err
+	}
+	if err := store.RotateSecrets(st, secretKeyring, s.logger); err != nil {
+		s.logger.Error("failed to rotate secrets", zap.Error(err))
+	}
+
 	// seed the search index
 	s.seedSearchIndexes(st)
 
@@ -126,8 +137,11 @@ func (s *Server) Start(bindplane *cli.BindPlane, h profile.Helper, forceConsoleC
 	// Swagger documentation
 	swagger.AddRoutes(router)
 
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+
 	// opamp does its own authorization based on the OnConnecting callback
-	err = opamp.AddRoutes(v1, server)
+	err = opamp.AddRoutes(ctx, v1, server)
 	if err != nil {
 		return fmt.Errorf("failed to start OpAMP: %w", err)
 	}
@@ -203,6 +217,9 @@ func (s *Server) stop() error {
 	timeout := 20 * time.Second
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
+	if s.cancel != nil {
+		defer s.cancel()
+	}
 	return s.http.Shutdown(ctx)
 }
 
@@ -211,11 +228,18 @@ func (s *Server) createStore(config *common.Server) (store.Store, error) {
 		return nil, errors.New("cannot create store with unset value for sessions-secret, run bindplane init server to set value")
 	}
 
+	secretKeyring, err := store.NewSecretKeyring(config)
+	if err != nil {
+		return nil, err
+	}
+
 	switch config.StoreType {
 	case common.StoreTypeMap:
 		return store.NewMapStore(context.Background(), store.Options{
 			SessionsSecret:   config.SessionsSecret,
 			MaxEventsToMerge: 100,
+			SecretKeyring:    secretKeyring,
+			SecretProviders:  store.NewSecretProviders(config),
 		}, s.logger), nil
 
 	case common.StoreTypeGoogleCloud:
@@ -236,6 +260,8 @@ func (s *Server) createStore(config *common.Server) (store.Store, error) {
 		return store.NewBoltStore(context.Background(), db, store.Options{
 			SessionsSecret:   config.SessionsSecret,
 			MaxEventsToMerge: 100,
+			SecretKeyring:    secretKeyring,
+			SecretProviders:  store.NewSecretProviders(config),
 		}, s.logger), nil
 	}
 }
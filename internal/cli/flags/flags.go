@@ -15,6 +15,7 @@
 package flags
 
 import (
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/agent"
 	"github.com/spf13/cobra"
 )
@@ -45,8 +46,21 @@ func Serve(cmd *cobra.Command) {
 	f.String("remote-url", "", "websocket url that agents use to connect to the server")
 	f.String("secret-key", "", "secret key used by agents when connecting to the server")
 	f.String("sessions-secret", "", "secret key used to sign cookies for session authentication, must be a UUID")
+	f.String("encryption-key", "", "key used to encrypt secret parameters, defaults to the sessions-secret")
+	f.StringSlice("previous-encryption-keys", make([]string, 0), "keys previously used to encrypt secret parameters that should be rotated to the encryption-key")
+	f.StringSlice("previous-secret-keys", make([]string, 0), "secret keys previously used by agents that are accepted until agents are migrated to the secret-key")
 	f.String("storage-file-path", "", "full path to the desired storage file, defaults to the $HOME/.bindplane/storage")
 	f.String("downloads-folder-path", "", "full path to the downloads folder where agents are cached, defaults to $HOME/.bindplane/downloads")
 	f.String("agents-service-url", agent.DefaultAgentVersionsURL, "url of the service that provides agent release information")
 	f.Bool("disable-downloads-cache", false, "true if agent distributions should be cached")
+	f.String("agent-cleanup-interval", common.DefaultAgentCleanupInterval.String(), "interval of the job that removes disconnected agents", withConfigFileName("agentCleanup.interval"))
+	f.String("agent-cleanup-ttl", common.DefaultAgentCleanupTTL.String(), "time that disconnected agents are kept before they are removed", withConfigFileName("agentCleanup.ttl"))
+	f.String("agent-heartbeat-interval", common.DefaultAgentHeartbeatInterval.String(), "interval of the heartbeat sent to connected agents")
+	f.String("agent-status-flush-interval", common.DefaultAgentStatusFlushInterval.String(), "interval at which buffered agent status changes are written to the store")
+	f.String("agent-admission-max-pending", "0", "maximum number of agent connections being established at the same time, 0 is unlimited", withConfigFileName("agentAdmission.maxPending"))
+	f.String("agent-admission-rate", "0", "maximum number of new agent connections accepted per second, 0 is unlimited", withConfigFileName("agentAdmission.rate"))
+	f.String("agent-admission-retry-after", common.DefaultAgentAdmissionRetryAfter.String(), "minimum time that agents rejected by admission control wait before reconnecting", withConfigFileName("agentAdmission.retryAfter"))
+	f.String("agent-admission-jitter", common.DefaultAgentAdmissionJitter.String(), "maximum random time added to the retry time of rejected agents", withConfigFileName("agentAdmission.jitter"))
+	f.StringSlice("secret-providers-env-prefixes", make([]string, 0), "prefixes of the server environment variables that secret parameters can reference, disabled if empty", withConfigFileName("secretProviders.envPrefixes"))
+	f.StringSlice("secret-providers-path-prefixes", make([]string, 0), "directories of the server files that secret parameters can reference, disabled if empty", withConfigFileName("secretProviders.pathPrefixes"))
 }
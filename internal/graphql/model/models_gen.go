@@ -138,14 +138,20 @@ func (e EventType) MarshalGQL(w io.Writer) {
 type ParameterType string
 
 const (
-	ParameterTypeString  ParameterType = "string"
-	ParameterTypeStrings ParameterType = "strings"
-	ParameterTypeInt     ParameterType = "int"
-	ParameterTypeBool    ParameterType = "bool"
-	ParameterTypeEnum    ParameterType = "enum"
-	ParameterTypeEnums   ParameterType = "enums"
-	ParameterTypeMap     ParameterType = "map"
-	ParameterTypeYaml    ParameterType = "yaml"
+	ParameterTypeString   ParameterType = "string"
+	ParameterTypeStrings  ParameterType = "strings"
+	ParameterTypeInt      ParameterType = "int"
+	ParameterTypeBool     ParameterType = "bool"
+	ParameterTypeEnum     ParameterType = "enum"
+	ParameterTypeEnums    ParameterType = "enums"
+	ParameterTypeMap      ParameterType = "map"
+	ParameterTypeYaml     ParameterType = "yaml"
+	ParameterTypeSecret   ParameterType = "secret"
+	ParameterTypeFloat    ParameterType = "float"
+	ParameterTypeDuration ParameterType = "duration"
+	ParameterTypeTimezone ParameterType = "timezone"
+	ParameterTypeText     ParameterType = "text"
+	ParameterTypeObjects  ParameterType = "objects"
 )
 
 var AllParameterType = []ParameterType{
@@ -157,11 +163,17 @@ var AllParameterType = []ParameterType{
 	ParameterTypeEnums,
 	ParameterTypeMap,
 	ParameterTypeYaml,
+	ParameterTypeSecret,
+	ParameterTypeFloat,
+	ParameterTypeDuration,
+	ParameterTypeTimezone,
+	ParameterTypeText,
+	ParameterTypeObjects,
 }
 
 func (e ParameterType) IsValid() bool {
 	switch e {
-	case ParameterTypeString, ParameterTypeStrings, ParameterTypeInt, ParameterTypeBool, ParameterTypeEnum, ParameterTypeEnums, ParameterTypeMap, ParameterTypeYaml:
+	case ParameterTypeString, ParameterTypeStrings, ParameterTypeInt, ParameterTypeBool, ParameterTypeEnum, ParameterTypeEnums, ParameterTypeMap, ParameterTypeYaml, ParameterTypeSecret, ParameterTypeFloat, ParameterTypeDuration, ParameterTypeTimezone, ParameterTypeText, ParameterTypeObjects:
 		return true
 	}
 	return false
@@ -191,16 +203,26 @@ func (e ParameterType) MarshalGQL(w io.Writer) {
 type RelevantIfOperatorType string
 
 const (
-	RelevantIfOperatorTypeEquals RelevantIfOperatorType = "equals"
+	RelevantIfOperatorTypeEquals      RelevantIfOperatorType = "equals"
+	RelevantIfOperatorTypeNotEquals   RelevantIfOperatorType = "notEquals"
+	RelevantIfOperatorTypeIn          RelevantIfOperatorType = "in"
+	RelevantIfOperatorTypeNotIn       RelevantIfOperatorType = "notIn"
+	RelevantIfOperatorTypeContainsAny RelevantIfOperatorType = "containsAny"
+	RelevantIfOperatorTypeGreaterThan RelevantIfOperatorType = "greaterThan"
 )
 
 var AllRelevantIfOperatorType = []RelevantIfOperatorType{
 	RelevantIfOperatorTypeEquals,
+	RelevantIfOperatorTypeNotEquals,
+	RelevantIfOperatorTypeIn,
+	RelevantIfOperatorTypeNotIn,
+	RelevantIfOperatorTypeContainsAny,
+	RelevantIfOperatorTypeGreaterThan,
 }
 
 func (e RelevantIfOperatorType) IsValid() bool {
 	switch e {
-	case RelevantIfOperatorTypeEquals:
+	case RelevantIfOperatorTypeEquals, RelevantIfOperatorTypeNotEquals, RelevantIfOperatorTypeIn, RelevantIfOperatorTypeNotIn, RelevantIfOperatorTypeContainsAny, RelevantIfOperatorTypeGreaterThan:
 		return true
 	}
 	return false
@@ -158,3 +158,15 @@ func (r *Resolver) queryOptionsAndSuggestions(selector *string, query *string, i
 	}
 	return options, suggestions, nil
 }
+
+// stringMap returns the map as a map[string]interface{} for the Map scalar
+func stringMap(m map[string]string) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
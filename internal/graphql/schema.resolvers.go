@@ -59,6 +59,16 @@ func (r *agentResolver) ConfigurationResource(ctx context.Context, obj *model.Ag
 	return r.bindplane.Store().AgentConfiguration(obj.ID)
 }
 
+// Identifying is the resolver for the identifying field.
+func (r *agentAttributesResolver) Identifying(ctx context.Context, obj *model.AgentAttributes) (map[string]interface{}, error) {
+	return stringMap(obj.Identifying), nil
+}
+
+// NonIdentifying is the resolver for the nonIdentifying field.
+func (r *agentAttributesResolver) NonIdentifying(ctx context.Context, obj *model.AgentAttributes) (map[string]interface{}, error) {
+	return stringMap(obj.NonIdentifying), nil
+}
+
 // MatchLabels is the resolver for the matchLabels field.
 func (r *agentSelectorResolver) MatchLabels(ctx context.Context, obj *model.AgentSelector) (map[string]interface{}, error) {
 	labels := map[string]interface{}{}
@@ -83,6 +93,11 @@ func (r *destinationTypeResolver) Kind(ctx context.Context, obj *model.Destinati
 	return string(obj.GetKind()), nil
 }
 
+// Operator is the resolver for the operator field.
+func (r *matchExpressionResolver) Operator(ctx context.Context, obj *model.MatchExpression) (string, error) {
+	return string(obj.Operator), nil
+}
+
 // Labels is the resolver for the labels field.
 func (r *metadataResolver) Labels(ctx context.Context, obj *model.Metadata) (map[string]interface{}, error) {
 	labels := map[string]interface{}{}
@@ -92,6 +107,11 @@ func (r *metadataResolver) Labels(ctx context.Context, obj *model.Metadata) (map
 	return labels, nil
 }
 
+// Value is the resolver for the value field.
+func (r *parameterResolver) Value(ctx context.Context, obj *model.Parameter) (interface{}, error) {
+	return model.RedactedValue(obj.Value), nil
+}
+
 // Type is the resolver for the type field.
 func (r *parameterDefinitionResolver) Type(ctx context.Context, obj *model.ParameterDefinition) (model1.ParameterType, error) {
 	switch obj.Type {
@@ -118,6 +138,24 @@ func (r *parameterDefinitionResolver) Type(ctx context.Context, obj *model.Param
 	case "enums":
 		return model1.ParameterTypeEnums, nil
 
+	case "secret":
+		return model1.ParameterTypeSecret, nil
+
+	case "float":
+		return model1.ParameterTypeFloat, nil
+
+	case "duration":
+		return model1.ParameterTypeDuration, nil
+
+	case "timezone":
+		return model1.ParameterTypeTimezone, nil
+
+	case "text":
+		return model1.ParameterTypeText, nil
+
+	case "objects":
+		return model1.ParameterTypeObjects, nil
+
 	default:
 		return "", errors.New("unknown parameter type")
 	}
@@ -347,6 +385,11 @@ func (r *subscriptionResolver) ConfigurationChanges(ctx context.Context, selecto
 // Agent returns generated.AgentResolver implementation.
 func (r *Resolver) Agent() generated.AgentResolver { return &agentResolver{r} }
 
+// AgentAttributes returns generated.AgentAttributesResolver implementation.
+func (r *Resolver) AgentAttributes() generated.AgentAttributesResolver {
+	return &agentAttributesResolver{r}
+}
+
 // AgentSelector returns generated.AgentSelectorResolver implementation.
 func (r *Resolver) AgentSelector() generated.AgentSelectorResolver { return &agentSelectorResolver{r} }
 
@@ -361,9 +404,17 @@ func (r *Resolver) DestinationType() generated.DestinationTypeResolver {
 	return &destinationTypeResolver{r}
 }
 
+// MatchExpression returns generated.MatchExpressionResolver implementation.
+func (r *Resolver) MatchExpression() generated.MatchExpressionResolver {
+	return &matchExpressionResolver{r}
+}
+
 // Metadata returns generated.MetadataResolver implementation.
 func (r *Resolver) Metadata() generated.MetadataResolver { return &metadataResolver{r} }
 
+// Parameter returns generated.ParameterResolver implementation.
+func (r *Resolver) Parameter() generated.ParameterResolver { return &parameterResolver{r} }
+
 // ParameterDefinition returns generated.ParameterDefinitionResolver implementation.
 func (r *Resolver) ParameterDefinition() generated.ParameterDefinitionResolver {
 	return &parameterDefinitionResolver{r}
@@ -393,11 +444,14 @@ func (r *Resolver) SourceType() generated.SourceTypeResolver { return &sourceTyp
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
 type agentResolver struct{ *Resolver }
+type agentAttributesResolver struct{ *Resolver }
 type agentSelectorResolver struct{ *Resolver }
 type configurationResolver struct{ *Resolver }
 type destinationResolver struct{ *Resolver }
 type destinationTypeResolver struct{ *Resolver }
+type matchExpressionResolver struct{ *Resolver }
 type metadataResolver struct{ *Resolver }
+type parameterResolver struct{ *Resolver }
 type parameterDefinitionResolver struct{ *Resolver }
 type processorResolver struct{ *Resolver }
 type processorTypeResolver struct{ *Resolver }
@@ -187,3 +187,54 @@ func TestConfigForAgent(t *testing.T) {
 		}
 	}
 }
+
+func TestParameterDefinitionType(t *testing.T) {
+	r := &parameterDefinitionResolver{}
+	for _, parameterType := range model1.AllParameterType {
+		t.Run(string(parameterType), func(t *testing.T) {
+			resolved, err := r.Type(context.Background(), &model.ParameterDefinition{Type: string(parameterType)})
+			require.NoError(t, err)
+			require.Equal(t, parameterType, resolved)
+		})
+	}
+
+	_, err := r.Type(context.Background(), &model.ParameterDefinition{Type: "unknown"})
+	require.Error(t, err)
+}
+
+func TestProcessorTypesParameterTypes(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mapstore := store.NewMapStore(ctx, store.Options{
+		SessionsSecret:   "super-secret-key",
+		MaxEventsToMerge: 1,
+	}, zap.NewNop())
+
+	bindplane, err := server.NewBindPlane(&common.Server{}, zaptest.NewLogger(t), mapstore, nil)
+	require.NoError(t, err)
+
+	c := client.New(newHandler(bindplane))
+
+	// the seeded custom processor type has a text parameter
+	custom := model.NewProcessorType("custom", []model.ParameterDefinition{
+		{Name: "telemetry_types", Type: "enums", ValidValues: []string{"Logs", "Metrics", "Traces"}, Default: []interface{}{}},
+		{Name: "configuration", Type: "text", Required: true},
+	})
+	_, err = bindplane.Store().ApplyResources([]model.Resource{custom})
+	require.NoError(t, err)
+
+	var resp struct {
+		ProcessorTypes []struct {
+			Spec struct {
+				Parameters []struct {
+					Name string
+					Type string
+				}
+			}
+		}
+	}
+	err = c.Post(`query TestQuery { processorTypes { spec { parameters { name type } } } }`, &resp)
+	require.NoError(t, err)
+	require.Len(t, resp.ProcessorTypes, 1)
+	require.Equal(t, "text", resp.ProcessorTypes[0].Spec.Parameters[1].Type)
+}
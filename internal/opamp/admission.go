@@ -0,0 +1,452 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	opamp "github.com/open-telemetry/opamp-go/server/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/rest"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// admissionReason is the reason that a connection was rejected
+type admissionReason string
+
+const (
+	// admissionRate rejects connections that exceed the configured rate with 429 Too Many Requests
+	admissionRate admissionReason = "rate"
+	// admissionPending rejects connections when too many are pending with 503 Service Unavailable
+	admissionPending admissionReason = "pending"
+	// admissionDraining rejects connections while the server is draining with 503 Service Unavailable
+	admissionDraining admissionReason = "draining"
+
+	// admissionPendingTimeout is the time after which an admitted connection that was never established is no longer
+	// counted as pending, e.g. if the WebSocket upgrade fails
+	admissionPendingTimeout = 30 * time.Second
+)
+
+// admission limits the number of agent connections being established at the same time and the rate of new
+// connections. It also drains the server by asking connected agents to reconnect gradually over a period of time.
+type admission struct {
+	maxPending int
+	rate       float64
+	burst      float64
+	retryAfter time.Duration
+	jitter     time.Duration
+
+	mtx    sync.Mutex
+	random *rand.Rand
+
+	// pending is the time each connection was admitted by remote address
+	pending map[string]time.Time
+
+	// tokens available for new connections when rate limited
+	tokens   float64
+	refilled time.Time
+
+	admitted int64
+	rejected map[admissionReason]int64
+	metrics  *admissionMetrics
+
+	// drain is in progress if drainStart is not zero. asked contains the agents that have been asked to reconnect.
+	drainStart time.Time
+	drainEnd   time.Time
+	asked      map[string]bool
+}
+
+func newAdmission(config *common.Server) *admission {
+	return &admission{
+		maxPending: config.AgentAdmission.MaxPending,
+		rate:       config.AgentAdmission.Rate,
+		burst:      math.Max(1, math.Ceil(config.AgentAdmission.Rate)),
+		retryAfter: config.AgentAdmissionRetryAfter(),
+		jitter:     config.AgentAdmissionJitter(),
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		pending:    map[string]time.Time{},
+		tokens:     math.Max(1, math.Ceil(config.AgentAdmission.Rate)),
+		rejected:   map[admissionReason]int64{},
+		asked:      map[string]bool{},
+	}
+}
+
+// admissionMetrics are the instruments used to count admitted and rejected connections
+type admissionMetrics struct {
+	admitted syncint64.Counter
+	rejected syncint64.Counter
+}
+
+// instrument records metrics of admission control using the meter. The number of pending connections is observed by a
+// gauge and admitted and rejected connections are counted. Rejected connections have a reason attribute.
+func (a *admission) instrument(meter metric.Meter) error {
+	admitted, err := meter.SyncInt64().Counter("bindplane.opamp.admission.admitted",
+		instrument.WithDescription("Number of agent connections admitted"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create the admitted counter: %w", err)
+	}
+	rejected, err := meter.SyncInt64().Counter("bindplane.opamp.admission.rejected",
+		instrument.WithDescription("Number of agent connections rejected by reason"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create the rejected counter: %w", err)
+	}
+	pending, err := meter.AsyncInt64().Gauge("bindplane.opamp.admission.pending",
+		instrument.WithDescription("Number of admitted agent connections that are not yet established"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create the pending gauge: %w", err)
+	}
+	err = meter.RegisterCallback([]instrument.Asynchronous{pending}, func(ctx context.Context) {
+		pending.Observe(ctx, int64(a.pendingCount(time.Now())))
+	})
+	if err != nil {
+		return fmt.Errorf("unable to register the pending gauge: %w", err)
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.metrics = &admissionMetrics{admitted: admitted, rejected: rejected}
+	return nil
+}
+
+// admit returns true if the connection from the remote address is admitted. If the connection is rejected, the reason
+// and the time the agent should wait before retrying are returned.
+func (a *admission) admit(remoteAddr string, now time.Time) (bool, admissionReason, time.Duration) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if reason, rejected := a.checkLocked(now); rejected {
+		a.rejectLocked(reason)
+		return false, reason, a.retryLocked()
+	}
+
+	a.pending[remoteAddr] = now
+	a.admitted++
+	if a.metrics != nil {
+		a.metrics.admitted.Add(context.Background(), 1)
+	}
+	return true, "", 0
+}
+
+// rejectLocked counts a rejection. a.mtx must be held.
+func (a *admission) rejectLocked(reason admissionReason) {
+	a.rejected[reason]++
+	if a.metrics != nil {
+		a.metrics.rejected.Add(context.Background(), 1, attribute.String("reason", string(reason)))
+	}
+}
+
+// checkLocked returns the reason a new connection should be rejected. a.mtx must be held.
+func (a *admission) checkLocked(now time.Time) (admissionReason, bool) {
+	if !a.drainStart.IsZero() {
+		return admissionDraining, true
+	}
+
+	a.expirePendingLocked(now)
+	if a.maxPending > 0 && len(a.pending) >= a.maxPending {
+		return admissionPending, true
+	}
+
+	if a.rate > 0 {
+		if !a.refilled.IsZero() {
+			a.tokens = math.Min(a.burst, a.tokens+now.Sub(a.refilled).Seconds()*a.rate)
+		}
+		a.refilled = now
+		if a.tokens < 1 {
+			return admissionRate, true
+		}
+		a.tokens--
+	}
+
+	return "", false
+}
+
+// expirePendingLocked removes the pending connections that were not established within admissionPendingTimeout. a.mtx
+// must be held.
+func (a *admission) expirePendingLocked(now time.Time) {
+	for addr, admitted := range a.pending {
+		if now.Sub(admitted) > admissionPendingTimeout {
+			delete(a.pending, addr)
+		}
+	}
+}
+
+// pendingCount returns the number of admitted connections that are not yet established
+func (a *admission) pendingCount(now time.Time) int {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.expirePendingLocked(now)
+	return len(a.pending)
+}
+
+// retryLocked returns the time that a rejected agent should wait before retrying. a.mtx must be held.
+func (a *admission) retryLocked() time.Duration {
+	if a.jitter <= 0 {
+		return a.retryAfter
+	}
+	return a.retryAfter + time.Duration(a.random.Int63n(int64(a.jitter)))
+}
+
+// retry returns the time that an agent asked to reconnect should wait before reconnecting
+func (a *admission) retry() time.Duration {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return a.retryLocked()
+}
+
+// established removes the connection from the remote address from the pending connections
+func (a *admission) established(remoteAddr string) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	delete(a.pending, remoteAddr)
+}
+
+// reject counts a rejection and returns the time the agent should wait before retrying. It is used for agents that
+// are rejected after they have been admitted, e.g. an agent using the plain HTTP transport while draining.
+func (a *admission) reject(reason admissionReason) time.Duration {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.rejectLocked(reason)
+	return a.retryLocked()
+}
+
+// drain starts draining. New connections are rejected and each connected agent is asked to reconnect at a time
+// within the duration determined by its agent ID.
+func (a *admission) drain(now time.Time, duration time.Duration) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.drainStart = now
+	a.drainEnd = now.Add(duration)
+	a.asked = map[string]bool{}
+}
+
+// cancelDrain stops draining and admits new connections
+func (a *admission) cancelDrain() {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.drainStart = time.Time{}
+	a.drainEnd = time.Time{}
+	a.asked = map[string]bool{}
+}
+
+// drained returns true if the agent should be asked to reconnect
+func (a *admission) drained(agentID string, now time.Time) bool {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	return a.drainedLocked(agentID, now)
+}
+
+// drainedLocked returns true if the agent should be asked to reconnect. a.mtx must be held.
+func (a *admission) drainedLocked(agentID string, now time.Time) bool {
+	if a.drainStart.IsZero() {
+		return false
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(agentID))
+	offset := time.Duration(float64(a.drainEnd.Sub(a.drainStart)) * float64(hash.Sum32()) / float64(math.MaxUint32))
+	return !now.Before(a.drainStart.Add(offset))
+}
+
+// ask returns the agents that should be asked to reconnect and have not already been asked
+func (a *admission) ask(agentIDs []string, now time.Time) []string {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	result := []string{}
+	for _, agentID := range agentIDs {
+		if !a.asked[agentID] && a.drainedLocked(agentID, now) {
+			a.asked[agentID] = true
+			result = append(result, agentID)
+		}
+	}
+	return result
+}
+
+// status returns the current state of admission control
+func (a *admission) status() *model.AgentAdmissionResponse {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	rejected := map[string]int64{}
+	for reason, count := range a.rejected {
+		rejected[string(reason)] = count
+	}
+	response := &model.AgentAdmissionResponse{
+		Pending:    len(a.pending),
+		MaxPending: a.maxPending,
+		Rate:       a.rate,
+		Admitted:   a.admitted,
+		Rejected:   rejected,
+		Draining:   !a.drainStart.IsZero(),
+		Asked:      len(a.asked),
+	}
+	if response.Draining {
+		drainEnd := a.drainEnd
+		response.DrainEnds = &drainEnd
+	}
+	return response
+}
+
+// ----------------------------------------------------------------------
+
+// drainInterval is the interval between checks for agents that should be asked to reconnect while draining
+const drainInterval = time.Second
+
+// admit applies admission control to a connection. Polls from agents already connected using the plain HTTP transport
+// are only rejected when the agent is asked to reconnect by a drain.
+func (s *opampServer) admit(request *http.Request, headers *agentHeaders) (opamp.ConnectionResponse, bool) {
+	now := time.Now()
+
+	var reason admissionReason
+	var retryAfter time.Duration
+	if conn, polling := s.connections.connection(headers.id).(*httpConnection); polling && headers.id != "" {
+		if !s.admission.drained(headers.id, now) {
+			return opamp.ConnectionResponse{Accept: true, HTTPStatusCode: http.StatusOK}, true
+		}
+		// the agent will be connected again if it polls this server after the drain is canceled
+		s.connections.disconnect(conn)
+		reason, retryAfter = admissionDraining, s.admission.reject(admissionDraining)
+	} else {
+		var admitted bool
+		admitted, reason, retryAfter = s.admission.admit(request.RemoteAddr, now)
+		if admitted {
+			return opamp.ConnectionResponse{Accept: true, HTTPStatusCode: http.StatusOK}, true
+		}
+	}
+
+	status := http.StatusServiceUnavailable
+	if reason == admissionRate {
+		status = http.StatusTooManyRequests
+	}
+	s.logger.Debug("agent connection rejected by admission control",
+		zap.String("agentID", headers.id),
+		zap.String("reason", string(reason)),
+		zap.Duration("retryAfter", retryAfter),
+	)
+	return opamp.ConnectionResponse{
+		Accept:         false,
+		HTTPStatusCode: status,
+		HTTPResponseHeader: map[string]string{
+			"Retry-After": strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))),
+		},
+	}, false
+}
+
+// established removes the connection from the connections pending admission
+func (s *opampServer) established(conn opamp.Connection) {
+	if addr := conn.RemoteAddr(); addr != nil {
+		s.admission.established(addr.String())
+	}
+}
+
+// drainConnections asks connected agents to reconnect when their turn in the drain arrives and closes their WebSocket
+// connections. Agents using the plain HTTP transport are rejected on their next poll.
+func (s *opampServer) drainConnections(ctx context.Context, now time.Time) int {
+	agentIDs := s.admission.ask(s.connections.agentIDs(), now)
+	for _, agentID := range agentIDs {
+		conn := s.connections.connection(agentID)
+		if _, polling := conn.(*httpConnection); polling || conn == nil {
+			continue
+		}
+		err := s.send(ctx, conn, &protobufs.ServerToAgent{
+			InstanceUid:  agentID,
+			Capabilities: s.connections.version(conn).capabilities,
+			ErrorResponse: &protobufs.ServerErrorResponse{
+				Type:         protobufs.ServerErrorResponse_Unavailable,
+				ErrorMessage: "server is draining, reconnect later",
+				Details: &protobufs.ServerErrorResponse_RetryInfo{
+					RetryInfo: &protobufs.RetryInfo{RetryAfterNanoseconds: uint64(s.admission.retry())},
+				},
+			},
+		})
+		if err != nil {
+			s.logger.Error("unable to ask agent to reconnect", zap.String("agentID", agentID), zap.Error(err))
+		}
+		// opamp-go agents ignore the error response so the connection is closed to make them reconnect
+		if err := s.connections.close(conn); err != nil {
+			s.logger.Error("unable to close agent connection", zap.String("agentID", agentID), zap.Error(err))
+		}
+	}
+	return len(agentIDs)
+}
+
+// ----------------------------------------------------------------------
+// REST API
+
+// @Summary Get the admission control status of agent connections on this server
+// @Produce json
+// @Router /opamp/admission [get]
+// @Success 200 {object} model.AgentAdmissionResponse
+func getAdmission(c *gin.Context, s *opampServer) {
+	c.JSON(http.StatusOK, s.admission.status())
+}
+
+// @Summary Drain agent connections from this server before maintenance
+// @Description New agent connections are rejected and connected agents are asked to reconnect gradually over the duration.
+// @Produce json
+// @Router /opamp/drain [post]
+// @Param body body model.PostDrainRequest true "drain duration"
+// @Success 200 {object} model.AgentAdmissionResponse
+// @Failure 400 {object} rest.ErrorResponse
+func postDrain(c *gin.Context, s *opampServer) {
+	req := &model.PostDrainRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(err))
+		return
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err == nil && duration < 0 {
+		err = errors.New("must not be negative")
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(fmt.Errorf("invalid drain duration %s: %w", req.Duration, err)))
+		return
+	}
+
+	s.logger.Info("draining agent connections", zap.Duration("duration", duration))
+	s.admission.drain(time.Now(), duration)
+	c.JSON(http.StatusOK, s.admission.status())
+}
+
+// @Summary Cancel the drain of agent connections from this server
+// @Produce json
+// @Router /opamp/drain [delete]
+// @Success 200 {object} model.AgentAdmissionResponse
+func deleteDrain(c *gin.Context, s *opampServer) {
+	s.logger.Info("canceled drain of agent connections")
+	s.admission.cancelDrain()
+	c.JSON(http.StatusOK, s.admission.status())
+}
@@ -0,0 +1,318 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	opampSvr "github.com/open-telemetry/opamp-go/server"
+	opamp "github.com/open-telemetry/opamp-go/server/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+	"github.com/observiq/bindplane-op/internal/util/metrictest"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func testAdmission(config common.AgentAdmission) *admission {
+	config.Jitter = time.Nanosecond
+	a := newAdmission(&common.Server{AgentAdmission: config})
+	a.jitter = 0
+	return a
+}
+
+func TestAdmissionPending(t *testing.T) {
+	now := time.Now()
+	a := testAdmission(common.AgentAdmission{MaxPending: 2})
+
+	ok, _, _ := a.admit("a", now)
+	require.True(t, ok)
+	ok, _, _ = a.admit("b", now)
+	require.True(t, ok)
+	ok, reason, retryAfter := a.admit("c", now)
+	require.False(t, ok)
+	require.Equal(t, admissionPending, reason)
+	require.Equal(t, common.DefaultAgentAdmissionRetryAfter, retryAfter)
+
+	a.established("a")
+	ok, _, _ = a.admit("c", now)
+	require.True(t, ok)
+
+	// pending connections that are never established expire
+	ok, _, _ = a.admit("d", now.Add(admissionPendingTimeout+time.Second))
+	require.True(t, ok)
+
+	status := a.status()
+	require.Equal(t, 1, status.Pending)
+	require.Equal(t, int64(4), status.Admitted)
+	require.Equal(t, map[string]int64{"pending": 1}, status.Rejected)
+}
+
+func TestAdmissionRate(t *testing.T) {
+	now := time.Now()
+	a := testAdmission(common.AgentAdmission{Rate: 2})
+
+	for i := 0; i < 2; i++ {
+		ok, _, _ := a.admit(fmt.Sprintf("agent-%d", i), now)
+		require.True(t, ok)
+	}
+	ok, reason, _ := a.admit("agent-2", now)
+	require.False(t, ok)
+	require.Equal(t, admissionRate, reason)
+
+	ok, _, _ = a.admit("agent-2", now.Add(500*time.Millisecond))
+	require.True(t, ok)
+}
+
+func TestAdmissionMetrics(t *testing.T) {
+	now := time.Now()
+	meter := metrictest.NewMeter()
+	a := testAdmission(common.AgentAdmission{MaxPending: 1})
+	require.NoError(t, a.instrument(meter))
+
+	ok, _, _ := a.admit("a", now)
+	require.True(t, ok)
+	ok, _, _ = a.admit("b", now)
+	require.False(t, ok)
+	a.reject(admissionDraining)
+
+	require.Equal(t, 1.0, meter.Sum("bindplane.opamp.admission.admitted"))
+	require.Equal(t, 1.0, meter.Sum("bindplane.opamp.admission.rejected", attribute.String("reason", "pending")))
+	require.Equal(t, 1.0, meter.Sum("bindplane.opamp.admission.rejected", attribute.String("reason", "draining")))
+
+	meter.Collect(context.Background())
+	pending, ok := meter.Last("bindplane.opamp.admission.pending")
+	require.True(t, ok)
+	require.Equal(t, 1.0, pending)
+
+	a.established("a")
+	meter.Collect(context.Background())
+	pending, _ = meter.Last("bindplane.opamp.admission.pending")
+	require.Equal(t, 0.0, pending)
+}
+
+func TestAdmissionJitter(t *testing.T) {
+	a := newAdmission(&common.Server{AgentAdmission: common.AgentAdmission{RetryAfter: 10 * time.Second, Jitter: 5 * time.Second}})
+	for i := 0; i < 100; i++ {
+		retry := a.retry()
+		require.GreaterOrEqual(t, retry, 10*time.Second)
+		require.Less(t, retry, 15*time.Second)
+	}
+}
+
+func TestAdmissionDrain(t *testing.T) {
+	now := time.Now()
+	a := testAdmission(common.AgentAdmission{})
+
+	agentIDs := []string{}
+	for i := 0; i < 20; i++ {
+		agentIDs = append(agentIDs, fmt.Sprintf("agent-%d", i))
+	}
+	require.Empty(t, a.ask(agentIDs, now))
+
+	a.drain(now, 10*time.Minute)
+	ok, reason, _ := a.admit("new", now)
+	require.False(t, ok)
+	require.Equal(t, admissionDraining, reason)
+
+	// agents are asked to reconnect gradually and only once
+	halfway := a.ask(agentIDs, now.Add(5*time.Minute))
+	require.NotEmpty(t, halfway)
+	require.Less(t, len(halfway), len(agentIDs))
+	rest := a.ask(agentIDs, now.Add(10*time.Minute))
+	require.Len(t, rest, len(agentIDs)-len(halfway))
+	require.Empty(t, a.ask(agentIDs, now.Add(10*time.Minute)))
+
+	status := a.status()
+	require.True(t, status.Draining)
+	require.Equal(t, len(agentIDs), status.Asked)
+	require.Equal(t, now.Add(10*time.Minute), *status.DrainEnds)
+
+	a.cancelDrain()
+	require.False(t, a.drained("agent-0", now.Add(10*time.Minute)))
+	ok, _, _ = a.admit("new", now)
+	require.True(t, ok)
+}
+
+func TestOnConnectingAdmission(t *testing.T) {
+	manager := &mocks.Manager{}
+	manager.On("VerifySecretKey", mock.Anything, "secret").Return(true)
+	s := testServer(manager)
+	s.admission = testAdmission(common.AgentAdmission{MaxPending: 1, Rate: 1})
+
+	request := func(remoteAddr string) *http.Request {
+		return &http.Request{
+			RemoteAddr: remoteAddr,
+			Header: http.Header{
+				"Opamp-Version": []string{"v0.2.0"},
+				"Authorization": []string{"Secret-Key secret"},
+			},
+		}
+	}
+
+	response := s.OnConnecting(request("10.0.0.1:1000"))
+	require.True(t, response.Accept)
+
+	response = s.OnConnecting(request("10.0.0.2:1000"))
+	require.False(t, response.Accept)
+	require.Equal(t, http.StatusServiceUnavailable, response.HTTPStatusCode)
+	require.Equal(t, "10", response.HTTPResponseHeader["Retry-After"])
+
+	// the first connection is established after the first message
+	s.established(&testConnection{addr: testAddr{"10.0.0.1:1000"}})
+	response = s.OnConnecting(request("10.0.0.2:1000"))
+	require.False(t, response.Accept)
+	require.Equal(t, http.StatusTooManyRequests, response.HTTPStatusCode)
+}
+
+func TestDrainConnections(t *testing.T) {
+	manager := &mocks.Manager{}
+	s := testServer(manager)
+	s.admission = testAdmission(common.AgentAdmission{})
+
+	conn := &mocks.Connection{}
+	conn.On("Send", mock.Anything, mock.MatchedBy(func(msg *protobufs.ServerToAgent) bool {
+		return msg.GetErrorResponse().GetType() == protobufs.ServerErrorResponse_Unavailable &&
+			msg.GetErrorResponse().GetRetryInfo().GetRetryAfterNanoseconds() == uint64(common.DefaultAgentAdmissionRetryAfter)
+	})).Return(nil).Once()
+	s.connections.connect(conn, "websocket")
+	polling, _ := s.connections.poll("polling", "10.0.0.1:1000", time.Now())
+
+	now := time.Now()
+	require.Equal(t, 0, s.drainConnections(context.Background(), now))
+
+	// every agent is asked once the drain duration has passed
+	s.admission.drain(now.Add(-time.Minute), time.Minute)
+	require.Equal(t, 2, s.drainConnections(context.Background(), now))
+	require.Equal(t, 0, s.drainConnections(context.Background(), now))
+	conn.AssertExpectations(t)
+
+	// the polling agent is rejected on its next poll and its connection is removed
+	require.Nil(t, polling.pending)
+	response, ok := s.admit(&http.Request{RemoteAddr: "10.0.0.1:1000"}, &agentHeaders{id: "polling"})
+	require.False(t, ok)
+	require.Equal(t, http.StatusServiceUnavailable, response.HTTPStatusCode)
+	require.False(t, s.Connected("polling"))
+}
+
+func TestDrainConnectionsClosesWebSocket(t *testing.T) {
+	manager := &mocks.Manager{}
+	manager.On("VerifySecretKey", mock.Anything, "secret").Return(true)
+	manager.On("UpsertAgent", mock.Anything, "websocket", mock.Anything).Return(&model.Agent{}, nil)
+	s := testServer(manager)
+	s.admission = testAdmission(common.AgentAdmission{})
+
+	handler, err := opampSvr.New(zap.NewNop().Sugar()).Attach(opampSvr.Settings{Callbacks: s})
+	require.NoError(t, err)
+	httpServer := httptest.NewServer(s.handler(handler))
+	defer httpServer.Close()
+
+	header := http.Header{}
+	header.Set(headerOpAMPVersion, compatibleOpAMPVersions[0])
+	header.Set(headerAuthorization, "Secret-Key secret")
+	client, _, err := websocket.DefaultDialer.Dial(strings.Replace(httpServer.URL, "http", "ws", 1), header)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// find the connection created by the opamp-go server
+	var conn opamp.Connection
+	require.Eventually(t, func() bool {
+		s.connections.mtx.RLock()
+		defer s.connections.mtx.RUnlock()
+		for c := range s.connections.negotiations {
+			conn = c
+		}
+		return conn != nil
+	}, time.Second, 10*time.Millisecond)
+	s.connections.connect(conn, "websocket")
+
+	now := time.Now()
+	s.admission.drain(now.Add(-time.Minute), time.Minute)
+	require.Equal(t, 1, s.drainConnections(context.Background(), now))
+
+	// the agent receives the error response and then the connection is closed
+	_, bytes, err := client.ReadMessage()
+	require.NoError(t, err)
+	message := &protobufs.ServerToAgent{}
+	require.NoError(t, proto.Unmarshal(bytes, message))
+	require.Equal(t, protobufs.ServerErrorResponse_Unavailable, message.GetErrorResponse().GetType())
+
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+	_, _, err = client.ReadMessage()
+	require.Error(t, err)
+	var netErr interface{ Timeout() bool }
+	if errors.As(err, &netErr) {
+		require.False(t, netErr.Timeout(), "connection should be closed by the server")
+	}
+
+	require.Eventually(t, func() bool { return !s.Connected("websocket") }, time.Second, 10*time.Millisecond)
+}
+
+func TestDrainRoutes(t *testing.T) {
+	s := testServer(&mocks.Manager{})
+	s.admission = testAdmission(common.AgentAdmission{})
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/admission", func(c *gin.Context) { getAdmission(c, s) })
+	router.POST("/drain", func(c *gin.Context) { postDrain(c, s) })
+	router.DELETE("/drain", func(c *gin.Context) { deleteDrain(c, s) })
+
+	do := func(method, body string) (*httptest.ResponseRecorder, *model.AgentAdmissionResponse) {
+		path := "/drain"
+		if method == http.MethodGet {
+			path = "/admission"
+		}
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(method, path, strings.NewReader(body)))
+		status := &model.AgentAdmissionResponse{}
+		_ = json.Unmarshal(recorder.Body.Bytes(), status)
+		return recorder, status
+	}
+
+	recorder, status := do(http.MethodGet, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.False(t, status.Draining)
+
+	recorder, _ = do(http.MethodPost, `{"duration":"soon"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = do(http.MethodPost, `{"duration":"-1m"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder, status = do(http.MethodPost, `{"duration":"5m"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.True(t, status.Draining)
+	require.NotNil(t, status.DrainEnds)
+
+	recorder, status = do(http.MethodDelete, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.False(t, status.Draining)
+}
@@ -16,6 +16,9 @@ package opamp
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
 
 	"github.com/open-telemetry/opamp-go/protobufs"
 	opamp "github.com/open-telemetry/opamp-go/server/types"
@@ -37,32 +40,94 @@ type agentDescription struct {
 	Version         string
 }
 
-func parseAgentDescription(desc *protobufs.AgentDescription) *agentDescription {
-	labels := stringValue("service.labels", desc.NonIdentifyingAttributes)
+func parseAgentDescription(desc *protobufs.AgentDescription, attributes agentDescriptionAttributes) *agentDescription {
+	labels := stringValue(attributes.labels, desc.NonIdentifyingAttributes)
 	if labels == "" {
 		// check IdentifyingAttributes for compatibility with existing agents
 		// TODO: remove when those agents are no longer supported
-		labels = stringValue("service.labels", desc.IdentifyingAttributes)
+		labels = stringValue(attributes.labels, desc.IdentifyingAttributes)
 	}
 	return &agentDescription{
-		AgentID:         stringValue("service.instance.id", desc.IdentifyingAttributes),
-		AgentName:       stringValue("service.instance.name", desc.IdentifyingAttributes),
-		AgentType:       stringValue("service.name", desc.IdentifyingAttributes),
-		Version:         stringValue("service.version", desc.IdentifyingAttributes),
+		AgentID:         stringValue(attributes.id, desc.IdentifyingAttributes),
+		AgentName:       stringValue(attributes.name, desc.IdentifyingAttributes),
+		AgentType:       stringValue(attributes.agentType, desc.IdentifyingAttributes),
+		Version:         stringValue(attributes.version, desc.IdentifyingAttributes),
 		Labels:          labels,
-		Architecture:    stringValue("os.arch", desc.NonIdentifyingAttributes),
-		OperatingSystem: stringValue("os.details", desc.NonIdentifyingAttributes),
-		Platform:        stringValue("os.family", desc.NonIdentifyingAttributes),
-		Hostname:        stringValue("host.name", desc.NonIdentifyingAttributes),
-		MacAddress:      stringValue("host.mac_address", desc.NonIdentifyingAttributes),
+		Architecture:    stringValue(attributes.architecture, desc.NonIdentifyingAttributes),
+		OperatingSystem: stringValue(attributes.operatingSystem, desc.NonIdentifyingAttributes),
+		Platform:        stringValue(attributes.platform, desc.NonIdentifyingAttributes),
+		Hostname:        stringValue(attributes.hostname, desc.NonIdentifyingAttributes),
+		MacAddress:      stringValue(attributes.macAddress, desc.NonIdentifyingAttributes),
 	}
 }
 
-func stringValue(key string, fields []*protobufs.KeyValue) string {
+// stringValue returns the value of the first key with a value
+func stringValue(keys []string, fields []*protobufs.KeyValue) string {
+	for _, key := range keys {
+		for _, kv := range fields {
+			if key == kv.Key {
+				if value := kv.Value.GetStringValue(); value != "" {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// agentAttributes returns all of the attributes in the agent description with the values converted to strings
+func agentAttributes(desc *protobufs.AgentDescription) *model.AgentAttributes {
+	identifying := attributeValues(desc.GetIdentifyingAttributes())
+	nonIdentifying := attributeValues(desc.GetNonIdentifyingAttributes())
+	if identifying == nil && nonIdentifying == nil {
+		return nil
+	}
+	return &model.AgentAttributes{
+		Identifying:    identifying,
+		NonIdentifying: nonIdentifying,
+	}
+}
+
+func attributeValues(fields []*protobufs.KeyValue) map[string]string {
+	if len(fields) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(fields))
 	for _, kv := range fields {
-		if key == kv.Key {
-			return kv.Value.GetStringValue()
+		if kv.GetKey() == "" {
+			continue
+		}
+		values[kv.Key] = anyValueString(kv.Value)
+	}
+	return values
+}
+
+// anyValueString returns the string representation of the value. Arrays are comma separated and key value lists are
+// formatted like labels, e.g. key1=value1,key2=value2.
+func anyValueString(value *protobufs.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *protobufs.AnyValue_StringValue:
+		return v.StringValue
+	case *protobufs.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *protobufs.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *protobufs.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64)
+	case *protobufs.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue)
+	case *protobufs.AnyValue_ArrayValue:
+		values := make([]string, 0, len(v.ArrayValue.GetValues()))
+		for _, value := range v.ArrayValue.GetValues() {
+			values = append(values, anyValueString(value))
+		}
+		return strings.Join(values, ",")
+	case *protobufs.AnyValue_KvlistValue:
+		values := make([]string, 0, len(v.KvlistValue.GetValues()))
+		for _, kv := range v.KvlistValue.GetValues() {
+			values = append(values, kv.GetKey()+"="+anyValueString(kv.GetValue()))
 		}
+		return strings.Join(values, ",")
 	}
 	return ""
 }
@@ -108,15 +173,18 @@ func (s *agentDescriptionSyncer) agentCapabilitiesFlag() protobufs.AgentCapabili
 
 func (s *agentDescriptionSyncer) update(ctx context.Context, logger *zap.Logger, state *agentState, conn opamp.Connection, agent *model.Agent, value *protobufs.AgentDescription) error {
 	state.Status.AgentDescription = value
-	updateOpAmpAgentDetails(agent, conn, value)
+	updateOpAmpAgentDetails(agent, conn, opampVersionFromContext(ctx), value)
 	return nil
 }
 
-func updateOpAmpAgentDetails(agent *model.Agent, conn opamp.Connection, desc *protobufs.AgentDescription) {
-	ad := parseAgentDescription(desc)
-	if ad.AgentID != "" {
+func updateOpAmpAgentDetails(agent *model.Agent, conn opamp.Connection, version *opampVersion, desc *protobufs.AgentDescription) {
+	ad := parseAgentDescription(desc, version.attributes)
+	// the ID of a stored agent is not changed by the description, which may still report the ID used before the agent
+	// was assigned a new one
+	if agent.ID == "" {
 		agent.ID = ad.AgentID
 	}
+	ad.AgentID = agent.ID
 	agent.Type = ad.AgentType
 	agent.Architecture = ad.Architecture
 	agent.Name = ad.AgentName
@@ -126,6 +194,7 @@ func updateOpAmpAgentDetails(agent *model.Agent, conn opamp.Connection, desc *pr
 	agent.Labels = ad.labels()
 	agent.Version = ad.Version
 	agent.MacAddress = ad.MacAddress
+	agent.Attributes = agentAttributes(desc)
 	if addr := conn.RemoteAddr(); addr != nil {
 		agent.RemoteAddress = addr.String()
 	} else {
@@ -0,0 +1,132 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"testing"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestAnyValueString(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  *protobufs.AnyValue
+		expect string
+	}{
+		{
+			name:   "nil",
+			value:  nil,
+			expect: "",
+		},
+		{
+			name:   "string",
+			value:  &protobufs.AnyValue{Value: &protobufs.AnyValue_StringValue{StringValue: "us-east1"}},
+			expect: "us-east1",
+		},
+		{
+			name:   "bool",
+			value:  &protobufs.AnyValue{Value: &protobufs.AnyValue_BoolValue{BoolValue: true}},
+			expect: "true",
+		},
+		{
+			name:   "int",
+			value:  &protobufs.AnyValue{Value: &protobufs.AnyValue_IntValue{IntValue: -42}},
+			expect: "-42",
+		},
+		{
+			name:   "double",
+			value:  &protobufs.AnyValue{Value: &protobufs.AnyValue_DoubleValue{DoubleValue: 1.5}},
+			expect: "1.5",
+		},
+		{
+			name:   "bytes",
+			value:  &protobufs.AnyValue{Value: &protobufs.AnyValue_BytesValue{BytesValue: []byte("id")}},
+			expect: "aWQ=",
+		},
+		{
+			name: "array",
+			value: &protobufs.AnyValue{Value: &protobufs.AnyValue_ArrayValue{ArrayValue: &protobufs.ArrayValue{
+				Values: []*protobufs.AnyValue{
+					{Value: &protobufs.AnyValue_StringValue{StringValue: "a"}},
+					{Value: &protobufs.AnyValue_IntValue{IntValue: 1}},
+				},
+			}}},
+			expect: "a,1",
+		},
+		{
+			name: "kvlist",
+			value: &protobufs.AnyValue{Value: &protobufs.AnyValue_KvlistValue{KvlistValue: &protobufs.KeyValueList{
+				Values: []*protobufs.KeyValue{
+					stringKeyValue("env", "production"),
+					stringKeyValue("team", "ops"),
+				},
+			}}},
+			expect: "env=production,team=ops",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expect, anyValueString(test.value))
+		})
+	}
+}
+
+func TestUpdateOpAmpAgentDetailsAttributes(t *testing.T) {
+	desc := &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("service.instance.id", "1"),
+			stringKeyValue("service.name", "com.observiq.collector"),
+		},
+		NonIdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("host.name", "host-1"),
+			stringKeyValue("cloud.region", "us-east1"),
+			stringKeyValue("k8s.cluster.name", "prod"),
+			{Key: "host.cpus", Value: &protobufs.AnyValue{Value: &protobufs.AnyValue_IntValue{IntValue: 8}}},
+		},
+	}
+
+	agent := &model.Agent{}
+	conn := &testConnection{agentID: "1", addr: testAddr{"10.0.0.1:5000"}}
+	updateOpAmpAgentDetails(agent, conn, opampV020, desc)
+
+	require.Equal(t, "1", agent.ID)
+	require.Equal(t, "host-1", agent.HostName)
+	require.Equal(t, &model.AgentAttributes{
+		Identifying: map[string]string{
+			"service.instance.id": "1",
+			"service.name":        "com.observiq.collector",
+		},
+		NonIdentifying: map[string]string{
+			"host.name":        "host-1",
+			"cloud.region":     "us-east1",
+			"k8s.cluster.name": "prod",
+			"host.cpus":        "8",
+		},
+	}, agent.Attributes)
+
+	// attributes that are no longer reported are removed
+	updateOpAmpAgentDetails(agent, conn, opampV020, &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{stringKeyValue("service.instance.id", "1")},
+	})
+	require.Equal(t, map[string]string{"service.instance.id": "1"}, agent.Attributes.Identifying)
+	require.Nil(t, agent.Attributes.NonIdentifying)
+
+	updateOpAmpAgentDetails(agent, conn, opampV020, &protobufs.AgentDescription{})
+	require.Nil(t, agent.Attributes)
+}
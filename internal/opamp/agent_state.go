@@ -36,9 +36,19 @@ var (
 )
 
 func (s *opampServer) updateAgentState(ctx context.Context, agentID string, conn opamp.Connection, msg *protobufs.AgentToServer, response *protobufs.ServerToAgent) (agent *model.Agent, state *agentState, err error) {
+	// label rules are evaluated when the agent connects and reports its description. They are retrieved before the
+	// update because the store may not allow reads during the update.
+	var labelRules []*model.LabelRule
+	if msg.GetAgentDescription() != nil {
+		labelRules, err = s.manager.LabelRules(ctx)
+		if err != nil {
+			s.logger.Error("unable to retrieve label rules", zap.Error(err))
+		}
+	}
+
 	agent, err = s.manager.UpsertAgent(ctx, agentID, func(agent *model.Agent) {
-		// we're using opamp
-		agent.Protocol = ProtocolName
+		// we're using opamp with the negotiated version
+		agent.Protocol = opampVersionFromContext(ctx).protocol()
 
 		// decode the state which we will update
 		state, err = decodeState(agent.State)
@@ -51,6 +61,11 @@ func (s *opampServer) updateAgentState(ctx context.Context, agentID string, conn
 		syncOne[*protobufs.RemoteConfigStatus](ctx, s.logger, msg, state, conn, agent, response, &syncRemoteConfigStatus)
 		syncOne[*protobufs.PackageStatuses](ctx, s.logger, msg, state, conn, agent, response, &syncPackageStatuses)
 
+		// labels set by rules that differ from the labels reported by the agent are sent to the agent after the update
+		if model.ApplyLabelRules(agent, labelRules) {
+			s.logger.Info("labeled agent using label rules", zap.String("agentID", agent.ID), zap.String("labels", agent.Labels.String()))
+		}
+
 		// after sync, update sequence number
 		state.SequenceNum = msg.GetSequenceNum()
 
@@ -66,6 +81,9 @@ func (s *opampServer) updateAgentState(ctx context.Context, agentID string, conn
 
 		// the state could be new
 		agent.State = encodeState(state)
+
+		// the agent may be using connection settings offered by the server
+		s.acceptConnectionSettings(conn, agent)
 	})
 
 	return agent, state, err
@@ -15,12 +15,16 @@
 package opamp
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
 	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -112,3 +116,71 @@ func TestSerializeState(t *testing.T) {
 	}
 
 }
+
+func TestUpdateAgentStateLabelRules(t *testing.T) {
+	rule := model.NewLabelRule("web", model.LabelRuleSpec{
+		Match:  model.LabelRuleMatch{HostName: "^web-", Platform: "linux"},
+		Labels: map[string]string{"tier": "web"},
+	})
+
+	kv := func(key, value string) *protobufs.KeyValue {
+		return &protobufs.KeyValue{Key: key, Value: &protobufs.AnyValue{Value: &protobufs.AnyValue_StringValue{StringValue: value}}}
+	}
+
+	tests := []struct {
+		name        string
+		description *protobufs.AgentDescription
+		expectRules bool
+		expectLabel string
+	}{
+		{
+			name: "matching agent connects",
+			description: &protobufs.AgentDescription{
+				IdentifyingAttributes:    []*protobufs.KeyValue{kv("service.instance.id", "1")},
+				NonIdentifyingAttributes: []*protobufs.KeyValue{kv("host.name", "web-1"), kv("os.family", "linux")},
+			},
+			expectRules: true,
+			expectLabel: "web",
+		},
+		{
+			name: "other agent connects",
+			description: &protobufs.AgentDescription{
+				IdentifyingAttributes:    []*protobufs.KeyValue{kv("service.instance.id", "1")},
+				NonIdentifyingAttributes: []*protobufs.KeyValue{kv("host.name", "db-1"), kv("os.family", "linux")},
+			},
+			expectRules: true,
+		},
+		{
+			name: "status without description",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manager := &mocks.Manager{}
+			conn := &mocks.Connection{}
+			conn.On("RemoteAddr").Return(&TestAddr{network: "tcp", address: "10.0.0.1:5000"})
+			manager.On("LabelRules", mock.Anything).Return([]*model.LabelRule{rule}, nil)
+			manager.On("UpsertAgent", mock.Anything, "1", mock.Anything).Return(
+				func(_ context.Context, agentID string, updater store.AgentUpdater) *model.Agent {
+					agent := &model.Agent{ID: agentID}
+					updater(agent)
+					return agent
+				}, nil)
+
+			s := testServer(manager)
+			s.connections.connect(conn, "1")
+
+			msg := &protobufs.AgentToServer{InstanceUid: "1", AgentDescription: test.description}
+			agent, _, err := s.updateAgentState(context.Background(), "1", conn, msg, &protobufs.ServerToAgent{})
+			require.NoError(t, err)
+			require.Equal(t, test.expectLabel, agent.Labels.Get("tier"))
+
+			if test.expectRules {
+				manager.AssertCalled(t, "LabelRules", mock.Anything)
+			} else {
+				manager.AssertNotCalled(t, "LabelRules", mock.Anything)
+			}
+		})
+	}
+}
@@ -17,9 +17,11 @@ package opamp
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/open-telemetry/opamp-go/protobufs"
@@ -27,21 +29,25 @@ import (
 	opamp "github.com/open-telemetry/opamp-go/server/types"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/server/auth"
 	"github.com/observiq/bindplane-op/model"
 	"github.com/observiq/bindplane-op/model/observiq"
 )
 
 var tracer = otel.Tracer("bindplane/opamp")
+var meter = global.Meter("bindplane/opamp")
 
 // ProtocolName is "opamp"
 const ProtocolName = "opamp"
 
-var compatibleOpAMPVersions = []string{"v0.2.0"}
+var compatibleOpAMPVersions = opampVersionNames(opampVersions)
 
 const (
 	headerAuthorization = "Authorization"
@@ -52,11 +58,16 @@ const (
 	headerAgentHostname = "Agent-Hostname"
 )
 
-// AddRoutes adds the routes used by opamp, currently /v1/opamp
-func AddRoutes(router gin.IRouter, bindplane server.BindPlane) error {
+// AddRoutes adds the routes used by opamp, currently /v1/opamp. Connections are monitored until the context is done.
+func AddRoutes(ctx context.Context, router gin.IRouter, bindplane server.BindPlane) error {
 	server := opampSvr.New(bindplane.Logger().Sugar())
 
 	callbacks := newServer(bindplane.Manager(), bindplane.Logger())
+	callbacks.admission = newAdmission(bindplane.Config())
+	if err := callbacks.admission.instrument(meter); err != nil {
+		bindplane.Logger().Error("unable to record agent admission metrics", zap.Error(err))
+	}
+	callbacks.endpoint = fmt.Sprintf("%s/v1/opamp", bindplane.Config().WebsocketURL())
 	settings := opampSvr.Settings{
 		Callbacks: callbacks,
 	}
@@ -66,22 +77,36 @@ func AddRoutes(router gin.IRouter, bindplane server.BindPlane) error {
 		return fmt.Errorf("error attempting to attach the OpAMP server: %w", err)
 	}
 
-	router.Any("/opamp", gin.WrapF(http.HandlerFunc(handler)))
+	// agents using the plain HTTP transport poll the same path used for WebSocket connections
+	router.Any("/opamp", gin.WrapF(callbacks.handler(handler)))
+
+	// admission control, drain status, and migrating agents require login
+	admin := router.Group("/opamp", auth.Chain(bindplane)...)
+	admin.GET("/admission", func(c *gin.Context) { getAdmission(c, callbacks) })
+	admin.POST("/drain", func(c *gin.Context) { postDrain(c, callbacks) })
+	admin.DELETE("/drain", func(c *gin.Context) { deleteDrain(c, callbacks) })
+	admin.POST("/migrate", func(c *gin.Context) { postMigrate(c, callbacks) })
 
 	bindplane.Manager().EnableProtocol(callbacks)
 
+	go callbacks.monitor(ctx)
+
 	return nil
 }
 
 const (
-	capabilities = protobufs.ServerCapabilities_AcceptsStatus | protobufs.ServerCapabilities_AcceptsEffectiveConfig | protobufs.ServerCapabilities_OffersRemoteConfig
+	capabilities = protobufs.ServerCapabilities_AcceptsStatus | protobufs.ServerCapabilities_AcceptsEffectiveConfig | protobufs.ServerCapabilities_OffersRemoteConfig | protobufs.ServerCapabilities_OffersConnectionSettings
 )
 
 type opampServer struct {
 	manager                 server.Manager
 	connections             *connections
 	compatibleOpAMPVersions []string
-	logger                  *zap.Logger
+	pollTimeout             time.Duration
+	admission               *admission
+	// endpoint is the OpAMP endpoint of this server offered to agents by default
+	endpoint string
+	logger   *zap.Logger
 }
 
 var _ server.Protocol = (*opampServer)(nil)
@@ -92,6 +117,8 @@ func newServer(manager server.Manager, logger *zap.Logger) *opampServer {
 		manager:                 manager,
 		connections:             newConnections(),
 		compatibleOpAMPVersions: compatibleOpAMPVersions,
+		pollTimeout:             httpPollTimeout,
+		admission:               newAdmission(&common.Server{}),
 		logger:                  logger,
 	}
 }
@@ -129,7 +156,7 @@ func (s *opampServer) OnConnecting(request *http.Request) opamp.ConnectionRespon
 			Accept:         false,
 			HTTPStatusCode: http.StatusUpgradeRequired,
 			HTTPResponseHeader: map[string]string{
-				"Upgrade": fmt.Sprintf("OpAMP/%s", s.compatibleOpAMPVersions[0]),
+				"Upgrade": upgradeHeader(s.compatibleOpAMPVersions),
 			},
 		}
 	}
@@ -142,12 +169,33 @@ func (s *opampServer) OnConnecting(request *http.Request) opamp.ConnectionRespon
 		}
 	}
 
+	if response, ok := s.admit(request, headers); !ok {
+		span.SetAttributes(attribute.Int("bindplane.opamp.status", response.HTTPStatusCode))
+		return response
+	}
+
+	// remember the version and the endpoint used by the agent until the connection is established
+	s.connections.negotiate(request.RemoteAddr, &negotiation{
+		version:  findOpAMPVersion(headers.opampVersion),
+		endpoint: requestEndpoint(request),
+		header:   request.Header.Clone(),
+	}, time.Now())
+
 	return opamp.ConnectionResponse{
 		Accept:         true,
 		HTTPStatusCode: http.StatusOK,
 	}
 }
 
+// upgradeHeader returns the value of the Upgrade header listing the compatible versions in order of preference
+func upgradeHeader(versions []string) string {
+	protocols := make([]string, 0, len(versions))
+	for _, version := range versions {
+		protocols = append(protocols, fmt.Sprintf("OpAMP/%s", version))
+	}
+	return strings.Join(protocols, ", ")
+}
+
 type agentHeaders struct {
 	opampVersion string
 	id           string
@@ -175,10 +223,13 @@ func parseAgentHeaders(request *http.Request) *agentHeaders {
 // OnConnected is called when the WebSocket connection is successfully established after OnConnecting() returns and the
 // HTTP connection is upgraded to WebSocket.
 //
-// opamp.Connection doesn't have much information that we can use here
+// opamp.Connection doesn't have much information that we can use here, but the version negotiated in OnConnecting is
+// associated with the connection using its remote address.
 func (s *opampServer) OnConnected(conn opamp.Connection) {
 	_, span := tracer.Start(context.TODO(), "opamp/connected")
 	defer span.End()
+
+	s.connections.accept(conn)
 }
 
 // OnMessage is called when a message is received from the connection. Can happen
@@ -189,21 +240,35 @@ func (s *opampServer) OnMessage(conn opamp.Connection, message *protobufs.AgentT
 
 	agentID := message.InstanceUid
 	hasConfiguration := message.GetEffectiveConfig().GetConfigMap() != nil
+	version := s.connections.version(conn)
+	ctx = withOpAMPVersion(ctx, version)
 
 	span.SetAttributes(
 		attribute.String("bindplane.agent.id", agentID),
 		attribute.String("bindplane.component", "opamp"),
 		attribute.Bool("bindplane.opamp.hasConfiguration", hasConfiguration),
+		attribute.String("bindplane.opamp.version", version.version),
 	)
 
 	s.logger.Info("OpAMP agent message", zap.String("agentID", agentID), zap.Strings("submessages", messageComponents(message)))
-	s.connections.connect(conn, agentID)
 
 	response := &protobufs.ServerToAgent{
 		InstanceUid:  agentID,
-		Capabilities: capabilities,
+		Capabilities: version.capabilities,
 	}
 
+	// an agent using the ID of another agent is assigned a new ID and the message is handled using the new ID
+	if duplicate := s.duplicateAgent(ctx, conn, message); duplicate != nil {
+		message = s.reassignAgentID(ctx, conn, message, duplicate, response)
+		agentID = message.InstanceUid
+		span.SetAttributes(attribute.String("bindplane.agent.duplicateOf", duplicate.OriginalID))
+	}
+
+	s.connections.connect(conn, agentID)
+	s.connections.reportCapabilities(conn, message.GetCapabilities())
+	// the connection is no longer pending admission after the first message has been handled
+	defer s.established(conn)
+
 	// verify the configuration and modify the response message
 	err := s.verifyAgentConfig(ctx, conn, agentID, message, response)
 	if err != nil {
@@ -222,7 +287,8 @@ func (s *opampServer) OnMessage(conn opamp.Connection, message *protobufs.AgentT
 
 // OnConnectionClose is called when the WebSocket connection is closed.
 // Typically, preceded by OnDisconnect() unless the client misbehaves or the
-// connection is lost.
+// connection is lost. It is also called when an agent using the plain HTTP
+// transport stops polling.
 func (s *opampServer) OnConnectionClose(conn opamp.Connection) {
 	ctx, span := tracer.Start(context.TODO(), "opamp/disconnected")
 	defer span.End()
@@ -230,6 +296,7 @@ func (s *opampServer) OnConnectionClose(conn opamp.Connection) {
 	agentID := s.connections.agentID(conn)
 	s.logger.Info("OpAMP agent disconnected", zap.String("AgentID", agentID))
 	s.connections.disconnect(conn)
+	s.established(conn)
 	if agentID == "" {
 		return
 	}
@@ -290,7 +357,7 @@ func (s *opampServer) UpdateAgent(ctx context.Context, agent *model.Agent, updat
 		agentConfiguration = &observiq.AgentConfiguration{}
 	}
 
-	newConfiguration, err := s.updatedConfiguration(ctx, agentConfiguration, updates)
+	newConfiguration, err := s.updatedConfiguration(ctx, agent, agentConfiguration, updates)
 	if err != nil {
 		return fmt.Errorf("unable to get the new configuration for agent [%s]: %w", agent.ID, err)
 	}
@@ -308,15 +375,19 @@ func (s *opampServer) UpdateAgent(ctx context.Context, agent *model.Agent, updat
 
 	return s.send(context.Background(), conn, &protobufs.ServerToAgent{
 		InstanceUid:  agent.ID,
-		Capabilities: capabilities,
+		Capabilities: s.connections.version(conn).capabilities,
 		RemoteConfig: agentRemoteConfig(&newRawConfiguration, &agentRawConfiguration),
 		Flags:        protobufs.ServerToAgent_ReportFullState,
 	})
 }
 
-// SendHeartbeat sends a heartbeat to the agent to keep the websocket open
+// SendHeartbeat sends a heartbeat to the agent to keep the websocket open. Agents using the plain HTTP transport poll
+// the server and do not need heartbeats.
 func (s *opampServer) SendHeartbeat(agentID string) error {
 	conn := s.connections.connection(agentID)
+	if _, polling := conn.(*httpConnection); polling {
+		return nil
+	}
 	if conn != nil {
 		return s.send(context.Background(), conn, &protobufs.ServerToAgent{})
 	}
@@ -325,6 +396,9 @@ func (s *opampServer) SendHeartbeat(agentID string) error {
 
 func (s *opampServer) send(ctx context.Context, conn opamp.Connection, msg *protobufs.ServerToAgent) error {
 	lock := s.connections.sendLock(conn)
+	if lock == nil {
+		return errors.New("connection is closed")
+	}
 	lock.Lock()
 	defer lock.Unlock()
 	return conn.Send(ctx, msg)
@@ -365,7 +439,7 @@ func (s *opampServer) updateAgentConfig(ctx context.Context, agent *model.Agent,
 		return fmt.Errorf("unable to get agent updates [%s]: %w", agent.ID, err)
 	}
 
-	serverConfiguration, err := s.updatedConfiguration(ctx, agentConfiguration, updates)
+	serverConfiguration, err := s.updatedConfiguration(ctx, agent, agentConfiguration, updates)
 	if err != nil {
 		return fmt.Errorf("unable to compute the updated agent configuration [%s]: %w", agent.ID, err)
 	}
@@ -398,10 +472,11 @@ func (s *opampServer) updateAgentConfig(ctx context.Context, agent *model.Agent,
 	return nil
 }
 
-func (s *opampServer) updatedConfiguration(ctx context.Context, agentConfiguration *observiq.AgentConfiguration, updates *server.AgentUpdates) (diff observiq.AgentConfiguration, err error) {
+func (s *opampServer) updatedConfiguration(ctx context.Context, agent *model.Agent, agentConfiguration *observiq.AgentConfiguration, updates *server.AgentUpdates) (diff observiq.AgentConfiguration, err error) {
 	// Configuration => collector.yaml
 	if updates.Configuration != nil {
-		newCollectorYAML, err := updates.Configuration.Render(ctx, s.manager.ResourceStore())
+		renderer := server.ConfigurationRendererFromContext(ctx, s.manager.ResourceStore())
+		newCollectorYAML, err := renderer.Render(ctx, updates.Configuration, agent)
 		if err != nil {
 			return diff, err
 		}
@@ -115,7 +115,7 @@ func TestUpdateOpAmpAgentDetails(t *testing.T) {
 		},
 	}
 
-	updateOpAmpAgentDetails(&agent, conn, desc)
+	updateOpAmpAgentDetails(&agent, conn, defaultOpAMPVersion, desc)
 
 	require.Nil(t, agent.DisconnectedAt)
 	require.Equal(t, "instance.id", agent.ID)
@@ -166,7 +166,7 @@ func TestUpdateOpAmpAgentDetails2(t *testing.T) {
 		},
 	}
 
-	updateOpAmpAgentDetails(&agent, conn, desc)
+	updateOpAmpAgentDetails(&agent, conn, defaultOpAMPVersion, desc)
 
 	require.Nil(t, agent.DisconnectedAt)
 	require.Equal(t, "instance.id", agent.ID)
@@ -217,7 +217,7 @@ func TestUpdateOpAmpAgentDetails3(t *testing.T) {
 		},
 	}
 
-	updateOpAmpAgentDetails(&agent, conn, desc)
+	updateOpAmpAgentDetails(&agent, conn, defaultOpAMPVersion, desc)
 
 	require.Nil(t, agent.DisconnectedAt)
 	require.Equal(t, "instance.id", agent.ID)
@@ -0,0 +1,299 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	opamp "github.com/open-telemetry/opamp-go/server/types"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/rest"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// connectionSettingsOffer is the OpAMP connection settings offered to agents along with the endpoint and header values
+// used to determine if an agent has accepted them
+type connectionSettingsOffer struct {
+	settings *protobufs.OpAMPConnectionSettings
+	endpoint string
+	headers  map[string]string
+}
+
+// newConnectionSettingsOffer returns the offer for the request. The endpoint of this server is used if the request
+// does not specify one.
+func newConnectionSettingsOffer(request *model.PostMigrateRequest, defaultEndpoint string) (*connectionSettingsOffer, error) {
+	endpoint := request.Endpoint
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+	}
+	switch u.Scheme {
+	case "ws", "wss", "http", "https":
+	default:
+		return nil, fmt.Errorf("invalid endpoint %s: scheme must be ws, wss, http, or https", endpoint)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid endpoint %s: missing host", endpoint)
+	}
+
+	headers := map[string]string{}
+	for name, value := range request.Headers {
+		headers[http.CanonicalHeaderKey(name)] = value
+	}
+	if request.SecretKey != "" {
+		if _, ok := headers[headerAuthorization]; ok {
+			return nil, errors.New("secret key cannot be specified with an Authorization header")
+		}
+		headers[headerAuthorization] = fmt.Sprintf("Secret-Key %s", request.SecretKey)
+	}
+
+	settings := &protobufs.OpAMPConnectionSettings{
+		DestinationEndpoint: endpoint,
+	}
+	if len(headers) > 0 {
+		settings.Headers = &protobufs.Headers{}
+		for _, name := range sortedHeaderNames(headers) {
+			settings.Headers.Headers = append(settings.Headers.Headers, &protobufs.Header{Key: name, Value: headers[name]})
+		}
+	}
+
+	switch {
+	case request.Certificate != "" && request.PrivateKey != "":
+		settings.Certificate = &protobufs.TLSCertificate{
+			PublicKey:   []byte(request.Certificate),
+			PrivateKey:  []byte(request.PrivateKey),
+			CaPublicKey: []byte(request.CertificateAuthority),
+		}
+	case request.Certificate != "" || request.PrivateKey != "":
+		return nil, errors.New("certificate and private key must be specified together")
+	case request.CertificateAuthority != "":
+		return nil, errors.New("certificate authority requires a certificate and private key")
+	}
+
+	return &connectionSettingsOffer{
+		settings: settings,
+		endpoint: endpoint,
+		headers:  headers,
+	}, nil
+}
+
+// message returns the ConnectionSettingsOffers message sent to agents
+func (o *connectionSettingsOffer) message() (*protobufs.ConnectionSettingsOffers, error) {
+	data, err := proto.Marshal(o.settings)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return &protobufs.ConnectionSettingsOffers{
+		Hash:  hash[:],
+		Opamp: o.settings,
+	}, nil
+}
+
+// agentConnectionSettings returns the connection settings recorded on agents sent the offer
+func (o *connectionSettingsOffer) agentConnectionSettings(now time.Time) *model.AgentConnectionSettings {
+	names := sortedHeaderNames(o.headers)
+	return &model.AgentConnectionSettings{
+		Status:    model.ConnectionSettingsOffered,
+		Endpoint:  o.endpoint,
+		Headers:   names,
+		Hash:      connectionSettingsHash(endpointHostPath(o.endpoint), names, func(name string) string { return o.headers[name] }),
+		OfferedAt: &now,
+	}
+}
+
+// connectionSettingsHash returns a hash of the endpoint and the values of the named headers. It is used to compare the
+// offered settings with the settings used by an agent to connect without storing the header values.
+func connectionSettingsHash(endpoint string, names []string, value func(name string) string) string {
+	h := sha256.New()
+	fmt.Fprintln(h, endpoint)
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, value(name))
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// endpointHostPath returns the host and path of the endpoint without the scheme or default port. The scheme used by the
+// agent is not known if TLS is terminated by a proxy.
+func endpointHostPath(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	return hostPath(u.Host, u.Path)
+}
+
+// requestEndpoint returns the host and path used by the agent to connect
+func requestEndpoint(request *http.Request) string {
+	if request.URL == nil {
+		return hostPath(request.Host, "")
+	}
+	return hostPath(request.Host, request.URL.Path)
+}
+
+// hostPath returns the lowercase host without the default port followed by the path
+func hostPath(host, path string) string {
+	if h, port, err := net.SplitHostPort(host); err == nil && (port == "80" || port == "443") {
+		host = h
+	}
+	return strings.ToLower(host) + path
+}
+
+func sortedHeaderNames(headers map[string]string) []string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ----------------------------------------------------------------------
+
+// offerConnectionSettings sends the connection settings to the connected agents matching the selector that accept
+// connection settings and records the offer on each agent
+func (s *opampServer) offerConnectionSettings(ctx context.Context, selector model.Selector, offer *connectionSettingsOffer) (*model.MigrateResponse, error) {
+	message, err := offer.message()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.MigrateResponse{
+		Offered: []string{},
+		Skipped: map[string]string{},
+	}
+
+	agentIDs := s.connections.agentIDs()
+	sort.Strings(agentIDs)
+	for _, agentID := range agentIDs {
+		conn := s.connections.connection(agentID)
+		if conn == nil {
+			continue
+		}
+		agent, err := s.manager.Agent(ctx, agentID)
+		if err != nil || agent == nil || !agent.MatchesSelector(selector) {
+			continue
+		}
+		if !s.connections.hasCapability(conn, protobufs.AgentCapabilities_AcceptsOpAMPConnectionSettings) {
+			response.Skipped[agentID] = "agent does not accept connection settings"
+			continue
+		}
+
+		err = s.send(ctx, conn, &protobufs.ServerToAgent{
+			InstanceUid:        agentID,
+			Capabilities:       s.connections.version(conn).capabilities,
+			ConnectionSettings: message,
+		})
+		if err != nil {
+			s.logger.Error("unable to send connection settings to the agent", zap.String("agentID", agentID), zap.Error(err))
+			response.Skipped[agentID] = err.Error()
+			continue
+		}
+
+		settings := offer.agentConnectionSettings(time.Now())
+		_, err = s.manager.UpsertAgent(ctx, agentID, func(current *model.Agent) { current.ConnectionSettings = settings })
+		if err != nil {
+			s.logger.Error("unable to record the connection settings offered to the agent", zap.String("agentID", agentID), zap.Error(err))
+		}
+		response.Offered = append(response.Offered, agentID)
+	}
+
+	return response, nil
+}
+
+// acceptConnectionSettings marks the connection settings offered to the agent as accepted if the agent used them to
+// connect
+func (s *opampServer) acceptConnectionSettings(conn opamp.Connection, agent *model.Agent) {
+	settings := agent.ConnectionSettings
+	if settings == nil || settings.Status != model.ConnectionSettingsOffered {
+		return
+	}
+	endpoint, header := s.connections.endpoint(conn)
+	if endpoint == "" {
+		return
+	}
+	if connectionSettingsHash(endpoint, settings.Headers, header.Get) != settings.Hash {
+		return
+	}
+	now := time.Now()
+	settings.Status = model.ConnectionSettingsAccepted
+	settings.AcceptedAt = &now
+}
+
+// ----------------------------------------------------------------------
+// REST API
+
+// @Summary Offer new connection settings to agents connected to this server
+// @Description Connected agents matching the selector, or all connected agents if all is set, are sent the OpAMP connection settings, e.g. to move them to another server or rotate the secret key. The settings are accepted when the agent connects using them.
+// @Produce json
+// @Router /opamp/migrate [post]
+// @Param body body model.PostMigrateRequest true "connection settings"
+// @Success 200 {object} model.MigrateResponse
+// @Failure 400 {object} rest.ErrorResponse
+// @Failure 500 {object} rest.ErrorResponse
+func postMigrate(c *gin.Context, s *opampServer) {
+	req := &model.PostMigrateRequest{}
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(err))
+		return
+	}
+	switch {
+	case req.Selector == "" && !req.All:
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(errors.New("selector is required, set all to select all connected agents")))
+		return
+	case req.Selector != "" && req.All:
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(errors.New("selector and all cannot be used together")))
+		return
+	}
+	selector, err := model.SelectorFromString(req.Selector)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(fmt.Errorf("invalid selector %s: %w", req.Selector, err)))
+		return
+	}
+	offer, err := newConnectionSettingsOffer(req, s.endpoint)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(err))
+		return
+	}
+	// agents must be able to reconnect to this server with the new secret key
+	if req.Endpoint == "" && req.SecretKey != "" && !s.manager.VerifySecretKey(c.Request.Context(), req.SecretKey) {
+		c.JSON(http.StatusBadRequest, rest.NewErrorResponse(errors.New("secret key is not accepted by this server, configure it as the secretKey before migrating agents")))
+		return
+	}
+
+	s.logger.Info("offering connection settings to agents", zap.String("selector", req.Selector), zap.String("endpoint", offer.endpoint))
+	response, err := s.offerConnectionSettings(c.Request.Context(), selector, offer)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, rest.NewErrorResponse(err))
+		return
+	}
+	c.JSON(http.StatusOK, response)
+}
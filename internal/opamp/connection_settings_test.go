@@ -0,0 +1,219 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+func TestNewConnectionSettingsOffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		request   model.PostMigrateRequest
+		expectErr string
+		expect    func(t *testing.T, offer *connectionSettingsOffer)
+	}{
+		{
+			name:    "default endpoint",
+			request: model.PostMigrateRequest{},
+			expect: func(t *testing.T, offer *connectionSettingsOffer) {
+				require.Equal(t, "ws://localhost:3001/v1/opamp", offer.settings.DestinationEndpoint)
+				require.Nil(t, offer.settings.Headers)
+				require.Nil(t, offer.settings.Certificate)
+			},
+		},
+		{
+			name: "secret key and headers",
+			request: model.PostMigrateRequest{
+				Endpoint:  "wss://bindplane.example.com/v1/opamp",
+				Headers:   map[string]string{"x-tenant": "one"},
+				SecretKey: "new",
+			},
+			expect: func(t *testing.T, offer *connectionSettingsOffer) {
+				require.Equal(t, "wss://bindplane.example.com/v1/opamp", offer.settings.DestinationEndpoint)
+				require.Equal(t, []*protobufs.Header{
+					{Key: "Authorization", Value: "Secret-Key new"},
+					{Key: "X-Tenant", Value: "one"},
+				}, offer.settings.Headers.Headers)
+			},
+		},
+		{
+			name: "certificate",
+			request: model.PostMigrateRequest{
+				Certificate:          "cert",
+				PrivateKey:           "key",
+				CertificateAuthority: "ca",
+			},
+			expect: func(t *testing.T, offer *connectionSettingsOffer) {
+				require.Equal(t, []byte("cert"), offer.settings.Certificate.PublicKey)
+				require.Equal(t, []byte("key"), offer.settings.Certificate.PrivateKey)
+				require.Equal(t, []byte("ca"), offer.settings.Certificate.CaPublicKey)
+			},
+		},
+		{
+			name:      "invalid scheme",
+			request:   model.PostMigrateRequest{Endpoint: "ftp://bindplane.example.com/v1/opamp"},
+			expectErr: "scheme must be ws, wss, http, or https",
+		},
+		{
+			name:      "missing host",
+			request:   model.PostMigrateRequest{Endpoint: "wss:///v1/opamp"},
+			expectErr: "missing host",
+		},
+		{
+			name: "secret key and authorization header",
+			request: model.PostMigrateRequest{
+				Headers:   map[string]string{"authorization": "Bearer token"},
+				SecretKey: "new",
+			},
+			expectErr: "secret key cannot be specified with an Authorization header",
+		},
+		{
+			name:      "certificate without private key",
+			request:   model.PostMigrateRequest{Certificate: "cert"},
+			expectErr: "certificate and private key must be specified together",
+		},
+		{
+			name:      "certificate authority without certificate",
+			request:   model.PostMigrateRequest{CertificateAuthority: "ca"},
+			expectErr: "certificate authority requires a certificate and private key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			offer, err := newConnectionSettingsOffer(&test.request, "ws://localhost:3001/v1/opamp")
+			if test.expectErr != "" {
+				require.ErrorContains(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			test.expect(t, offer)
+		})
+	}
+}
+
+func TestEndpointHostPath(t *testing.T) {
+	require.Equal(t, "bindplane.example.com/v1/opamp", endpointHostPath("wss://Bindplane.Example.com:443/v1/opamp"))
+	require.Equal(t, "bindplane.example.com:3001/v1/opamp", endpointHostPath("ws://bindplane.example.com:3001/v1/opamp"))
+	require.Equal(t, "bindplane.example.com/v1/opamp", hostPath("bindplane.example.com:80", "/v1/opamp"))
+}
+
+func TestOfferConnectionSettings(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	otherAgentID := "f8d1e3b8-2b5d-4d0e-9b6a-4a3e2c7f1d90"
+	s := testPlainHTTPServer(t)
+	ctx := context.Background()
+
+	// agents connect to the old endpoint
+	plainHTTPResponse(t, plainHTTPPollURL(t, s, "http://old.example.com/v1/opamp", "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid:  agentID,
+		SequenceNum:  1,
+		Capabilities: protobufs.AgentCapabilities_ReportsStatus | protobufs.AgentCapabilities_AcceptsOpAMPConnectionSettings,
+	}))
+	plainHTTPResponse(t, plainHTTPPollURL(t, s, "http://old.example.com/v1/opamp", "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid:  otherAgentID,
+		SequenceNum:  1,
+		Capabilities: protobufs.AgentCapabilities_ReportsStatus,
+	}))
+
+	offer, err := newConnectionSettingsOffer(&model.PostMigrateRequest{
+		Endpoint:  "wss://new.example.com/v1/opamp",
+		SecretKey: "secret",
+	}, s.endpoint)
+	require.NoError(t, err)
+	response, err := s.offerConnectionSettings(ctx, model.EverythingSelector(), offer)
+	require.NoError(t, err)
+	require.Equal(t, []string{agentID}, response.Offered)
+	require.Equal(t, map[string]string{otherAgentID: "agent does not accept connection settings"}, response.Skipped)
+
+	agent, err := s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, model.ConnectionSettingsOffered, agent.ConnectionSettings.Status)
+	require.Equal(t, "wss://new.example.com/v1/opamp", agent.ConnectionSettings.Endpoint)
+	require.Equal(t, []string{"Authorization"}, agent.ConnectionSettings.Headers)
+	require.NotContains(t, agent.ConnectionSettings.Hash, "secret")
+
+	// the offer is returned with the next poll and the agent is still using the old endpoint
+	polled := plainHTTPResponse(t, plainHTTPPollURL(t, s, "http://old.example.com/v1/opamp", "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid: agentID,
+		SequenceNum: 2,
+	}))
+	require.Equal(t, "wss://new.example.com/v1/opamp", polled.GetConnectionSettings().GetOpamp().GetDestinationEndpoint())
+	require.NotEmpty(t, polled.GetConnectionSettings().GetHash())
+	agent, err = s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, model.ConnectionSettingsOffered, agent.ConnectionSettings.Status)
+
+	// the agent connects using the offered settings
+	plainHTTPResponse(t, plainHTTPPollURL(t, s, "https://new.example.com/v1/opamp", "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid: agentID,
+		SequenceNum: 3,
+	}))
+	agent, err = s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, model.ConnectionSettingsAccepted, agent.ConnectionSettings.Status)
+	require.NotNil(t, agent.ConnectionSettings.AcceptedAt)
+}
+
+func TestMigrateRoute(t *testing.T) {
+	s := testPlainHTTPServer(t)
+	s.endpoint = "ws://localhost:3001/v1/opamp"
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/migrate", func(c *gin.Context) { postMigrate(c, s) })
+
+	do := func(body string) (*httptest.ResponseRecorder, *model.MigrateResponse) {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/migrate", strings.NewReader(body)))
+		response := &model.MigrateResponse{}
+		_ = json.Unmarshal(recorder.Body.Bytes(), response)
+		return recorder, response
+	}
+
+	recorder, _ := do(`{"selector":"=production"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// all agents must be selected explicitly
+	recorder, _ = do(`{"secretKey":"secret"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "selector is required")
+	recorder, _ = do(`{"selector":"env=production","all":true,"secretKey":"secret"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = do(`{"all":true,"endpoint":"ftp://example.com"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// the secret key must be accepted by this server unless agents are moved to another server
+	recorder, _ = do(`{"all":true,"secretKey":"unknown"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = do(`{"all":true,"secretKey":"unknown","endpoint":"wss://other.example.com/v1/opamp"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	recorder, response := do(`{"selector":"env=production","secretKey":"secret"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Empty(t, response.Offered)
+}
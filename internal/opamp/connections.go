@@ -15,43 +15,111 @@
 package opamp
 
 import (
+	"errors"
+	"net"
+	"net/http"
 	"sync"
+	"time"
 
+	"github.com/open-telemetry/opamp-go/protobufs"
 	opamp "github.com/open-telemetry/opamp-go/server/types"
 )
 
+// negotiation is the information about a connection determined in OnConnecting
+type negotiation struct {
+	version *opampVersion
+	// endpoint is the host and path used by the agent to connect and header contains the request headers
+	endpoint string
+	header   http.Header
+	// capabilities are the capabilities most recently reported by the agent
+	capabilities protobufs.AgentCapabilities
+	// negotiatedAt is the time of the negotiation, used to expire negotiations for connections that are never
+	// established
+	negotiatedAt time.Time
+	// netConn is the network connection of a WebSocket, used to close the connection
+	netConn net.Conn
+}
+
 type connections struct {
 	// maps connection => agentID and agentID => connection
 	locks       map[opamp.Connection]*sync.Mutex
 	connections map[opamp.Connection]string
 	agents      map[string]opamp.Connection
-	mtx         sync.RWMutex
+	// negotiations in OnConnecting by remote address until the connection is established and then by connection
+	negotiated   map[string]*negotiation
+	negotiations map[opamp.Connection]*negotiation
+	mtx          sync.RWMutex
 }
 
 func newConnections() *connections {
 	return &connections{
-		locks:       make(map[opamp.Connection]*sync.Mutex),
-		connections: make(map[opamp.Connection]string),
-		agents:      make(map[string]opamp.Connection),
+		locks:        make(map[opamp.Connection]*sync.Mutex),
+		connections:  make(map[opamp.Connection]string),
+		agents:       make(map[string]opamp.Connection),
+		negotiated:   make(map[string]*negotiation),
+		negotiations: make(map[opamp.Connection]*negotiation),
 	}
 }
 
 func (c *connections) connect(conn opamp.Connection, agentID string) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
-	c.locks[conn] = &sync.Mutex{}
+	c.connectLocked(conn, agentID)
+}
+
+// connectLocked adds the connection, keeping the existing send lock if the connection is already known. If the
+// connection was used by another agentID, e.g. when an agent is assigned a new ID, the previous agentID no longer uses
+// it. c.mtx must be held.
+func (c *connections) connectLocked(conn opamp.Connection, agentID string) {
+	if _, ok := c.locks[conn]; !ok {
+		c.locks[conn] = &sync.Mutex{}
+	}
+	if previous, ok := c.connections[conn]; ok && previous != agentID && c.agents[previous] == conn {
+		delete(c.agents, previous)
+	}
 	c.connections[conn] = agentID
 	c.agents[agentID] = conn
 }
 
+// poll records a poll from an agent using the plain HTTP transport and returns its connection. The connection is
+// created if the agent is not already connected using the plain HTTP transport and created will be true.
+func (c *connections) poll(agentID string, remoteAddr string, now time.Time) (conn *httpConnection, created bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if existing, ok := c.agents[agentID].(*httpConnection); ok {
+		existing.poll(remoteAddr, now)
+		return existing, false
+	}
+	conn = newHTTPConnection(remoteAddr, now)
+	c.connectLocked(conn, agentID)
+	return conn, true
+}
+
+// expiredPolling returns the plain HTTP connections that have not polled within the timeout
+func (c *connections) expiredPolling(now time.Time, timeout time.Duration) []*httpConnection {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	expired := []*httpConnection{}
+	for _, conn := range c.agents {
+		if conn, ok := conn.(*httpConnection); ok && conn.expired(now, timeout) {
+			expired = append(expired, conn)
+		}
+	}
+	return expired
+}
+
 func (c *connections) disconnect(conn opamp.Connection) {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
+	delete(c.negotiations, conn)
 	agentID, ok := c.connections[conn]
 	if ok {
 		delete(c.locks, conn)
 		delete(c.connections, conn)
-		delete(c.agents, agentID)
+		// the agent may have already reconnected with a different connection
+		if c.agents[agentID] == conn {
+			delete(c.agents, agentID)
+		}
 	}
 }
 
@@ -88,3 +156,111 @@ func (c *connections) agentIDs() []string {
 	}
 	return ids
 }
+
+// negotiate records the negotiation with the agent at the remote address
+func (c *connections) negotiate(remoteAddr string, n *negotiation, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	n.negotiatedAt = now
+	c.negotiated[remoteAddr] = n
+}
+
+// expireNegotiations removes the negotiations for connections that were not established within the timeout, e.g. if
+// the WebSocket upgrade failed or the plain HTTP request was invalid, and returns the number removed
+func (c *connections) expireNegotiations(now time.Time, timeout time.Duration) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	expired := 0
+	for addr, negotiated := range c.negotiated {
+		if now.Sub(negotiated.negotiatedAt) > timeout {
+			delete(c.negotiated, addr)
+			expired++
+		}
+	}
+	return expired
+}
+
+// hijacked records the network connection of the WebSocket negotiated with the agent at the remote address
+func (c *connections) hijacked(remoteAddr string, netConn net.Conn) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if n, ok := c.negotiated[remoteAddr]; ok {
+		n.netConn = netConn
+	}
+}
+
+// close closes the network connection of a WebSocket connection. The agent is disconnected when the opamp-go server
+// fails to read the next message.
+func (c *connections) close(conn opamp.Connection) error {
+	c.mtx.RLock()
+	n, ok := c.negotiations[conn]
+	c.mtx.RUnlock()
+	if !ok || n.netConn == nil {
+		return errors.New("network connection is unknown")
+	}
+	return n.netConn.Close()
+}
+
+// accept associates the negotiation with the agent at the remote address of the connection with the connection. The
+// capabilities reported on the connection are kept when the agent negotiates again, e.g. with each plain HTTP poll.
+func (c *connections) accept(conn opamp.Connection) {
+	addr := conn.RemoteAddr()
+	if addr == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if n, ok := c.negotiated[addr.String()]; ok {
+		delete(c.negotiated, addr.String())
+		if previous, ok := c.negotiations[conn]; ok && n.capabilities == 0 {
+			n.capabilities = previous.capabilities
+		}
+		c.negotiations[conn] = n
+	}
+}
+
+// version returns the version negotiated for the connection or the default version if there is none
+func (c *connections) version(conn opamp.Connection) *opampVersion {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if n, ok := c.negotiations[conn]; ok && n.version != nil {
+		return n.version
+	}
+	return defaultOpAMPVersion
+}
+
+// endpoint returns the host and path used by the agent to connect and the request headers or empty values if they are
+// unknown
+func (c *connections) endpoint(conn opamp.Connection) (string, http.Header) {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if n, ok := c.negotiations[conn]; ok {
+		return n.endpoint, n.header
+	}
+	return "", http.Header{}
+}
+
+// reportCapabilities records the capabilities reported by the agent. Agents may omit them from messages after the
+// first so they are only updated if specified.
+func (c *connections) reportCapabilities(conn opamp.Connection, capabilities protobufs.AgentCapabilities) {
+	if capabilities == 0 {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if n, ok := c.negotiations[conn]; ok {
+		n.capabilities = capabilities
+		return
+	}
+	c.negotiations[conn] = &negotiation{capabilities: capabilities}
+}
+
+// hasCapability returns true if the agent reported the capability on the connection
+func (c *connections) hasCapability(conn opamp.Connection, capability protobufs.AgentCapabilities) bool {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	if n, ok := c.negotiations[conn]; ok {
+		return n.capabilities&capability != 0
+	}
+	return false
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/open-telemetry/opamp-go/protobufs"
 	opamp "github.com/open-telemetry/opamp-go/server/types"
@@ -81,3 +82,77 @@ func TestConnected(t *testing.T) {
 	require.Equal(t, []string{"1"}, c.agentIDs(), "should have agentID 1 connected")
 	require.True(t, c.connected("1"), "should have agentID 1 connected")
 }
+
+func TestDisconnectReconnected(t *testing.T) {
+	c := newConnections()
+	old := &testConnection{agentID: "1"}
+	current := &testConnection{agentID: "1"}
+	c.connect(old, "1")
+	c.connect(current, "1")
+	c.disconnect(old)
+	require.Equal(t, current, c.connection("1"), "should keep the newer connection")
+}
+
+func TestConnectNewAgentID(t *testing.T) {
+	c := newConnections()
+	conn := &testConnection{agentID: "1"}
+	c.connect(conn, "1")
+	c.connect(conn, "2")
+	require.Equal(t, []string{"2"}, c.agentIDs(), "the previous agentID should no longer use the connection")
+	require.Equal(t, "2", c.agentID(conn))
+
+	// a newer connection of the previous agentID is kept
+	c.connect(conn, "1")
+	reconnected := &testConnection{agentID: "1"}
+	c.connect(reconnected, "1")
+	c.connect(conn, "3")
+	require.ElementsMatch(t, []string{"1", "3"}, c.agentIDs())
+	require.Equal(t, reconnected, c.connection("1"))
+}
+
+func TestPoll(t *testing.T) {
+	now := time.Now()
+	c := newConnections()
+	conn, created := c.poll("1", "127.0.0.1:1234", now)
+	require.True(t, created)
+	require.Equal(t, conn, c.connection("1"))
+	require.Equal(t, "1", c.agentID(conn))
+
+	again, created := c.poll("1", "127.0.0.1:1234", now.Add(time.Minute))
+	require.False(t, created)
+	require.Same(t, conn, again)
+
+	// a WebSocket connection is replaced when the agent starts polling
+	c.connect(&testConnection{agentID: "2"}, "2")
+	_, created = c.poll("2", "127.0.0.1:1234", now)
+	require.True(t, created)
+}
+
+func TestExpiredPolling(t *testing.T) {
+	now := time.Now()
+	c := newConnections()
+	c.connect(&testConnection{agentID: "1"}, "1")
+	stale, _ := c.poll("2", "127.0.0.1:1234", now)
+	c.poll("3", "127.0.0.1:1234", now.Add(time.Minute))
+
+	require.Equal(t, []*httpConnection{stale}, c.expiredPolling(now.Add(90*time.Second), time.Minute))
+}
+
+func TestNegotiateExpires(t *testing.T) {
+	now := time.Now()
+	c := newConnections()
+	c.negotiate("10.0.0.1:5000", &negotiation{version: opampV020}, now)
+	c.negotiate("10.0.0.2:5000", &negotiation{version: opampV020}, now.Add(admissionPendingTimeout))
+	require.Equal(t, 0, c.expireNegotiations(now.Add(admissionPendingTimeout), admissionPendingTimeout))
+	require.Len(t, c.negotiated, 2)
+
+	// the connection from 10.0.0.1 was never established
+	require.Equal(t, 1, c.expireNegotiations(now.Add(admissionPendingTimeout+time.Second), admissionPendingTimeout))
+	require.Len(t, c.negotiated, 1)
+	require.NotContains(t, c.negotiated, "10.0.0.1:5000")
+
+	conn := &testConnection{agentID: "2", addr: testAddr{"10.0.0.2:5000"}}
+	c.accept(conn)
+	require.Same(t, opampV020, c.version(conn))
+	require.NotContains(t, c.negotiated, "10.0.0.2:5000")
+}
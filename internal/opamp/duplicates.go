@@ -0,0 +1,144 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	opamp "github.com/open-telemetry/opamp-go/server/types"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// duplicateAgent returns the duplicate record if the agent sending the message is using the ID of another agent or nil
+// if it is not a duplicate. Agents cloned from the same VM image report the same ID and would otherwise update the same
+// model.Agent.
+func (s *opampServer) duplicateAgent(ctx context.Context, conn opamp.Connection, message *protobufs.AgentToServer) *model.AgentDuplicate {
+	agentID := message.InstanceUid
+	reason := s.duplicateReason(ctx, conn, agentID, message.GetAgentDescription())
+	if reason == "" {
+		return nil
+	}
+	now := time.Now()
+	return &model.AgentDuplicate{
+		OriginalID: agentID,
+		Reason:     reason,
+		DetectedAt: &now,
+	}
+}
+
+// duplicateReason returns the reason the agent is a duplicate or "" if it is not
+func (s *opampServer) duplicateReason(ctx context.Context, conn opamp.Connection, agentID string, desc *protobufs.AgentDescription) model.DuplicateReason {
+	if s.duplicateConnection(ctx, conn, agentID) {
+		return model.DuplicateConnection
+	}
+
+	// the identity is only known when the agent reports its description, usually in the first message
+	if desc == nil {
+		return ""
+	}
+	agent, err := s.manager.Agent(ctx, agentID)
+	if err != nil {
+		s.logger.Error("unable to retrieve the agent to check for duplicates", zap.String("agentID", agentID), zap.Error(err))
+		return ""
+	}
+	if agent == nil {
+		return ""
+	}
+	ad := parseAgentDescription(desc, s.connections.version(conn).attributes)
+	if identityDiffers(agent, ad.Hostname, ad.MacAddress) {
+		return model.DuplicateIdentity
+	}
+	return ""
+}
+
+// duplicateConnection returns true if another agent with the same ID is connected using a WebSocket from a different
+// host. An agent that reconnects before its previous connection is closed connects from the same host, and an agent
+// whose address changed leaves a connection that can no longer be sent messages, so the existing connection must be
+// confirmed live. Agents using the plain HTTP transport may poll from a different address each time, e.g. behind a load
+// balancer, so they are only identified by their description.
+func (s *opampServer) duplicateConnection(ctx context.Context, conn opamp.Connection, agentID string) bool {
+	existing := s.connections.connection(agentID)
+	if existing == nil || existing == conn {
+		return false
+	}
+	if _, polling := existing.(*httpConnection); polling {
+		return false
+	}
+	if _, polling := conn.(*httpConnection); polling {
+		return false
+	}
+	if remoteHost(existing.RemoteAddr()) == remoteHost(conn.RemoteAddr()) {
+		return false
+	}
+	if err := s.send(ctx, existing, &protobufs.ServerToAgent{InstanceUid: agentID}); err != nil {
+		s.logger.Info("previous connection of the agent is closed", zap.String("agentID", agentID), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// identityDiffers returns true if the host reported by the agent is not the host of the stored agent. MAC addresses are
+// compared if both are known because a host may be renamed. Otherwise host names are compared.
+func identityDiffers(agent *model.Agent, hostname, macAddress string) bool {
+	if agent.MacAddress != "" && macAddress != "" {
+		return !strings.EqualFold(agent.MacAddress, macAddress)
+	}
+	return agent.HostName != "" && hostname != "" && !strings.EqualFold(agent.HostName, hostname)
+}
+
+// remoteHost returns the host of the remote address without the port or "" if it is unknown
+func remoteHost(addr net.Addr) string {
+	if addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
+
+// reassignAgentID assigns a new ID to the duplicate agent. The new ID is sent to the agent in the response and the
+// returned message uses the new ID so that the duplicate is recorded as a separate agent.
+func (s *opampServer) reassignAgentID(ctx context.Context, conn opamp.Connection, message *protobufs.AgentToServer, duplicate *model.AgentDuplicate, response *protobufs.ServerToAgent) *protobufs.AgentToServer {
+	agentID := uuid.NewString()
+	s.logger.Warn("assigning a new ID to a duplicate agent",
+		zap.String("agentID", duplicate.OriginalID),
+		zap.String("newAgentID", agentID),
+		zap.String("reason", string(duplicate.Reason)),
+		zap.Any("remoteAddress", conn.RemoteAddr()),
+	)
+
+	response.AgentIdentification = &protobufs.AgentIdentification{NewInstanceUid: agentID}
+	// the agent may only report changes in later messages so the full state is requested for the new agent
+	response.Flags |= protobufs.ServerToAgent_ReportFullState
+
+	_, err := s.manager.UpsertAgent(ctx, agentID, func(agent *model.Agent) { agent.Duplicate = duplicate })
+	if err != nil {
+		s.logger.Error("unable to record the duplicate agent", zap.String("agentID", agentID), zap.Error(err))
+	}
+
+	reassigned := proto.Clone(message).(*protobufs.AgentToServer)
+	reassigned.InstanceUid = agentID
+	return reassigned
+}
@@ -0,0 +1,240 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func testDuplicateConnection(address string) *mocks.Connection {
+	conn := &mocks.Connection{}
+	conn.On("RemoteAddr").Return(&TestAddr{network: "tcp", address: address})
+	conn.On("Send", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return conn
+}
+
+func testDuplicateMessage(agentID, hostname, macAddress string) *protobufs.AgentToServer {
+	kv := func(key, value string) *protobufs.KeyValue {
+		return &protobufs.KeyValue{Key: key, Value: &protobufs.AnyValue{Value: &protobufs.AnyValue_StringValue{StringValue: value}}}
+	}
+	return &protobufs.AgentToServer{
+		InstanceUid: agentID,
+		AgentDescription: &protobufs.AgentDescription{
+			IdentifyingAttributes: []*protobufs.KeyValue{kv("service.instance.id", agentID)},
+			NonIdentifyingAttributes: []*protobufs.KeyValue{
+				kv("host.name", hostname),
+				kv("host.mac_address", macAddress),
+			},
+		},
+	}
+}
+
+func TestIdentityDiffers(t *testing.T) {
+	tests := []struct {
+		name       string
+		agent      *model.Agent
+		hostname   string
+		macAddress string
+		expect     bool
+	}{
+		{
+			name:       "same host",
+			agent:      &model.Agent{HostName: "web-1", MacAddress: "00:11:22:33:44:55"},
+			hostname:   "web-1",
+			macAddress: "00:11:22:33:44:55",
+		},
+		{
+			name:       "different mac address",
+			agent:      &model.Agent{HostName: "web-1", MacAddress: "00:11:22:33:44:55"},
+			hostname:   "web-1",
+			macAddress: "66:77:88:99:AA:BB",
+			expect:     true,
+		},
+		{
+			name:       "renamed host",
+			agent:      &model.Agent{HostName: "web-1", MacAddress: "00:11:22:33:44:55"},
+			hostname:   "web-2",
+			macAddress: "00:11:22:33:44:55",
+		},
+		{
+			name:     "different host without mac address",
+			agent:    &model.Agent{HostName: "web-1"},
+			hostname: "web-2",
+			expect:   true,
+		},
+		{
+			name:       "mac address case",
+			agent:      &model.Agent{MacAddress: "aa:bb:cc:dd:ee:ff"},
+			macAddress: "AA:BB:CC:DD:EE:FF",
+		},
+		{
+			name:     "new agent",
+			agent:    &model.Agent{},
+			hostname: "web-1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expect, identityDiffers(test.agent, test.hostname, test.macAddress))
+		})
+	}
+}
+
+func TestOnMessageDuplicateConnection(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	s := testPlainHTTPServer(t)
+	ctx := context.Background()
+
+	original := testDuplicateConnection("10.0.0.1:5000")
+	response := s.OnMessage(original, testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55"))
+	require.Nil(t, response.AgentIdentification)
+
+	// the original agent reconnects before the previous connection is closed
+	reconnected := testDuplicateConnection("10.0.0.1:5001")
+	response = s.OnMessage(reconnected, testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55"))
+	require.Nil(t, response.AgentIdentification)
+	require.Same(t, reconnected, s.connections.connection(agentID))
+
+	// the original agent connects from another address after the previous connection stopped accepting messages
+	moved := &mocks.Connection{}
+	moved.On("RemoteAddr").Return(&TestAddr{network: "tcp", address: "10.0.0.3:5000"})
+	closed := &mocks.Connection{}
+	closed.On("RemoteAddr").Return(&TestAddr{network: "tcp", address: "10.0.0.4:5000"})
+	closed.On("Send", mock.Anything, mock.Anything).Return(errors.New("connection reset by peer"))
+	s.connections.connect(closed, agentID)
+	response = s.OnMessage(moved, testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55"))
+	require.Nil(t, response.AgentIdentification)
+	require.Same(t, moved, s.connections.connection(agentID))
+	s.connections.connect(reconnected, agentID)
+
+	// a clone connects with the same ID and identity from another address while the original agent is connected
+	clone := testDuplicateConnection("10.0.0.2:5000")
+	response = s.OnMessage(clone, testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55"))
+	require.Equal(t, agentID, response.InstanceUid, "the response should be addressed to the ID used by the agent")
+	require.NotNil(t, response.AgentIdentification)
+	newID := response.AgentIdentification.NewInstanceUid
+	require.NotEmpty(t, newID)
+	require.NotEqual(t, agentID, newID)
+	require.NotZero(t, response.Flags&protobufs.ServerToAgent_ReportFullState)
+
+	require.Same(t, reconnected, s.connections.connection(agentID))
+	require.Same(t, clone, s.connections.connection(newID))
+
+	agent, err := s.manager.Agent(ctx, newID)
+	require.NoError(t, err)
+	require.Equal(t, newID, agent.ID, "the ID in the description should not replace the new ID")
+	require.Equal(t, newID, agent.Labels.Get(model.LabelBindPlaneAgentID))
+	require.Equal(t, "web-1", agent.HostName)
+	require.Equal(t, agentID, agent.Duplicate.OriginalID)
+	require.Equal(t, model.DuplicateConnection, agent.Duplicate.Reason)
+	require.NotNil(t, agent.Duplicate.DetectedAt)
+
+	agent, err = s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Nil(t, agent.Duplicate)
+
+	// later messages from the clone use the new ID
+	response = s.OnMessage(clone, &protobufs.AgentToServer{InstanceUid: newID, SequenceNum: 1})
+	require.Nil(t, response.AgentIdentification)
+}
+
+func TestOnMessageDuplicateIdentity(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	s := testPlainHTTPServer(t)
+	ctx := context.Background()
+
+	original := testDuplicateConnection("10.0.0.1:5000")
+	response := s.OnMessage(original, testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55"))
+	require.Nil(t, response.AgentIdentification)
+	s.OnConnectionClose(original)
+
+	// a clone with a different MAC address connects while the original agent is disconnected
+	clone := testDuplicateConnection("10.0.0.1:5001")
+	response = s.OnMessage(clone, testDuplicateMessage(agentID, "web-1", "66:77:88:99:AA:BB"))
+	require.NotNil(t, response.AgentIdentification)
+	newID := response.AgentIdentification.NewInstanceUid
+
+	agent, err := s.manager.Agent(ctx, newID)
+	require.NoError(t, err)
+	require.Equal(t, model.DuplicateIdentity, agent.Duplicate.Reason)
+	require.Equal(t, "66:77:88:99:AA:BB", agent.MacAddress)
+
+	agent, err = s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, "00:11:22:33:44:55", agent.MacAddress, "the original agent should not be modified by the duplicate")
+}
+
+func TestHandlePlainHTTPDuplicate(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	s := testPlainHTTPServer(t)
+	ctx := context.Background()
+
+	response := plainHTTPResponse(t, plainHTTPPollFrom(t, s, "10.0.0.1:5000", testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55")))
+	require.Nil(t, response.AgentIdentification)
+	original := s.connections.connection(agentID).(*httpConnection)
+
+	// messages queued for the original agent are not returned to the clone
+	require.NoError(t, s.send(ctx, original, &protobufs.ServerToAgent{
+		InstanceUid:  agentID,
+		RemoteConfig: &protobufs.AgentRemoteConfig{ConfigHash: []byte("original")},
+	}))
+
+	// a clone polls with the same ID and a different identity from another address
+	response = plainHTTPResponse(t, plainHTTPPollFrom(t, s, "10.0.0.2:5000", testDuplicateMessage(agentID, "web-2", "66:77:88:99:AA:BB")))
+	require.NotNil(t, response.AgentIdentification)
+	newID := response.AgentIdentification.NewInstanceUid
+	require.NotEqual(t, agentID, newID)
+	require.Nil(t, response.GetRemoteConfig())
+
+	clone, ok := s.connections.connection(newID).(*httpConnection)
+	require.True(t, ok)
+	require.NotSame(t, original, clone, "the clone should have its own connection")
+	require.Same(t, original, s.connections.connection(agentID))
+	require.Equal(t, "10.0.0.1:5000", original.RemoteAddr().String())
+	require.Equal(t, "10.0.0.2:5000", clone.RemoteAddr().String())
+
+	agent, err := s.manager.Agent(ctx, newID)
+	require.NoError(t, err)
+	require.Equal(t, model.DuplicateIdentity, agent.Duplicate.Reason)
+
+	// the original agent receives its queued messages and both agents keep polling with their own IDs
+	response = plainHTTPResponse(t, plainHTTPPollFrom(t, s, "10.0.0.1:5000", &protobufs.AgentToServer{InstanceUid: agentID, SequenceNum: 1}))
+	require.Nil(t, response.AgentIdentification)
+	require.Equal(t, []byte("original"), response.GetRemoteConfig().GetConfigHash())
+	response = plainHTTPResponse(t, plainHTTPPollFrom(t, s, "10.0.0.2:5000", &protobufs.AgentToServer{InstanceUid: newID, SequenceNum: 1}))
+	require.Nil(t, response.AgentIdentification)
+	require.Same(t, clone, s.connections.connection(newID))
+
+	// the original agent polls from another address with the same identity, e.g. through a different load balancer
+	response = plainHTTPResponse(t, plainHTTPPollFrom(t, s, "10.0.0.3:5000", testDuplicateMessage(agentID, "web-1", "00:11:22:33:44:55")))
+	require.Nil(t, response.AgentIdentification)
+	require.Same(t, original, s.connections.connection(agentID))
+	require.Equal(t, "10.0.0.3:5000", original.RemoteAddr().String())
+
+	// both agents are disconnected when they stop polling
+	require.Equal(t, 2, s.expirePolling(time.Now().Add(2*httpPollTimeout)))
+	require.False(t, s.Connected(agentID))
+	require.False(t, s.Connected(newID))
+}
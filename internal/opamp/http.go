@@ -0,0 +1,249 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	opamp "github.com/open-telemetry/opamp-go/server/types"
+	"go.uber.org/zap"
+)
+
+const (
+	headerContentType   = "Content-Type"
+	contentTypeProtobuf = "application/x-protobuf"
+
+	// httpPollTimeout is the time since the last poll after which an agent using the plain HTTP transport is considered
+	// disconnected. Agents poll every 30 seconds by default.
+	httpPollTimeout = 90 * time.Second
+
+	// maxHTTPRequestSize is the maximum size of an AgentToServer message sent using the plain HTTP transport
+	maxHTTPRequestSize = 16 * 1024 * 1024
+)
+
+// isPlainHTTPRequest returns true if the request uses the OpAMP plain HTTP transport instead of a WebSocket
+func isPlainHTTPRequest(request *http.Request) bool {
+	return request.Header.Get(headerContentType) == contentTypeProtobuf
+}
+
+// httpAddr is the remote address of an agent using the plain HTTP transport
+type httpAddr string
+
+var _ net.Addr = httpAddr("")
+
+func (a httpAddr) Network() string {
+	return "tcp"
+}
+
+func (a httpAddr) String() string {
+	return string(a)
+}
+
+// httpConnection is an opamp.Connection for an agent using the plain HTTP transport. There is no persistent
+// connection to the agent so messages sent to the agent are queued and returned in the response to the next poll.
+type httpConnection struct {
+	remoteAddr net.Addr
+	lastPoll   time.Time
+	pending    *protobufs.ServerToAgent
+	mtx        sync.Mutex
+}
+
+var _ opamp.Connection = (*httpConnection)(nil)
+
+func newHTTPConnection(remoteAddr string, now time.Time) *httpConnection {
+	return &httpConnection{
+		remoteAddr: httpAddr(remoteAddr),
+		lastPoll:   now,
+	}
+}
+
+// RemoteAddr returns the remote address of the last poll
+func (c *httpConnection) RemoteAddr() net.Addr {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.remoteAddr
+}
+
+// Send queues the message to be included in the response to the next poll
+func (c *httpConnection) Send(_ context.Context, message *protobufs.ServerToAgent) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.pending == nil {
+		c.pending = &protobufs.ServerToAgent{}
+	}
+	mergeServerToAgent(c.pending, message)
+	return nil
+}
+
+// poll records a poll from the agent at the specified address
+func (c *httpConnection) poll(remoteAddr string, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.remoteAddr = httpAddr(remoteAddr)
+	c.lastPoll = now
+}
+
+// expired returns true if the agent has not polled within the timeout
+func (c *httpConnection) expired(now time.Time, timeout time.Duration) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return now.Sub(c.lastPoll) > timeout
+}
+
+// respond merges any queued messages with the response to the current poll and clears the queue. Fields set in the
+// response take precedence over queued messages.
+func (c *httpConnection) respond(response *protobufs.ServerToAgent) *protobufs.ServerToAgent {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	pending := c.pending
+	c.pending = nil
+	if pending == nil {
+		return response
+	}
+	mergeServerToAgent(pending, response)
+	return pending
+}
+
+// mergeServerToAgent merges src into dst. Flags are combined and a RemoteConfig or ConnectionSettings in src replaces
+// the one in dst because each is complete.
+func mergeServerToAgent(dst, src *protobufs.ServerToAgent) {
+	flags := dst.Flags | src.Flags
+	remoteConfig := dst.RemoteConfig
+	if src.RemoteConfig != nil {
+		remoteConfig = src.RemoteConfig
+	}
+	connectionSettings := dst.ConnectionSettings
+	if src.ConnectionSettings != nil {
+		connectionSettings = src.ConnectionSettings
+	}
+	proto.Merge(dst, src)
+	dst.Flags = flags
+	dst.RemoteConfig = remoteConfig
+	dst.ConnectionSettings = connectionSettings
+}
+
+// ----------------------------------------------------------------------
+
+// handlePlainHTTP handles a poll from an agent using the plain HTTP transport. The request contains an AgentToServer
+// message and the response contains the ServerToAgent message along with any messages queued since the last poll.
+func (s *opampServer) handlePlainHTTP(w http.ResponseWriter, request *http.Request) {
+	accept := s.OnConnecting(request)
+	if !accept.Accept {
+		for k, v := range accept.HTTPResponseHeader {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(accept.HTTPStatusCode)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, request.Body, maxHTTPRequestSize))
+	if err != nil {
+		s.logger.Error("unable to read OpAMP request body", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	message := &protobufs.AgentToServer{}
+	if err := proto.Unmarshal(body, message); err != nil {
+		s.logger.Error("unable to parse OpAMP request body", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if message.InstanceUid == "" {
+		s.logger.Error("OpAMP request is missing the agent instance uid")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	conn, created := s.pollConnection(request.Context(), request.RemoteAddr, message, time.Now())
+	if created {
+		s.OnConnected(conn)
+	} else {
+		// each poll negotiates the version in OnConnecting
+		s.connections.accept(conn)
+	}
+
+	response := conn.respond(s.OnMessage(conn, message))
+
+	if message.GetAgentDisconnect() != nil {
+		// the agent state is already updated by OnMessage so only the connection needs to be removed
+		s.connections.disconnect(conn)
+	}
+
+	data, err := proto.Marshal(response)
+	if err != nil {
+		s.logger.Error("unable to marshal OpAMP response", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(headerContentType, contentTypeProtobuf)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		s.logger.Error("unable to write OpAMP response", zap.Error(err))
+	}
+}
+
+// pollConnection returns the connection of the agent sending the poll and true if it was created. An agent using the ID
+// of another polling agent with a different identity is given a separate connection so that the connection of the other agent is not modified and
+// its queued messages are not returned to the duplicate. OnMessage assigns the duplicate a new ID.
+func (s *opampServer) pollConnection(ctx context.Context, remoteAddr string, message *protobufs.AgentToServer, now time.Time) (*httpConnection, bool) {
+	if _, ok := s.connections.connection(message.InstanceUid).(*httpConnection); ok {
+		conn := newHTTPConnection(remoteAddr, now)
+		if s.duplicateReason(ctx, conn, message.InstanceUid, message.GetAgentDescription()) != "" {
+			return conn, true
+		}
+	}
+	return s.connections.poll(message.InstanceUid, remoteAddr, now)
+}
+
+// expirePolling disconnects agents using the plain HTTP transport that have not polled within the poll timeout
+func (s *opampServer) expirePolling(now time.Time) int {
+	expired := s.connections.expiredPolling(now, s.pollTimeout)
+	for _, conn := range expired {
+		s.OnConnectionClose(conn)
+	}
+	return len(expired)
+}
+
+// monitor checks for expired plain HTTP connections and negotiations and asks agents to reconnect while draining until
+// the context is done
+func (s *opampServer) monitor(ctx context.Context) {
+	pollTicker := time.NewTicker(s.pollTimeout / 3)
+	defer pollTicker.Stop()
+	drainTicker := time.NewTicker(drainInterval)
+	defer drainTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-pollTicker.C:
+			if count := s.expirePolling(now); count > 0 {
+				s.logger.Info("disconnected agents that stopped polling", zap.Int("count", count))
+			}
+			s.connections.expireNegotiations(now, admissionPendingTimeout)
+		case now := <-drainTicker.C:
+			if count := s.drainConnections(ctx, now); count > 0 {
+				s.logger.Info("asked agents to reconnect while draining", zap.Int("count", count))
+			}
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/server"
+	"github.com/observiq/bindplane-op/internal/store"
+)
+
+func TestHTTPConnectionQueue(t *testing.T) {
+	conn := newHTTPConnection("127.0.0.1:1234", time.Now())
+	require.Equal(t, "127.0.0.1:1234", conn.RemoteAddr().String())
+
+	// nothing queued
+	response := &protobufs.ServerToAgent{InstanceUid: "1"}
+	require.Same(t, response, conn.respond(response))
+
+	first := &protobufs.AgentRemoteConfig{ConfigHash: []byte("first")}
+	second := &protobufs.AgentRemoteConfig{ConfigHash: []byte("second")}
+	require.NoError(t, conn.Send(context.Background(), &protobufs.ServerToAgent{RemoteConfig: first, Flags: protobufs.ServerToAgent_ReportFullState}))
+	require.NoError(t, conn.Send(context.Background(), &protobufs.ServerToAgent{RemoteConfig: second}))
+
+	result := conn.respond(&protobufs.ServerToAgent{InstanceUid: "1", Capabilities: capabilities})
+	require.Equal(t, "1", result.InstanceUid)
+	require.Equal(t, capabilities, result.Capabilities)
+	require.Equal(t, protobufs.ServerToAgent_ReportFullState, result.Flags)
+	require.Equal(t, []byte("second"), result.RemoteConfig.ConfigHash)
+
+	// the queue is cleared after responding
+	response = &protobufs.ServerToAgent{InstanceUid: "1"}
+	require.Same(t, response, conn.respond(response))
+}
+
+func TestHTTPConnectionResponsePrecedence(t *testing.T) {
+	conn := newHTTPConnection("127.0.0.1:1234", time.Now())
+	require.NoError(t, conn.Send(context.Background(), &protobufs.ServerToAgent{RemoteConfig: &protobufs.AgentRemoteConfig{ConfigHash: []byte("queued")}}))
+
+	result := conn.respond(&protobufs.ServerToAgent{RemoteConfig: &protobufs.AgentRemoteConfig{ConfigHash: []byte("current")}})
+	require.Equal(t, []byte("current"), result.RemoteConfig.ConfigHash)
+}
+
+func TestHTTPConnectionConnectionSettings(t *testing.T) {
+	conn := newHTTPConnection("127.0.0.1:1234", time.Now())
+	require.NoError(t, conn.Send(context.Background(), &protobufs.ServerToAgent{ConnectionSettings: &protobufs.ConnectionSettingsOffers{
+		Hash:  []byte("first"),
+		Opamp: &protobufs.OpAMPConnectionSettings{Headers: &protobufs.Headers{Headers: []*protobufs.Header{{Key: "Authorization", Value: "first"}}}},
+	}}))
+	require.NoError(t, conn.Send(context.Background(), &protobufs.ServerToAgent{ConnectionSettings: &protobufs.ConnectionSettingsOffers{
+		Hash:  []byte("second"),
+		Opamp: &protobufs.OpAMPConnectionSettings{DestinationEndpoint: "wss://example.com/v1/opamp"},
+	}}))
+
+	// the latest offer replaces the previous offer
+	result := conn.respond(&protobufs.ServerToAgent{})
+	require.Equal(t, []byte("second"), result.ConnectionSettings.Hash)
+	require.Nil(t, result.ConnectionSettings.Opamp.Headers)
+}
+
+func TestHTTPConnectionExpired(t *testing.T) {
+	now := time.Now()
+	conn := newHTTPConnection("127.0.0.1:1234", now)
+	require.False(t, conn.expired(now.Add(time.Minute), 2*time.Minute))
+	require.True(t, conn.expired(now.Add(3*time.Minute), 2*time.Minute))
+
+	conn.poll("127.0.0.1:5678", now.Add(2*time.Minute))
+	require.False(t, conn.expired(now.Add(3*time.Minute), 2*time.Minute))
+	require.Equal(t, "127.0.0.1:5678", conn.RemoteAddr().String())
+}
+
+func testPlainHTTPServer(t *testing.T) *opampServer {
+	testMapStore := store.NewMapStore(context.TODO(), store.Options{
+		SessionsSecret:   "supersecret-key",
+		MaxEventsToMerge: 1000,
+	}, zap.NewNop())
+	testManager, err := server.NewManager(&common.Server{SecretKey: "secret"}, testMapStore, zap.NewNop())
+	require.NoError(t, err)
+
+	s := testServer(testManager)
+	testManager.EnableProtocol(s)
+	return s
+}
+
+func plainHTTPPoll(t *testing.T, s *opampServer, authorization string, message *protobufs.AgentToServer) *httptest.ResponseRecorder {
+	return plainHTTPPollURL(t, s, "/v1/opamp", authorization, message)
+}
+
+func plainHTTPPollURL(t *testing.T, s *opampServer, target, authorization string, message *protobufs.AgentToServer) *httptest.ResponseRecorder {
+	return plainHTTPPollRequest(t, s, plainHTTPRequest(t, target, authorization, message))
+}
+
+// plainHTTPPollFrom polls from the specified remote address
+func plainHTTPPollFrom(t *testing.T, s *opampServer, remoteAddr string, message *protobufs.AgentToServer) *httptest.ResponseRecorder {
+	request := plainHTTPRequest(t, "/v1/opamp", "Secret-Key secret", message)
+	request.RemoteAddr = remoteAddr
+	return plainHTTPPollRequest(t, s, request)
+}
+
+func plainHTTPRequest(t *testing.T, target, authorization string, message *protobufs.AgentToServer) *http.Request {
+	body, err := proto.Marshal(message)
+	require.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	request.Header.Set(headerContentType, contentTypeProtobuf)
+	request.Header.Set(headerOpAMPVersion, compatibleOpAMPVersions[0])
+	request.Header.Set(headerAuthorization, authorization)
+	require.True(t, isPlainHTTPRequest(request))
+	return request
+}
+
+func plainHTTPPollRequest(t *testing.T, s *opampServer, request *http.Request) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.handlePlainHTTP(recorder, request)
+	return recorder
+}
+
+func plainHTTPResponse(t *testing.T, recorder *httptest.ResponseRecorder) *protobufs.ServerToAgent {
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, contentTypeProtobuf, recorder.Header().Get(headerContentType))
+	response := &protobufs.ServerToAgent{}
+	require.NoError(t, proto.Unmarshal(recorder.Body.Bytes(), response))
+	return response
+}
+
+func TestHandlePlainHTTP(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	s := testPlainHTTPServer(t)
+	ctx := context.Background()
+
+	// rejected without the secret key
+	recorder := plainHTTPPoll(t, s, "", &protobufs.AgentToServer{InstanceUid: agentID})
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.False(t, s.Connected(agentID))
+
+	// first poll connects the agent
+	response := plainHTTPResponse(t, plainHTTPPoll(t, s, "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid: agentID,
+		SequenceNum: 1,
+	}))
+	require.Equal(t, agentID, response.InstanceUid)
+	require.True(t, s.Connected(agentID))
+	agent, err := s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, "Connected", agent.StatusDisplayText())
+	require.Equal(t, "opamp/v0.2.0", agent.Protocol, "should record the negotiated version")
+
+	// heartbeats are not queued for polling agents
+	require.NoError(t, s.SendHeartbeat(agentID))
+	conn := s.connections.connection(agentID).(*httpConnection)
+	require.Nil(t, conn.pending)
+
+	// queued messages are returned with the next poll
+	require.NoError(t, s.send(ctx, conn, &protobufs.ServerToAgent{
+		InstanceUid:  agentID,
+		RemoteConfig: &protobufs.AgentRemoteConfig{ConfigHash: []byte("queued")},
+	}))
+	response = plainHTTPResponse(t, plainHTTPPoll(t, s, "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid: agentID,
+		SequenceNum: 2,
+	}))
+	require.Equal(t, []byte("queued"), response.GetRemoteConfig().GetConfigHash())
+	require.Same(t, conn, s.connections.connection(agentID), "polls should reuse the connection")
+
+	// the agent is disconnected after it stops polling
+	require.Equal(t, 0, s.expirePolling(time.Now()))
+	require.Equal(t, 1, s.expirePolling(time.Now().Add(2*httpPollTimeout)))
+	require.False(t, s.Connected(agentID))
+	agent, err = s.manager.Agent(ctx, agentID)
+	require.NoError(t, err)
+	require.Equal(t, "Disconnected", agent.StatusDisplayText())
+}
+
+func TestHandlePlainHTTPAgentDisconnect(t *testing.T) {
+	agentID := "a4013625-30f4-489e-a0ca-ef1c97d2ae3f"
+	s := testPlainHTTPServer(t)
+
+	plainHTTPResponse(t, plainHTTPPoll(t, s, "Secret-Key secret", &protobufs.AgentToServer{InstanceUid: agentID, SequenceNum: 1}))
+	require.True(t, s.Connected(agentID))
+
+	plainHTTPResponse(t, plainHTTPPoll(t, s, "Secret-Key secret", &protobufs.AgentToServer{
+		InstanceUid:     agentID,
+		SequenceNum:     2,
+		AgentDisconnect: &protobufs.AgentDisconnect{},
+	}))
+	require.False(t, s.Connected(agentID))
+}
+
+func TestHandlePlainHTTPInvalidBody(t *testing.T) {
+	s := testPlainHTTPServer(t)
+
+	request := httptest.NewRequest(http.MethodPost, "/v1/opamp", bytes.NewReader([]byte("not protobuf")))
+	request.Header.Set(headerContentType, contentTypeProtobuf)
+	request.Header.Set(headerOpAMPVersion, compatibleOpAMPVersions[0])
+	request.Header.Set(headerAuthorization, "Secret-Key secret")
+
+	recorder := httptest.NewRecorder()
+	s.handlePlainHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestHandlePlainHTTPBodyTooLarge(t *testing.T) {
+	s := testPlainHTTPServer(t)
+
+	request := httptest.NewRequest(http.MethodPost, "/v1/opamp", bytes.NewReader(make([]byte, maxHTTPRequestSize+1)))
+	request.Header.Set(headerContentType, contentTypeProtobuf)
+	request.Header.Set(headerOpAMPVersion, compatibleOpAMPVersions[0])
+	request.Header.Set(headerAuthorization, "Secret-Key secret")
+
+	recorder := httptest.NewRecorder()
+	s.handlePlainHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func TestMonitorStops(t *testing.T) {
+	s := testPlainHTTPServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.monitor(ctx)
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "monitor should stop when the context is done")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+)
+
+// opampVersion describes how messages are handled for a version of the OpAMP protocol
+type opampVersion struct {
+	version      string
+	capabilities protobufs.ServerCapabilities
+	attributes   agentDescriptionAttributes
+}
+
+// protocol returns the value of model.Agent.Protocol for agents using this version, e.g. opamp/v0.2.0
+func (v *opampVersion) protocol() string {
+	return fmt.Sprintf("%s/%s", ProtocolName, v.version)
+}
+
+// agentDescriptionAttributes are the names of the AgentDescription attributes used for each agent field. The first
+// attribute with a value is used.
+type agentDescriptionAttributes struct {
+	id              []string
+	name            []string
+	agentType       []string
+	version         []string
+	labels          []string
+	architecture    []string
+	operatingSystem []string
+	platform        []string
+	hostname        []string
+	macAddress      []string
+}
+
+var (
+	opampV020 = &opampVersion{
+		version:      "v0.2.0",
+		capabilities: capabilities,
+		attributes: agentDescriptionAttributes{
+			id:              []string{"service.instance.id"},
+			name:            []string{"service.instance.name"},
+			agentType:       []string{"service.name"},
+			version:         []string{"service.version"},
+			labels:          []string{"service.labels"},
+			architecture:    []string{"os.arch"},
+			operatingSystem: []string{"os.details"},
+			platform:        []string{"os.family"},
+			hostname:        []string{"host.name"},
+			macAddress:      []string{"host.mac_address"},
+		},
+	}
+
+	// opampVersions are the supported versions in order of preference. A version is only added when the protobufs used
+	// to decode its messages are available, so only v0.2.0 is supported until opamp-go is upgraded.
+	opampVersions = []*opampVersion{opampV020}
+
+	// defaultOpAMPVersion is used for connections without a negotiated version
+	defaultOpAMPVersion = opampV020
+)
+
+// opampVersionNames returns the names of the versions
+func opampVersionNames(versions []*opampVersion) []string {
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.version)
+	}
+	return names
+}
+
+// findOpAMPVersion returns the version with the specified name or the default version if it is unknown
+func findOpAMPVersion(name string) *opampVersion {
+	for _, v := range opampVersions {
+		if v.version == name {
+			return v
+		}
+	}
+	return defaultOpAMPVersion
+}
+
+type opampVersionKey struct{}
+
+// withOpAMPVersion returns a context with the version negotiated with the agent
+func withOpAMPVersion(ctx context.Context, version *opampVersion) context.Context {
+	return context.WithValue(ctx, opampVersionKey{}, version)
+}
+
+// opampVersionFromContext returns the version added to the context with withOpAMPVersion or the default version if
+// there is none
+func opampVersionFromContext(ctx context.Context) *opampVersion {
+	if version, ok := ctx.Value(opampVersionKey{}).(*opampVersion); ok && version != nil {
+		return version
+	}
+	return defaultOpAMPVersion
+}
@@ -0,0 +1,161 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/open-telemetry/opamp-go/protobufs"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/server/mocks"
+)
+
+func stringKeyValue(key, value string) *protobufs.KeyValue {
+	return &protobufs.KeyValue{
+		Key:   key,
+		Value: &protobufs.AnyValue{Value: &protobufs.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+// testOpAMPVersion reports the os and host attributes using the semantic conventions names and falls back to the
+// previous names
+var testOpAMPVersion = &opampVersion{
+	version:      "v9.9.9",
+	capabilities: capabilities,
+	attributes: agentDescriptionAttributes{
+		id:              []string{"service.instance.id"},
+		architecture:    []string{"host.arch", "os.arch"},
+		operatingSystem: []string{"os.description", "os.details"},
+		platform:        []string{"os.type", "os.family"},
+	},
+}
+
+func TestParseAgentDescriptionVersions(t *testing.T) {
+	legacy := &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("service.instance.id", "1"),
+		},
+		NonIdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("os.arch", "amd64"),
+			stringKeyValue("os.details", "Ubuntu 20.04"),
+			stringKeyValue("os.family", "linux"),
+		},
+	}
+	semconv := &protobufs.AgentDescription{
+		IdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("service.instance.id", "1"),
+		},
+		NonIdentifyingAttributes: []*protobufs.KeyValue{
+			stringKeyValue("host.arch", "arm64"),
+			stringKeyValue("os.description", "Ubuntu 22.04"),
+			stringKeyValue("os.type", "linux"),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		version         *opampVersion
+		desc            *protobufs.AgentDescription
+		architecture    string
+		operatingSystem string
+	}{
+		{
+			name:            "v0.2.0",
+			version:         opampV020,
+			desc:            legacy,
+			architecture:    "amd64",
+			operatingSystem: "Ubuntu 20.04",
+		},
+		{
+			name:    "v0.2.0 ignores semantic conventions",
+			version: opampV020,
+			desc:    semconv,
+		},
+		{
+			name:            "semantic conventions",
+			version:         testOpAMPVersion,
+			desc:            semconv,
+			architecture:    "arm64",
+			operatingSystem: "Ubuntu 22.04",
+		},
+		{
+			name:            "falls back to previous names",
+			version:         testOpAMPVersion,
+			desc:            legacy,
+			architecture:    "amd64",
+			operatingSystem: "Ubuntu 20.04",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ad := parseAgentDescription(test.desc, test.version.attributes)
+			require.Equal(t, "1", ad.AgentID)
+			require.Equal(t, test.architecture, ad.Architecture)
+			require.Equal(t, test.operatingSystem, ad.OperatingSystem)
+		})
+	}
+}
+
+func TestFindOpAMPVersion(t *testing.T) {
+	require.Equal(t, []string{"v0.2.0"}, compatibleOpAMPVersions)
+	require.Same(t, opampV020, findOpAMPVersion("v0.2.0"))
+	require.Same(t, defaultOpAMPVersion, findOpAMPVersion("v0.3.0"), "v0.3.0 messages cannot be decoded")
+	require.Same(t, defaultOpAMPVersion, findOpAMPVersion("v9.9.9"))
+	require.Equal(t, "opamp/v0.2.0", opampV020.protocol())
+}
+
+func TestOpAMPVersionFromContext(t *testing.T) {
+	require.Same(t, defaultOpAMPVersion, opampVersionFromContext(context.Background()))
+	require.Same(t, testOpAMPVersion, opampVersionFromContext(withOpAMPVersion(context.Background(), testOpAMPVersion)))
+}
+
+func TestOnConnectingNegotiatesVersion(t *testing.T) {
+	manager := &mocks.Manager{}
+	manager.On("VerifySecretKey", mock.Anything, "secret").Return(true)
+	server := testServer(manager)
+
+	request := &http.Request{
+		RemoteAddr: "10.0.0.1:5000",
+		Header: http.Header{
+			"Opamp-Version": []string{"v0.2.0"},
+			"Authorization": []string{"Secret-Key secret"},
+		},
+	}
+	response := server.OnConnecting(request)
+	require.True(t, response.Accept)
+
+	conn := &testConnection{agentID: "1", addr: testAddr{"10.0.0.1:5000"}}
+	_, header := server.connections.endpoint(conn)
+	require.Empty(t, header, "negotiation is unknown until connected")
+
+	server.OnConnected(conn)
+	require.Same(t, opampV020, server.connections.version(conn))
+	_, header = server.connections.endpoint(conn)
+	require.Equal(t, "v0.2.0", header.Get(headerOpAMPVersion))
+
+	server.connections.connect(conn, "1")
+	server.connections.disconnect(conn)
+	_, header = server.connections.endpoint(conn)
+	require.Empty(t, header)
+}
+
+func TestUpgradeHeader(t *testing.T) {
+	require.Equal(t, "OpAMP/v0.2.0", upgradeHeader([]string{"v0.2.0"}))
+	require.Equal(t, "OpAMP/v0.3.0, OpAMP/v0.2.0", upgradeHeader([]string{"v0.3.0", "v0.2.0"}))
+}
@@ -0,0 +1,61 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opamp
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+
+	opampSvr "github.com/open-telemetry/opamp-go/server"
+)
+
+// handler returns the handler for the OpAMP endpoint. Plain HTTP polls are handled by the opampServer and WebSocket
+// connections are handled by the opamp-go server. The opamp-go server does not provide a way to close a WebSocket
+// connection so the network connection is recorded when the request is upgraded.
+func (s *opampServer) handler(websocketHandler opampSvr.HTTPHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if isPlainHTTPRequest(r) {
+			s.handlePlainHTTP(w, r)
+			return
+		}
+		websocketHandler(&hijackRecorder{ResponseWriter: w, remoteAddr: r.RemoteAddr, connections: s.connections}, r)
+	}
+}
+
+// hijackRecorder records the network connection taken over by the WebSocket upgrade with the negotiation for the
+// remote address
+type hijackRecorder struct {
+	http.ResponseWriter
+	remoteAddr  string
+	connections *connections
+}
+
+var _ http.Hijacker = (*hijackRecorder)(nil)
+
+// Hijack implements http.Hijacker
+func (h *hijackRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := h.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response does not support hijacking the connection")
+	}
+	netConn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	h.connections.hijacked(h.remoteAddr, netConn)
+	return netConn, rw, nil
+}
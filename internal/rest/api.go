@@ -39,6 +39,7 @@ func AddRestRoutes(router gin.IRouter, bindplane server.BindPlane) {
 	router.GET("/agents/:id", func(c *gin.Context) { getAgent(c, bindplane) })
 	router.DELETE("/agents", func(c *gin.Context) { deleteAgents(c, bindplane) })
 	router.PATCH("/agents/labels", func(c *gin.Context) { labelAgents(c, bindplane) })
+	router.GET("/agents/duplicates", func(c *gin.Context) { agentDuplicates(c, bindplane) })
 	router.GET("/agents/:id/labels", func(c *gin.Context) { getAgentLabels(c, bindplane) })
 	router.PATCH("/agents/:id/labels", func(c *gin.Context) { patchAgentLabels(c, bindplane) })
 	router.PUT("/agents/:id/restart", func(c *gin.Context) { restartAgent(c, bindplane) })
@@ -49,6 +50,8 @@ func AddRestRoutes(router gin.IRouter, bindplane server.BindPlane) {
 	router.GET("/configurations/:name", func(c *gin.Context) { configuration(c, bindplane) })
 	router.DELETE("/configurations/:name", func(c *gin.Context) { deleteConfiguration(c, bindplane) })
 	router.POST("/configurations/:name/duplicate", func(c *gin.Context) { duplicateConfig(c, bindplane) })
+	router.PUT("/configurations/:name/sources/:component/flow-control", func(c *gin.Context) { putFlowControl(c, bindplane, model.KindSource) })
+	router.PUT("/configurations/:name/destinations/:component/flow-control", func(c *gin.Context) { putFlowControl(c, bindplane, model.KindDestination) })
 
 	router.GET("/sources", func(c *gin.Context) { sources(c, bindplane) })
 	router.GET("/sources/:name", func(c *gin.Context) { source(c, bindplane) })
@@ -74,6 +77,18 @@ func AddRestRoutes(router gin.IRouter, bindplane server.BindPlane) {
 	router.GET("/destination-types/:name", func(c *gin.Context) { destinationType(c, bindplane) })
 	router.DELETE("/destination-types/:name", func(c *gin.Context) { deleteDestinationType(c, bindplane) })
 
+	router.GET("/configuration-templates", func(c *gin.Context) { configurationTemplates(c, bindplane) })
+	router.GET("/configuration-templates/:name", func(c *gin.Context) { configurationTemplate(c, bindplane) })
+	router.DELETE("/configuration-templates/:name", func(c *gin.Context) { deleteConfigurationTemplate(c, bindplane) })
+
+	router.GET("/configuration-instances", func(c *gin.Context) { configurationInstances(c, bindplane) })
+	router.GET("/configuration-instances/:name", func(c *gin.Context) { configurationInstance(c, bindplane) })
+	router.DELETE("/configuration-instances/:name", func(c *gin.Context) { deleteConfigurationInstance(c, bindplane) })
+
+	router.GET("/label-rules", func(c *gin.Context) { labelRules(c, bindplane) })
+	router.GET("/label-rules/:name", func(c *gin.Context) { labelRule(c, bindplane) })
+	router.DELETE("/label-rules/:name", func(c *gin.Context) { deleteLabelRule(c, bindplane) })
+
 	router.POST("/apply", func(c *gin.Context) { applyResources(c, bindplane) })
 	router.POST("/delete", func(c *gin.Context) { deleteResources(c, bindplane) })
 
@@ -139,6 +154,30 @@ func agents(c *gin.Context, bindplane server.BindPlane) {
 	})
 }
 
+// @Summary List agents that were assigned a new ID because they connected using the ID of another agent
+// @Description Agents cloned from the same VM image report the same ID. Each duplicate is assigned a new ID and records the original ID.
+// @Produce json
+// @Router /agents/duplicates [get]
+// @Param 	id	query	string	false "only list the duplicates of the agent with this id"
+// @Success 200 {object} model.AgentDuplicatesResponse
+// @Failure 500 {object} ErrorResponse
+func agentDuplicates(c *gin.Context, bindplane server.BindPlane) {
+	ctx, span := tracer.Start(c.Request.Context(), "rest/agentDuplicates")
+	defer span.End()
+
+	// duplicateOf: without a value matches all duplicates
+	query := search.ParseQuery(fmt.Sprintf("duplicateOf:%s", c.Query("id")))
+	agents, err := bindplane.Store().Agents(ctx, store.WithQuery(query))
+	if err != nil {
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, model.AgentDuplicatesResponse{
+		Agents: agents,
+	})
+}
+
 // @Summary delete agents by ids
 // @Produce json
 // @Router /agents [delete]
@@ -242,7 +281,12 @@ func getAgentConfiguration(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
-	c.JSON(http.StatusOK, &model.ConfigurationResponse{Configuration: config})
+	config = model.RedactSecrets(config)
+	response := &model.ConfigurationResponse{Configuration: config}
+	if config != nil {
+		response.Overrides = config.Spec.AgentOverrides(agent)
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // @Summary Bulk apply labels to agents
@@ -430,7 +474,7 @@ func configurations(c *gin.Context, bindplane server.BindPlane) {
 	}
 
 	c.JSON(http.StatusOK, model.ConfigurationsResponse{
-		Configurations: configs,
+		Configurations: model.RedactAllSecrets(configs),
 	})
 }
 
@@ -438,6 +482,7 @@ func configurations(c *gin.Context, bindplane server.BindPlane) {
 // @Produce json
 // @Router /configurations/{name} [get]
 // @Param 	name	path	string	true "the name of the configuration"
+// @Param 	platform	query	string	false "render the configuration for agents on this platform, e.g. windows"
 // @Success 200 {object} model.ConfigurationResponse
 // @Failure 500 {object} ErrorResponse
 func configuration(c *gin.Context, bindplane server.BindPlane) {
@@ -451,13 +496,19 @@ func configuration(c *gin.Context, bindplane server.BindPlane) {
 		return
 	}
 
-	raw, err := config.Render(ctx, bindplane.Store())
+	// render for an agent on the platform, if specified
+	var agent *model.Agent
+	if platform := c.Query("platform"); platform != "" {
+		agent = &model.Agent{Platform: platform}
+	}
+
+	raw, err := config.Render(ctx, agent, bindplane.Store())
 	if !okResponse(c, err) {
 		return
 	}
 
 	c.JSON(http.StatusOK, model.ConfigurationResponse{
-		Configuration: config,
+		Configuration: model.RedactSecrets(config),
 		Raw:           raw,
 	})
 }
@@ -533,6 +584,69 @@ func duplicateConfig(c *gin.Context, bindplane server.BindPlane) {
 	})
 }
 
+// @Summary Replace the flow control of a source or destination in a configuration
+// @Description Pauses or samples the telemetry flowing through a source or destination without
+// @Description applying the entire configuration. Inline sources and destinations are identified by their position,
+// @Description e.g. source0 or destination1.
+// @Produce json
+// @Router /configurations/{name}/sources/{component}/flow-control [put]
+// @Router /configurations/{name}/destinations/{component}/flow-control [put]
+// @Param 	name	path	string	true "the name of the configuration"
+// @Param 	component	path	string	true "the name of the source or destination"
+// @Param 	flowControl	body	model.FlowControl	true "the flow control to apply"
+// @Success 200 {object} model.FlowControlResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func putFlowControl(c *gin.Context, bindplane server.BindPlane, kind model.Kind) {
+	name := c.Param("name")
+	component := c.Param("component")
+
+	flowControl := &model.FlowControl{}
+	if err := c.BindJSON(flowControl); err != nil {
+		handleErrorResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	// the flow control is replaced in the stored configuration so that concurrent changes to the configuration are not
+	// lost
+	var componentErr error
+	resourceStatus, err := bindplane.Store().UpdateConfiguration(name, func(current *model.Configuration) (*model.Configuration, error) {
+		updated, err := current.WithFlowControl(kind, component, flowControl)
+		componentErr = err
+		return updated, err
+	})
+	switch {
+	case errors.Is(err, store.ErrResourceMissing):
+		handleErrorResponse(c, http.StatusNotFound, fmt.Errorf("no configuration with name %s found", name))
+		return
+	case componentErr != nil:
+		handleErrorResponse(c, http.StatusNotFound, componentErr)
+		return
+	case errors.Is(err, store.ErrResourceChanged):
+		handleErrorResponse(c, http.StatusConflict, err)
+		return
+	case err != nil:
+		handleErrorResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch resourceStatus.Status {
+	case model.StatusInvalid:
+		handleErrorResponse(c, http.StatusBadRequest, errors.New(resourceStatus.Reason))
+		return
+	case model.StatusError:
+		handleErrorResponse(c, http.StatusInternalServerError, errors.New(resourceStatus.Reason))
+		return
+	}
+
+	c.JSON(http.StatusOK, &model.FlowControlResponse{
+		FlowControl: flowControl,
+		Status:      resourceStatus.Status,
+	})
+}
+
 // ----------------------------------------------------------------------
 
 // @Summary List sources
@@ -544,7 +658,7 @@ func sources(c *gin.Context, bindplane server.BindPlane) {
 	sources, err := bindplane.Store().Sources()
 	if okResponse(c, err) {
 		c.JSON(http.StatusOK, model.SourcesResponse{
-			Sources: sources,
+			Sources: model.RedactAllSecrets(sources),
 		})
 	}
 }
@@ -561,7 +675,7 @@ func source(c *gin.Context, bindplane server.BindPlane) {
 	source, err := bindplane.Store().Source(name)
 	if okResource(c, source == nil, err) {
 		c.JSON(http.StatusOK, model.SourceResponse{
-			Source: source,
+			Source: model.RedactSecrets(source),
 		})
 	}
 }
@@ -641,7 +755,7 @@ func processors(c *gin.Context, bindplane server.BindPlane) {
 	processors, err := bindplane.Store().Processors()
 	if okResponse(c, err) {
 		c.JSON(http.StatusOK, model.ProcessorsResponse{
-			Processors: processors,
+			Processors: model.RedactAllSecrets(processors),
 		})
 	}
 }
@@ -658,7 +772,7 @@ func processor(c *gin.Context, bindplane server.BindPlane) {
 	processor, err := bindplane.Store().Processor(name)
 	if okResource(c, processor == nil, err) {
 		c.JSON(http.StatusOK, model.ProcessorResponse{
-			Processor: processor,
+			Processor: model.RedactSecrets(processor),
 		})
 	}
 }
@@ -737,7 +851,7 @@ func destinations(c *gin.Context, bindplane server.BindPlane) {
 	destinations, err := bindplane.Store().Destinations()
 	if okResponse(c, err) {
 		c.JSON(http.StatusOK, model.DestinationsResponse{
-			Destinations: destinations,
+			Destinations: model.RedactAllSecrets(destinations),
 		})
 	}
 }
@@ -754,7 +868,7 @@ func destination(c *gin.Context, bindplane server.BindPlane) {
 	destination, err := bindplane.Store().Destination(name)
 	if okResource(c, destination == nil, err) {
 		c.JSON(http.StatusOK, model.DestinationResponse{
-			Destination: destination,
+			Destination: model.RedactSecrets(destination),
 		})
 	}
 }
@@ -824,6 +938,152 @@ func deleteDestinationType(c *gin.Context, bindplane server.BindPlane) {
 
 // ----------------------------------------------------------------------
 
+// @Summary List configuration templates
+// @Produce json
+// @Router /configuration-templates [get]
+// @Success 200 {object} model.ConfigurationTemplatesResponse
+// @Failure 500 {object} ErrorResponse
+func configurationTemplates(c *gin.Context, bindplane server.BindPlane) {
+	configurationTemplates, err := bindplane.Store().ConfigurationTemplates()
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.ConfigurationTemplatesResponse{
+			ConfigurationTemplates: configurationTemplates,
+		})
+	}
+}
+
+// @Summary Get configuration template by name
+// @Produce json
+// @Router /configuration-templates/{name} [get]
+// @Param 	name	path	string	true "the name of the configuration template"
+// @Success 200 {object} model.ConfigurationTemplateResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func configurationTemplate(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	configurationTemplate, err := bindplane.Store().ConfigurationTemplate(name)
+	if okResource(c, configurationTemplate == nil, err) {
+		c.JSON(http.StatusOK, model.ConfigurationTemplateResponse{
+			ConfigurationTemplate: configurationTemplate,
+		})
+	}
+}
+
+// @Summary Delete configuration template by name
+// @Produce json
+// @Router /configuration-templates/{name} [delete]
+// @Param 	name	path	string	true "the name of the configuration template to delete"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteConfigurationTemplate(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	configurationTemplate, err := bindplane.Store().DeleteConfigurationTemplate(name)
+	if okResource(c, configurationTemplate == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List configuration instances
+// @Produce json
+// @Router /configuration-instances [get]
+// @Success 200 {object} model.ConfigurationInstancesResponse
+// @Failure 500 {object} ErrorResponse
+func configurationInstances(c *gin.Context, bindplane server.BindPlane) {
+	configurationInstances, err := bindplane.Store().ConfigurationInstances()
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.ConfigurationInstancesResponse{
+			ConfigurationInstances: configurationInstances,
+		})
+	}
+}
+
+// @Summary Get configuration instance by name
+// @Produce json
+// @Router /configuration-instances/{name} [get]
+// @Param 	name	path	string	true "the name of the configuration instance"
+// @Success 200 {object} model.ConfigurationInstanceResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func configurationInstance(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	configurationInstance, err := bindplane.Store().ConfigurationInstance(name)
+	if okResource(c, configurationInstance == nil, err) {
+		c.JSON(http.StatusOK, model.ConfigurationInstanceResponse{
+			ConfigurationInstance: configurationInstance,
+		})
+	}
+}
+
+// @Summary Delete configuration instance by name
+// @Produce json
+// @Router /configuration-instances/{name} [delete]
+// @Param 	name	path	string	true "the name of the configuration instance to delete"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteConfigurationInstance(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	configurationInstance, err := bindplane.Store().DeleteConfigurationInstance(name)
+	if okResource(c, configurationInstance == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// @Summary List label rules
+// @Produce json
+// @Router /label-rules [get]
+// @Success 200 {object} model.LabelRulesResponse
+// @Failure 500 {object} ErrorResponse
+func labelRules(c *gin.Context, bindplane server.BindPlane) {
+	labelRules, err := bindplane.Store().LabelRules()
+	if okResponse(c, err) {
+		c.JSON(http.StatusOK, model.LabelRulesResponse{
+			LabelRules: labelRules,
+		})
+	}
+}
+
+// @Summary Get label rule by name
+// @Produce json
+// @Router /label-rules/{name} [get]
+// @Param 	name	path	string	true "the name of the label rule"
+// @Success 200 {object} model.LabelRuleResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func labelRule(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	labelRule, err := bindplane.Store().LabelRule(name)
+	if okResource(c, labelRule == nil, err) {
+		c.JSON(http.StatusOK, model.LabelRuleResponse{
+			LabelRule: labelRule,
+		})
+	}
+}
+
+// @Summary Delete label rule by name
+// @Description Labels set by the rule are not removed from agents.
+// @Produce json
+// @Router /label-rules/{name} [delete]
+// @Param 	name	path	string	true "the name of the label rule to delete"
+// @Success 204	"Successful Delete, no content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+func deleteLabelRule(c *gin.Context, bindplane server.BindPlane) {
+	name := c.Param("name")
+	labelRule, err := bindplane.Store().DeleteLabelRule(name)
+	if okResource(c, labelRule == nil, err) {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// ----------------------------------------------------------------------
+
 // @Summary Create, edit, and configure multiple resources.
 // @Description The /apply route will try to parse resources
 // @Description and upsert them into the store.  Additionally
@@ -863,7 +1123,7 @@ func applyResources(c *gin.Context, bindplane server.BindPlane) {
 	}
 
 	c.JSON(http.StatusAccepted, &model.ApplyResponse{
-		Updates: resourceStatuses,
+		Updates: model.RedactResourceStatusSecrets(resourceStatuses),
 	})
 }
 
@@ -904,7 +1164,7 @@ func deleteResources(c *gin.Context, bindplane server.BindPlane) {
 	}
 
 	c.JSON(http.StatusAccepted, &model.DeleteResponse{
-		Updates: resourceStatuses,
+		Updates: model.RedactResourceStatusSecrets(resourceStatuses),
 	})
 }
 
@@ -232,6 +232,27 @@ func TestREST(t *testing.T) {
 		require.Equal(t, ar.Agent, agent)
 	})
 
+	t.Run("GET /agents/duplicates returns the agents assigned a new ID", func(t *testing.T) {
+		resetStore(t, s)
+
+		_, err := addAgent(s, &model.Agent{ID: "1", Name: "Fake Agent 1", Labels: model.MakeLabels()})
+		require.NoError(t, err)
+		duplicate1, err := addAgent(s, &model.Agent{ID: "2", Name: "Fake Agent 2", Labels: model.MakeLabels(),
+			Duplicate: &model.AgentDuplicate{OriginalID: "1", Reason: model.DuplicateConnection}})
+		require.NoError(t, err)
+		duplicate2, err := addAgent(s, &model.Agent{ID: "3", Name: "Fake Agent 3", Labels: model.MakeLabels(),
+			Duplicate: &model.AgentDuplicate{OriginalID: "4", Reason: model.DuplicateIdentity}})
+		require.NoError(t, err)
+
+		ar := &model.AgentDuplicatesResponse{}
+		getRequest(t, client, "/agents/duplicates", ar)
+		require.ElementsMatch(t, []*model.Agent{duplicate1, duplicate2}, ar.Agents)
+
+		ar = &model.AgentDuplicatesResponse{}
+		getRequest(t, client, "/agents/duplicates?id=1", ar)
+		require.Equal(t, []*model.Agent{duplicate1}, ar.Agents)
+	})
+
 	t.Run("GET /destinations returns all Destinations in the store", func(t *testing.T) {
 		resetStore(t, s)
 
@@ -687,6 +708,46 @@ func TestREST(t *testing.T) {
 		})
 	})
 
+	t.Run("PUT /configurations/:name/destinations/:component/flow-control", func(t *testing.T) {
+		resetStore(t, s)
+
+		config := testConfiguration("flow-control")
+		_, err := bindplane.Store().ApplyResources([]model.Resource{config})
+		require.NoError(t, err)
+
+		t.Run("404 Not Found configuration", func(t *testing.T) {
+			resp, err := client.R().SetBody(&model.FlowControl{Paused: true}).Put("/configurations/does-not-exist/destinations/destination0/flow-control")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNotFound, resp.StatusCode())
+		})
+
+		t.Run("404 Not Found destination", func(t *testing.T) {
+			resp, err := client.R().SetBody(&model.FlowControl{Paused: true}).Put("/configurations/flow-control/destinations/destination5/flow-control")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusNotFound, resp.StatusCode())
+		})
+
+		t.Run("400 Bad Request", func(t *testing.T) {
+			invalidPercentage := 150.0
+			resp, err := client.R().SetBody(&model.FlowControl{SamplingPercentage: &invalidPercentage}).Put("/configurations/flow-control/destinations/destination0/flow-control")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadRequest, resp.StatusCode())
+		})
+
+		t.Run("200 OK", func(t *testing.T) {
+			result := &model.FlowControlResponse{}
+			resp, err := client.R().SetBody(&model.FlowControl{Paused: true}).SetResult(result).Put("/configurations/flow-control/destinations/destination0/flow-control")
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode())
+			require.Equal(t, &model.FlowControl{Paused: true}, result.FlowControl)
+			require.Equal(t, model.StatusConfigured, result.Status)
+
+			updated, err := bindplane.Store().Configuration("flow-control")
+			require.NoError(t, err)
+			require.Equal(t, &model.FlowControl{Paused: true}, updated.Spec.Destinations[0].FlowControl)
+		})
+	})
+
 	t.Run("POST /delete Status 200 Accepted", func(t *testing.T) {
 		tests := []struct {
 			description   string
@@ -822,6 +883,51 @@ func TestREST(t *testing.T) {
 
 	})
 
+	t.Run("GET /agents/:id/configuration returns overrides", func(t *testing.T) {
+		// Setup
+		resetStore(t, bindplane.Store())
+
+		labels := map[string]string{"env": "test"}
+		addAgent(store, &model.Agent{ID: "1", Labels: model.Labels{Set: labels}})
+		addAgent(store, &model.Agent{ID: "2", Labels: model.Labels{Set: labels}})
+
+		destinationType := model.NewDestinationType("otlp", []model.ParameterDefinition{
+			{Name: "endpoint", Type: "string", Default: "otelcol:4317"},
+		})
+		override := model.ParameterOverride{
+			AgentID:     "1",
+			Destination: "destination0",
+			Parameters:  []model.Parameter{{Name: "endpoint", Value: "snowflake:4317"}},
+		}
+		config := model.NewConfigurationWithSpec("config", model.ConfigurationSpec{
+			Destinations: []model.ResourceConfiguration{{Type: "otlp"}},
+			Selector:     model.AgentSelector{MatchLabels: labels},
+			Overrides:    []model.ParameterOverride{override},
+		})
+
+		resources, err := bindplane.Store().ApplyResources([]model.Resource{destinationType, config})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusCreated, resources[1].Status, resources[1].Reason)
+
+		t.Run("/agents/1/configuration returns the override", func(t *testing.T) {
+			result := &model.ConfigurationResponse{}
+			_, err := client.R().SetResult(result).Get("/agents/1/configuration")
+			require.NoError(t, err)
+
+			require.NotNil(t, result.Configuration)
+			assert.Equal(t, []model.ParameterOverride{override}, result.Overrides)
+		})
+
+		t.Run("/agents/2/configuration returns no overrides", func(t *testing.T) {
+			result := &model.ConfigurationResponse{}
+			_, err := client.R().SetResult(result).Get("/agents/2/configuration")
+			require.NoError(t, err)
+
+			require.NotNil(t, result.Configuration)
+			assert.Empty(t, result.Overrides)
+		})
+	})
+
 	t.Run("PATCH /agents/labels status 200", func(t *testing.T) {
 		resetStore(t, bindplane.Store())
 
@@ -0,0 +1,277 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// agentBufferMaxBatch is the number of buffered agents that triggers a flush before the flush interval
+const agentBufferMaxBatch = 1000
+
+// bufferedAgent is an agent with changes that have not been written to the store
+type bufferedAgent struct {
+	// base is the agent when it was added to the buffer and current is the agent after all of the updates. Only the
+	// fields that differ between them are written to the store so that changes made to the store while the agent is
+	// buffered, e.g. labels modified with the REST API, are preserved.
+	base    *model.Agent
+	current *model.Agent
+}
+
+// agentBuffer coalesces agent updates and writes them to the store in batches. Each agent message would otherwise be
+// written in its own transaction which limits the number of agents that can connect at the same time. Agents that
+// become disconnected or report an error are written immediately.
+type agentBuffer struct {
+	store    store.Store
+	logger   *zap.Logger
+	interval time.Duration
+
+	// running is true while the flush loop is running. Updates are written directly to the store otherwise.
+	running  bool
+	pending  map[string]*bufferedAgent
+	flushing map[string]*bufferedAgent
+	mtx      sync.Mutex
+
+	// flushMtx ensures that only one batch is written at a time
+	flushMtx sync.Mutex
+	full     chan struct{}
+}
+
+func newAgentBuffer(s store.Store, interval time.Duration, logger *zap.Logger) *agentBuffer {
+	return &agentBuffer{
+		store:    s,
+		logger:   logger,
+		interval: interval,
+		pending:  map[string]*bufferedAgent{},
+		full:     make(chan struct{}, 1),
+	}
+}
+
+// run flushes the buffer at each interval until the context is done. Any remaining updates are flushed before it
+// returns.
+func (b *agentBuffer) run(ctx context.Context) {
+	b.mtx.Lock()
+	b.running = true
+	b.mtx.Unlock()
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			b.mtx.Lock()
+			b.running = false
+			b.mtx.Unlock()
+			// the context is done so the final flush uses a new one
+			if _, err := b.flush(context.Background()); err != nil {
+				b.logger.Error("unable to flush buffered agent updates", zap.Error(err))
+			}
+			return
+		case <-ticker.C:
+		case <-b.full:
+		}
+		if _, err := b.flush(ctx); err != nil {
+			b.logger.Error("unable to flush buffered agent updates", zap.Error(err))
+		}
+	}
+}
+
+// agent returns the buffered agent with the specified id or nil if it is not buffered
+func (b *agentBuffer) agent(agentID string) *model.Agent {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if entry, ok := b.pending[agentID]; ok {
+		return entry.current
+	}
+	if entry, ok := b.flushing[agentID]; ok {
+		return entry.current
+	}
+	return nil
+}
+
+// upsertAgent applies the updater to the buffered agent and returns the result. The update is written immediately if
+// the flush loop is not running or the agent status changed to Disconnected or Error.
+func (b *agentBuffer) upsertAgent(ctx context.Context, agentID string, updater store.AgentUpdater) (*model.Agent, error) {
+	b.mtx.Lock()
+	if !b.running {
+		b.mtx.Unlock()
+		return b.store.UpsertAgent(ctx, agentID, updater)
+	}
+
+	entry, err := b.entryLocked(agentID)
+	if err != nil {
+		b.mtx.Unlock()
+		return nil, err
+	}
+
+	agent, err := cloneAgent(entry.current)
+	if err != nil {
+		b.mtx.Unlock()
+		return nil, err
+	}
+	updater(agent)
+	critical := agent.Status != entry.current.Status && criticalAgentStatus(agent.Status)
+	entry.current = agent
+	size := len(b.pending)
+	b.mtx.Unlock()
+
+	if critical {
+		if _, err := b.flush(ctx); err != nil {
+			return agent, err
+		}
+		return agent, nil
+	}
+	if size >= agentBufferMaxBatch {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+	return agent, nil
+}
+
+// entryLocked returns the pending entry for the agent, adding it from the batch being flushed or the store if
+// necessary. b.mtx must be held.
+func (b *agentBuffer) entryLocked(agentID string) (*bufferedAgent, error) {
+	if entry, ok := b.pending[agentID]; ok {
+		return entry, nil
+	}
+
+	// the current agent is either being flushed or in the store
+	agent := &model.Agent{ID: agentID}
+	if entry, ok := b.flushing[agentID]; ok {
+		agent = entry.current
+	} else {
+		stored, err := b.store.Agent(agentID)
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			agent = stored
+		}
+	}
+
+	base, err := cloneAgent(agent)
+	if err != nil {
+		return nil, err
+	}
+	current, err := cloneAgent(agent)
+	if err != nil {
+		return nil, err
+	}
+	entry := &bufferedAgent{base: base, current: current}
+	b.pending[agentID] = entry
+	return entry, nil
+}
+
+// flush writes the buffered agents to the store in a single batch and returns the number of agents written
+func (b *agentBuffer) flush(ctx context.Context) (int, error) {
+	b.flushMtx.Lock()
+	defer b.flushMtx.Unlock()
+
+	b.mtx.Lock()
+	if len(b.pending) == 0 {
+		b.mtx.Unlock()
+		return 0, nil
+	}
+	flushing := b.pending
+	b.flushing = flushing
+	b.pending = map[string]*bufferedAgent{}
+	b.mtx.Unlock()
+
+	ctx, span := tracer.Start(ctx, "manager/flushAgents")
+	defer span.End()
+	span.SetAttributes(attribute.Int("count", len(flushing)))
+
+	agentIDs := make([]string, 0, len(flushing))
+	for agentID := range flushing {
+		agentIDs = append(agentIDs, agentID)
+	}
+
+	start := time.Now()
+	_, err := b.store.UpsertAgents(ctx, agentIDs, func(agent *model.Agent) {
+		entry := flushing[agent.ID]
+		mergeAgent(agent, entry.base, entry.current)
+	})
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.flushing = nil
+
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		// keep the changes to retry with the next flush
+		for agentID, entry := range flushing {
+			if newer, ok := b.pending[agentID]; ok {
+				newer.base = entry.base
+			} else {
+				b.pending[agentID] = entry
+			}
+		}
+		return 0, err
+	}
+
+	b.logger.Debug("flushed buffered agent updates", zap.Int("count", len(agentIDs)), zap.Duration("duration", time.Since(start)))
+	return len(agentIDs), nil
+}
+
+// criticalAgentStatus returns true if changes to the status should be written immediately
+func criticalAgentStatus(status model.AgentStatus) bool {
+	return status == model.Disconnected || status == model.Error
+}
+
+// cloneAgent returns a deep copy of the agent
+func cloneAgent(agent *model.Agent) (*model.Agent, error) {
+	data, err := json.Marshal(agent)
+	if err != nil {
+		return nil, err
+	}
+	clone := &model.Agent{}
+	if err := json.Unmarshal(data, clone); err != nil {
+		return nil, err
+	}
+	// SecretKey is not serialized
+	clone.SecretKey = agent.SecretKey
+	return clone, nil
+}
+
+// mergeAgent copies the fields of current that differ from base to the stored agent. If the agent is not in the store,
+// e.g. it was removed while buffered, all of the fields are copied.
+func mergeAgent(stored, base, current *model.Agent) {
+	if reflect.DeepEqual(stored, &model.Agent{ID: stored.ID}) {
+		*stored = *current
+		return
+	}
+	storedValue := reflect.ValueOf(stored).Elem()
+	baseValue := reflect.ValueOf(base).Elem()
+	currentValue := reflect.ValueOf(current).Elem()
+	for i := 0; i < storedValue.NumField(); i++ {
+		if !reflect.DeepEqual(baseValue.Field(i).Interface(), currentValue.Field(i).Interface()) {
+			storedValue.Field(i).Set(currentValue.Field(i))
+		}
+	}
+}
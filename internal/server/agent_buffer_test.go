@@ -0,0 +1,291 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+)
+
+func newTestAgentBuffer(t testing.TB, s store.Store) *agentBuffer {
+	b := newAgentBuffer(s, time.Hour, zap.NewNop())
+	// flushes are triggered by the tests
+	b.running = true
+	return b
+}
+
+// connectTestAgent updates the agent as if it sent a status message after connecting
+func connectTestAgent(agent *model.Agent) {
+	agent.Connect("v1.0.0")
+	agent.Status = model.Connected
+}
+
+func TestAgentBufferNotRunning(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	b := newAgentBuffer(s, time.Hour, zap.NewNop())
+
+	_, err := b.upsertAgent(ctx, "1", connectTestAgent)
+	require.NoError(t, err)
+
+	agent, err := s.Agent("1")
+	require.NoError(t, err)
+	require.NotNil(t, agent, "updates should be written directly before the flush loop starts")
+	require.Nil(t, b.agent("1"))
+}
+
+func TestAgentBufferFlush(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	b := newTestAgentBuffer(t, s)
+
+	for i := 0; i < 3; i++ {
+		agent, err := b.upsertAgent(ctx, "1", func(agent *model.Agent) {
+			connectTestAgent(agent)
+			agent.Name = fmt.Sprintf("agent-%d", i)
+		})
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("agent-%d", i), agent.Name)
+	}
+	_, err := b.upsertAgent(ctx, "2", connectTestAgent)
+	require.NoError(t, err)
+
+	// not written until flushed
+	agent, err := s.Agent("1")
+	require.NoError(t, err)
+	require.Nil(t, agent)
+	require.Equal(t, "agent-2", b.agent("1").Name)
+
+	count, err := b.flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	agent, err = s.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, "agent-2", agent.Name)
+	require.Equal(t, model.Connected, agent.Status)
+	require.Nil(t, b.agent("1"))
+
+	count, err = b.flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func TestAgentBufferCriticalStatus(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	b := newTestAgentBuffer(t, s)
+
+	_, err := b.upsertAgent(ctx, "1", connectTestAgent)
+	require.NoError(t, err)
+	_, err = b.upsertAgent(ctx, "2", connectTestAgent)
+	require.NoError(t, err)
+
+	// an error is written immediately along with the rest of the buffer
+	_, err = b.upsertAgent(ctx, "1", func(agent *model.Agent) {
+		agent.Status = model.Error
+		agent.ErrorMessage = "failed"
+	})
+	require.NoError(t, err)
+	agent, err := s.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, model.Error, agent.Status)
+	agent, err = s.Agent("2")
+	require.NoError(t, err)
+	require.Equal(t, model.Connected, agent.Status)
+
+	_, err = b.upsertAgent(ctx, "2", func(agent *model.Agent) { agent.Disconnect() })
+	require.NoError(t, err)
+	agent, err = s.Agent("2")
+	require.NoError(t, err)
+	require.Equal(t, model.Disconnected, agent.Status)
+}
+
+func TestAgentBufferPreservesStoreChanges(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	_, err := s.UpsertAgent(ctx, "1", func(agent *model.Agent) {
+		connectTestAgent(agent)
+		agent.Labels = model.MakeLabels()
+	})
+	require.NoError(t, err)
+
+	b := newTestAgentBuffer(t, s)
+	_, err = b.upsertAgent(ctx, "1", func(agent *model.Agent) { agent.Name = "buffered" })
+	require.NoError(t, err)
+
+	// labels changed in the store while the agent is buffered
+	labels, err := model.LabelsFromSelector("env=production")
+	require.NoError(t, err)
+	_, err = s.UpsertAgent(ctx, "1", func(agent *model.Agent) { agent.Labels = labels })
+	require.NoError(t, err)
+
+	_, err = b.flush(ctx)
+	require.NoError(t, err)
+
+	agent, err := s.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, "buffered", agent.Name)
+	require.Equal(t, "env=production", agent.Labels.String())
+}
+
+func TestAgentBufferRemovedAgent(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	_, err := s.UpsertAgent(ctx, "1", func(agent *model.Agent) {
+		connectTestAgent(agent)
+		agent.Name = "agent"
+	})
+	require.NoError(t, err)
+
+	b := newTestAgentBuffer(t, s)
+	_, err = b.upsertAgent(ctx, "1", func(agent *model.Agent) { agent.HostName = "host" })
+	require.NoError(t, err)
+
+	_, err = s.DeleteAgents(ctx, []string{"1"})
+	require.NoError(t, err)
+
+	_, err = b.flush(ctx)
+	require.NoError(t, err)
+
+	agent, err := s.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, "agent", agent.Name, "all fields should be written if the agent was removed")
+	require.Equal(t, "host", agent.HostName)
+}
+
+func TestManagerAgentBuffer(t *testing.T) {
+	// the store outlives the manager
+	s := store.NewMapStore(context.Background(), store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	m := newJobsTestManager(t, s, &common.Server{AgentStatusFlushInterval: time.Hour})
+
+	done := make(chan struct{})
+	go func() {
+		m.agentBuffer.run(ctx)
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		m.agentBuffer.mtx.Lock()
+		defer m.agentBuffer.mtx.Unlock()
+		return m.agentBuffer.running
+	}, time.Second, 10*time.Millisecond)
+
+	_, err := m.UpsertAgent(ctx, "1", connectTestAgent)
+	require.NoError(t, err)
+	agent, err := m.Agent(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, model.Connected, agent.Status)
+
+	// remaining updates are flushed when the manager stops
+	cancel()
+	<-done
+	agent, err = s.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, model.Connected, agent.Status)
+}
+
+// ----------------------------------------------------------------------
+
+const benchmarkAgentCount = 10_000
+
+// BenchmarkAgentStatusWrites measures the time to record a status message from each of 10k agents sending messages at
+// the same time, e.g. after a server restart
+func BenchmarkAgentStatusWrites(b *testing.B) {
+	b.Run("direct", func(b *testing.B) {
+		benchmarkAgentStatusWrites(b, func(s store.Store) (store.Store, func(ctx context.Context) error) {
+			return s, func(ctx context.Context) error { return nil }
+		})
+	})
+	b.Run("buffered", func(b *testing.B) {
+		benchmarkAgentStatusWrites(b, func(s store.Store) (store.Store, func(ctx context.Context) error) {
+			buffer := newTestAgentBuffer(b, s)
+			return &bufferedStore{Store: s, buffer: buffer}, func(ctx context.Context) error {
+				_, err := buffer.flush(ctx)
+				return err
+			}
+		})
+	})
+}
+
+// bufferedStore sends agent updates through the buffer
+type bufferedStore struct {
+	store.Store
+	buffer *agentBuffer
+}
+
+func (s *bufferedStore) UpsertAgent(ctx context.Context, agentID string, updater store.AgentUpdater) (*model.Agent, error) {
+	return s.buffer.upsertAgent(ctx, agentID, updater)
+}
+
+func benchmarkAgentStatusWrites(b *testing.B, setup func(s store.Store) (store.Store, func(ctx context.Context) error)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, err := store.InitDB(filepath.Join(b.TempDir(), "bindplane.db"))
+	require.NoError(b, err)
+	defer db.Close()
+
+	boltstore := store.NewBoltStore(ctx, db, store.Options{SessionsSecret: "super-secret-key", MaxEventsToMerge: 1}, zap.NewNop())
+	s, flush := setup(boltstore)
+
+	agentIDs := make([]string, benchmarkAgentCount)
+	for i := range agentIDs {
+		agentIDs[i] = fmt.Sprintf("agent-%d", i)
+	}
+
+	// simulated agents send messages concurrently
+	const workers = 100
+	b.ResetTimer()
+	start := time.Now()
+	for n := 0; n < b.N; n++ {
+		var wg sync.WaitGroup
+		ids := make(chan string, len(agentIDs))
+		for _, agentID := range agentIDs {
+			ids <- agentID
+		}
+		close(ids)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for agentID := range ids {
+					_, err := s.UpsertAgent(ctx, agentID, func(agent *model.Agent) {
+						connectTestAgent(agent)
+						agent.Name = agentID
+					})
+					if err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		require.NoError(b, flush(ctx))
+	}
+	b.ReportMetric(float64(benchmarkAgentCount*b.N)/time.Since(start).Seconds(), "agents/s")
+}
@@ -0,0 +1,211 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.uber.org/zap"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/model"
+)
+
+// job is a background task run periodically by the manager
+type job struct {
+	name     string
+	interval time.Duration
+
+	// leader is true if the job should only run on one server when multiple servers share a store. The server holding
+	// the lease for the job runs it.
+	leader bool
+
+	// run performs the job and returns the number of items processed
+	run func(ctx context.Context) (int, error)
+}
+
+// jobMetrics are the instruments used to record job runs. Each measurement has a job attribute with the name of the job.
+type jobMetrics struct {
+	runs      syncint64.Counter
+	duration  syncfloat64.Histogram
+	processed syncint64.Counter
+}
+
+func newJobMetrics(meter metric.Meter) (*jobMetrics, error) {
+	runs, err := meter.SyncInt64().Counter("bindplane.manager.job.runs",
+		instrument.WithDescription("Number of job runs by job and result"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the job runs counter: %w", err)
+	}
+	duration, err := meter.SyncFloat64().Histogram("bindplane.manager.job.duration",
+		instrument.WithDescription("Duration of job runs"),
+		instrument.WithUnit(unit.Milliseconds),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the job duration histogram: %w", err)
+	}
+	processed, err := meter.SyncInt64().Counter("bindplane.manager.job.processed",
+		instrument.WithDescription("Number of items processed by job runs, e.g. agents deleted by agentCleanup"),
+		instrument.WithUnit(unit.Dimensionless),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the job processed counter: %w", err)
+	}
+	return &jobMetrics{runs: runs, duration: duration, processed: processed}, nil
+}
+
+// record records a run of the job. The result attribute of the run is success or error.
+func (jm *jobMetrics) record(ctx context.Context, name string, count int, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	jobAttr := attribute.String("job", name)
+	jm.runs.Add(ctx, 1, jobAttr, attribute.String("result", result))
+	jm.duration.Record(ctx, float64(duration)/float64(time.Millisecond), jobAttr)
+	jm.processed.Add(ctx, int64(count), jobAttr)
+}
+
+// jobLeaseMultiplier is the number of intervals that a job lease is held without being renewed. If the leader stops
+// running the job, another server will acquire the lease after this many intervals.
+const jobLeaseMultiplier = 3
+
+// jobs returns the background jobs run by the manager
+func (m *manager) jobs() []job {
+	return []job{
+		{
+			name:     "agentCleanup",
+			interval: m.agentCleanupInterval,
+			leader:   true,
+			run:      m.handleAgentCleanup,
+		},
+		{
+			// heartbeats are sent to the agents connected to each server so this runs on every server
+			name:     "agentHeartbeat",
+			interval: m.agentHeartbeatInterval,
+			run:      m.handleAgentHeartbeat,
+		},
+	}
+}
+
+// runJob runs the job at each interval until the context is done. Jobs without an interval are not run.
+func (m *manager) runJob(ctx context.Context, j job) {
+	if j.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.runJobOnce(ctx, j)
+		}
+	}
+}
+
+// runJobOnce runs the job if this server is the leader or the job does not require a leader and reports the run in the
+// logs, metrics, and a trace span
+func (m *manager) runJobOnce(ctx context.Context, j job) {
+	ctx, span := tracer.Start(ctx, fmt.Sprintf("manager/job/%s", j.name))
+	defer span.End()
+
+	logger := m.logger.With(zap.String("job", j.name))
+
+	if j.leader {
+		leader, err := m.store.AcquireLease(ctx, fmt.Sprintf("job/%s", j.name), m.serverID, jobLeaseMultiplier*j.interval)
+		if err != nil {
+			logger.Error("unable to acquire job lease", zap.Error(err))
+			span.SetStatus(codes.Error, err.Error())
+			return
+		}
+		span.SetAttributes(attribute.Bool("leader", leader))
+		if !leader {
+			logger.Debug("skipping job run on follower")
+			return
+		}
+	}
+
+	start := time.Now()
+	count, err := j.run(ctx)
+	duration := time.Since(start)
+	span.SetAttributes(attribute.Int("count", count))
+	m.jobMetrics.record(ctx, j.name, count, duration, err)
+
+	if err != nil {
+		logger.Error("job run failed", zap.Int("count", count), zap.Duration("duration", duration), zap.Error(err))
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	if count > 0 {
+		logger.Info("job run completed", zap.Int("count", count), zap.Duration("duration", duration))
+		return
+	}
+	logger.Debug("job run completed", zap.Int("count", count), zap.Duration("duration", duration))
+}
+
+// ----------------------------------------------------------------------
+
+// agentRetention is the TTL of disconnected agents matching a selector
+type agentRetention struct {
+	selector model.Selector
+	ttl      time.Duration
+}
+
+// agentRetentionPolicies determine how long disconnected agents are kept before they are removed
+type agentRetentionPolicies struct {
+	policies   []agentRetention
+	defaultTTL time.Duration
+}
+
+func newAgentRetentionPolicies(config common.AgentCleanup, defaultTTL time.Duration) (agentRetentionPolicies, error) {
+	result := agentRetentionPolicies{defaultTTL: defaultTTL}
+	for _, retention := range config.Retention {
+		selector, err := model.SelectorFromString(retention.Selector)
+		if err != nil {
+			return result, fmt.Errorf("invalid agent retention selector %s: %w", retention.Selector, err)
+		}
+		result.policies = append(result.policies, agentRetention{selector: selector, ttl: retention.TTL})
+	}
+	return result, nil
+}
+
+// ttl returns the TTL of the first policy matching the agent or the default TTL if no policy matches
+func (p agentRetentionPolicies) ttl(agent *model.Agent) time.Duration {
+	for _, policy := range p.policies {
+		if agent.MatchesSelector(policy.selector) {
+			return policy.ttl
+		}
+	}
+	return p.defaultTTL
+}
+
+// expired returns true if the agent has been disconnected longer than its TTL
+func (p agentRetentionPolicies) expired(agent *model.Agent, now time.Time) bool {
+	return agent.DisconnectedSince(now.Add(-p.ttl(agent)))
+}
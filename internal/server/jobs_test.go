@@ -0,0 +1,200 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/observiq/bindplane-op/common"
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/internal/util/metrictest"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+)
+
+func newJobsTestManager(t *testing.T, s store.Store, config *common.Server) *manager {
+	m, err := NewManager(config, s, zap.NewNop())
+	require.NoError(t, err)
+	return m.(*manager)
+}
+
+func TestHandleAgentCleanup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+
+	now := time.Now()
+	addAgent := func(id string, labels string, disconnectedAt *time.Time) {
+		_, err := s.UpsertAgent(ctx, id, func(agent *model.Agent) {
+			agent.Labels, _ = model.LabelsFromSelector(labels)
+			agent.DisconnectedAt = disconnectedAt
+		})
+		require.NoError(t, err)
+	}
+	ago := func(d time.Duration) *time.Time {
+		at := now.Add(-d)
+		return &at
+	}
+	addAgent("connected", "env=prod", nil)
+	addAgent("prod-recent", "env=prod", ago(48*time.Hour))
+	addAgent("prod-old", "env=prod", ago(31*24*time.Hour))
+	addAgent("ci-old", "env=ci", ago(2*time.Hour))
+	addAgent("ci-recent", "env=ci", ago(30*time.Minute))
+	addAgent("other-old", "env=dev", ago(20*time.Minute))
+	addAgent("other-recent", "env=dev", ago(10*time.Minute))
+
+	m := newJobsTestManager(t, s, &common.Server{
+		AgentCleanup: common.AgentCleanup{
+			Retention: []common.AgentRetention{
+				{Selector: "env=prod", TTL: 30 * 24 * time.Hour},
+				{Selector: "env=ci", TTL: time.Hour},
+			},
+		},
+	})
+
+	count, err := m.handleAgentCleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+
+	agents, err := s.Agents(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, agent := range agents {
+		ids = append(ids, agent.ID)
+	}
+	require.ElementsMatch(t, []string{"connected", "prod-recent", "ci-recent", "other-recent"}, ids)
+}
+
+// reconnectingStore reconnects an agent after the agents are read, as if it reconnected during agent cleanup
+type reconnectingStore struct {
+	store.Store
+	agentID string
+}
+
+func (s *reconnectingStore) Agents(ctx context.Context, options ...store.QueryOption) ([]*model.Agent, error) {
+	agents, err := s.Store.Agents(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.Store.UpsertAgent(ctx, s.agentID, func(agent *model.Agent) { agent.DisconnectedAt = nil })
+	return agents, err
+}
+
+func TestHandleAgentCleanupReconnected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+
+	disconnectedAt := time.Now().Add(-2 * time.Hour)
+	for _, id := range []string{"reconnected", "disconnected"} {
+		_, err := s.UpsertAgent(ctx, id, func(agent *model.Agent) { agent.DisconnectedAt = &disconnectedAt })
+		require.NoError(t, err)
+	}
+
+	m := newJobsTestManager(t, &reconnectingStore{Store: s, agentID: "reconnected"}, &common.Server{
+		AgentCleanup: common.AgentCleanup{TTL: time.Hour},
+	})
+	count, err := m.handleAgentCleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	agent, err := s.Agent("reconnected")
+	require.NoError(t, err)
+	require.NotNil(t, agent, "an agent that reconnected during cleanup should not be deleted")
+	agent, err = s.Agent("disconnected")
+	require.NoError(t, err)
+	require.Nil(t, agent)
+}
+
+func TestNewManagerInvalidRetention(t *testing.T) {
+	_, err := NewManager(&common.Server{
+		AgentCleanup: common.AgentCleanup{
+			Retention: []common.AgentRetention{{Selector: "env in (", TTL: time.Hour}},
+		},
+	}, nil, zap.NewNop())
+	require.ErrorContains(t, err, "invalid agent retention selector env in (")
+}
+
+func TestRunJobOnceLeader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+
+	leader := newJobsTestManager(t, s, &common.Server{})
+	follower := newJobsTestManager(t, s, &common.Server{})
+
+	runs := map[string]int{}
+	testJob := func(m *manager, leaderOnly bool) job {
+		return job{
+			name:     "test",
+			interval: time.Minute,
+			leader:   leaderOnly,
+			run: func(ctx context.Context) (int, error) {
+				runs[m.serverID]++
+				return 0, nil
+			},
+		}
+	}
+
+	leader.runJobOnce(ctx, testJob(leader, true))
+	follower.runJobOnce(ctx, testJob(follower, true))
+	leader.runJobOnce(ctx, testJob(leader, true))
+	require.Equal(t, 2, runs[leader.serverID])
+	require.Equal(t, 0, runs[follower.serverID])
+
+	// jobs that do not require a leader run on every server
+	follower.runJobOnce(ctx, testJob(follower, false))
+	require.Equal(t, 1, runs[follower.serverID])
+}
+
+func TestRunJobOnceMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := store.NewMapStore(ctx, store.Options{SessionsSecret: "super-secret-key"}, zap.NewNop())
+
+	m := newJobsTestManager(t, s, &common.Server{})
+	meter := metrictest.NewMeter()
+	var err error
+	m.jobMetrics, err = newJobMetrics(meter)
+	require.NoError(t, err)
+
+	m.runJobOnce(ctx, job{name: "test", run: func(ctx context.Context) (int, error) { return 3, nil }})
+	m.runJobOnce(ctx, job{name: "test", run: func(ctx context.Context) (int, error) { return 1, errors.New("failed") }})
+
+	jobAttr := attribute.String("job", "test")
+	require.Equal(t, 1.0, meter.Sum("bindplane.manager.job.runs", jobAttr, attribute.String("result", "success")))
+	require.Equal(t, 1.0, meter.Sum("bindplane.manager.job.runs", jobAttr, attribute.String("result", "error")))
+	require.Equal(t, 4.0, meter.Sum("bindplane.manager.job.processed", jobAttr))
+	require.Len(t, meter.Measurements("bindplane.manager.job.duration"), 2)
+}
+
+func TestHandleAgentHeartbeat(t *testing.T) {
+	protocol := &mockProtocol{}
+	protocol.On("Name").Return("mock")
+	protocol.On("ConnectedAgentIDs", mock.Anything).Return([]string{"1", "2"}, nil)
+	protocol.On("SendHeartbeat", mock.Anything).Return(nil)
+
+	m := &manager{logger: zap.NewNop(), protocols: []Protocol{protocol}}
+	count, err := m.handleAgentHeartbeat(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	protocol.AssertNumberOfCalls(t, "SendHeartbeat", 2)
+}
@@ -16,12 +16,16 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 
 	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/eventbus"
@@ -30,14 +34,17 @@ import (
 )
 
 var tracer = otel.Tracer("bindplane/manager")
+var meter = global.Meter("bindplane/manager")
 
 const (
 	// AgentCleanupInterval is the default agent cleanup interval.
-	AgentCleanupInterval = time.Minute
+	AgentCleanupInterval = common.DefaultAgentCleanupInterval
 	// AgentCleanupTTL is the default agent cleanup time to live.
-	AgentCleanupTTL = 15 * time.Minute
+	AgentCleanupTTL = common.DefaultAgentCleanupTTL
 	// AgentHeartbeatInterval is the default interval for the heartbeat sent to the agent to keep the websocket live.
-	AgentHeartbeatInterval = 30 * time.Second
+	AgentHeartbeatInterval = common.DefaultAgentHeartbeatInterval
+	// AgentStatusFlushInterval is the default interval at which buffered agent status changes are written to the store.
+	AgentStatusFlushInterval = common.DefaultAgentStatusFlushInterval
 )
 
 // Manager manages agent connects and communications with them
@@ -56,30 +63,66 @@ type Manager interface {
 	VerifySecretKey(ctx context.Context, secretKey string) bool
 	// ResourceStore provides access to the store to render configurations
 	ResourceStore() model.ResourceStore
+	// LabelRules returns the rules used to label agents when they connect
+	LabelRules(ctx context.Context) ([]*model.LabelRule, error)
 }
 
 // ----------------------------------------------------------------------
 
 type manager struct {
-	// agentCleanupTicker   *time.Ticker
-	// agentHeartbeatTicker *time.Ticker
+	// serverID identifies this server when acquiring leases for background jobs
+	serverID string
+
+	agentCleanupInterval   time.Duration
+	agentHeartbeatInterval time.Duration
+	agentRetention         agentRetentionPolicies
+	jobMetrics             *jobMetrics
+
+	// agentBuffer coalesces agent status changes and writes them to the store in batches while the manager is running
+	agentBuffer *agentBuffer
+
 	store     store.Store
 	logger    *zap.Logger
 	protocols []Protocol
 	secretKey string
+	// previousSecretKeys are also accepted from agents while they are migrated to the secretKey
+	previousSecretKeys []string
+	// secretKeyring decrypts secret parameters when configurations are rendered for agents
+	secretKeyring *model.SecretKeyring
+	// secretProviders resolve secret references when configurations are rendered for agents
+	secretProviders model.SecretProviders
 }
 
 var _ Manager = (*manager)(nil)
 
 // NewManager returns a new implementation of the Manager interface
-func NewManager(config *common.Server, store store.Store, logger *zap.Logger) (Manager, error) {
+func NewManager(config *common.Server, s store.Store, logger *zap.Logger) (Manager, error) {
+	secretKeyring, err := store.NewSecretKeyring(config)
+	if err != nil {
+		return nil, err
+	}
+	agentRetention, err := newAgentRetentionPolicies(config.AgentCleanup, config.AgentCleanupTTL())
+	if err != nil {
+		return nil, err
+	}
+	jobMetrics, err := newJobMetrics(meter)
+	if err != nil {
+		return nil, err
+	}
 	return &manager{
-		// agentCleanupTicker:   time.NewTicker(AgentCleanupInterval),
-		// agentHeartbeatTicker: time.NewTicker(AgentHeartbeatInterval),
-		store:     store,
-		logger:    logger,
-		protocols: []Protocol{},
-		secretKey: config.SecretKey,
+		serverID:               uuid.NewString(),
+		agentCleanupInterval:   config.AgentCleanupInterval(),
+		agentHeartbeatInterval: config.AgentHeartbeat(),
+		agentRetention:         agentRetention,
+		jobMetrics:             jobMetrics,
+		agentBuffer:            newAgentBuffer(s, config.AgentStatusFlush(), logger),
+		store:                  s,
+		logger:                 logger,
+		protocols:              []Protocol{},
+		secretKey:              config.SecretKey,
+		previousSecretKeys:     config.PreviousSecretKeys,
+		secretKeyring:          secretKeyring,
+		secretProviders:        store.NewSecretProviders(config),
 	}, nil
 }
 
@@ -87,16 +130,19 @@ func (m *manager) EnableProtocol(protocol Protocol) {
 	m.protocols = append(m.protocols, protocol)
 }
 
-// Start TODO(doc)
+// Start processes configuration changes and runs the background jobs until the context is done
 func (m *manager) Start(ctx context.Context) {
 	updatesChannel, unsubscribe := eventbus.Subscribe(m.store.Updates(), eventbus.WithChannel(make(chan *store.Updates, 10_000)))
 	defer unsubscribe()
 
+	for _, j := range m.jobs() {
+		go m.runJob(ctx, j)
+	}
+	go m.agentBuffer.run(ctx)
+
 	for {
 		select {
 		case <-ctx.Done():
-			// m.agentCleanupTicker.Stop()
-			// m.agentHeartbeatTicker.Stop()
 			return
 
 		case updates := <-updatesChannel:
@@ -106,13 +152,6 @@ func (m *manager) Start(ctx context.Context) {
 				zap.Int("Configurations", len(updates.Configurations)),
 			)
 			m.handleUpdates(updates)
-
-			// TODO: determine if these need to be replaced and if so, replace them
-			// case <-m.agentCleanupTicker.C:
-			// 	m.handleAgentCleanup()
-
-			// case <-m.agentHeartbeatTicker.C:
-			// 	m.handleAgentHeartbeat()
 		}
 	}
 }
@@ -197,6 +236,12 @@ func (m *manager) handleUpdates(updates *store.Updates) {
 	ctx, span := tracer.Start(context.TODO(), "manager/handleUpdates")
 	defer span.End()
 
+	// configurations generated from instances will be sent with the next updates
+	m.generateConfigurations(updates.ConfigurationInstances)
+
+	// agents labeled by rules will be sent their labels with the next updates
+	m.applyLabelRules(ctx, updates.LabelRules)
+
 	pending := pendingAgentUpdates{}
 
 	for _, change := range updates.Agents {
@@ -267,15 +312,127 @@ func (m *manager) handleUpdates(updates *store.Updates) {
 
 	}
 
+	// configurations are rendered once for all of the agents updated together unless they reference the agent
+	ctx = WithConfigurationRenderer(ctx, NewConfigurationRenderer(m.ResourceStore()))
 	pending.apply(ctx, m)
 }
 
+// generateConfigurations applies the configurations generated by inserted or updated ConfigurationInstances and
+// deletes the configurations generated by removed ConfigurationInstances. Updates to a ConfigurationTemplate include
+// updates to its instances so that their configurations are generated again.
+func (m *manager) generateConfigurations(events store.Events[*model.ConfigurationInstance]) {
+	var generated []model.Resource
+	for _, event := range events {
+		instance := event.Item
+
+		if event.Type == store.EventTypeRemove {
+			m.deleteGeneratedConfiguration(instance)
+			continue
+		}
+
+		template, err := m.store.ConfigurationTemplate(instance.Spec.Template)
+		if err != nil || template == nil {
+			m.logger.Error("unable to find configuration template", zap.String("configurationInstance", instance.Name()), zap.String("configurationTemplate", instance.Spec.Template), zap.Error(err))
+			continue
+		}
+		configuration, err := instance.Generate(template)
+		if err != nil {
+			m.logger.Error("unable to generate configuration", zap.String("configurationInstance", instance.Name()), zap.Error(err))
+			continue
+		}
+		generated = append(generated, configuration)
+	}
+	if len(generated) == 0 {
+		return
+	}
+
+	statuses, err := m.store.ApplyResources(generated)
+	if err != nil {
+		m.logger.Error("unable to apply generated configurations", zap.Error(err))
+	}
+	for _, status := range statuses {
+		if status.Status == model.StatusInvalid || status.Status == model.StatusError {
+			m.logger.Error("unable to apply generated configuration", zap.String("configuration.name", status.Resource.Name()), zap.String("reason", status.Reason))
+		}
+	}
+}
+
+func (m *manager) deleteGeneratedConfiguration(instance *model.ConfigurationInstance) {
+	configuration, err := m.store.Configuration(instance.Name())
+	if err != nil || configuration == nil {
+		return
+	}
+	// only delete configurations generated from the template of the instance
+	if configuration.Metadata.Labels.Get(model.ConfigurationTemplateLabel) != instance.Spec.Template {
+		return
+	}
+	if _, err := m.store.DeleteConfiguration(configuration.Name()); err != nil {
+		m.logger.Error("unable to delete generated configuration", zap.String("configuration.name", configuration.Name()), zap.Error(err))
+	}
+}
+
+// applyLabelRules labels the agents that match inserted or updated LabelRules. The label changes are written to the store
+// which sends the labels and any new configuration to connected agents.
+func (m *manager) applyLabelRules(ctx context.Context, events store.Events[*model.LabelRule]) {
+	var rules []*model.LabelRule
+	for _, event := range events {
+		if event.Type == store.EventTypeInsert || event.Type == store.EventTypeUpdate {
+			rules = append(rules, event.Item)
+		}
+	}
+	if len(rules) == 0 {
+		return
+	}
+
+	agents, err := m.store.Agents(ctx)
+	if err != nil {
+		m.logger.Error("unable to apply label rules", zap.Error(err))
+		return
+	}
+	var agentIDs []string
+	for _, agent := range agents {
+		// the rules replace the labels of the copy without modifying the labels of the agent
+		labeled := *agent
+		if model.ApplyLabelRules(&labeled, rules) {
+			agentIDs = append(agentIDs, agent.ID)
+		}
+	}
+	if len(agentIDs) == 0 {
+		return
+	}
+
+	m.logger.Info("labeling agents that match label rules", zap.Int("count", len(agentIDs)))
+	_, err = m.store.UpsertAgents(ctx, agentIDs, func(agent *model.Agent) {
+		model.ApplyLabelRules(agent, rules)
+	})
+	if err != nil {
+		m.logger.Error("unable to apply label rules", zap.Error(err))
+	}
+}
+
+// LabelRules returns the rules used to label agents when they connect
+func (m *manager) LabelRules(ctx context.Context) ([]*model.LabelRule, error) {
+	return m.store.LabelRules()
+}
+
+// Agent returns the agent with the specified agentID, including any buffered changes that have not been written to the
+// store
 func (m *manager) Agent(ctx context.Context, agentID string) (*model.Agent, error) {
+	if m.agentBuffer != nil {
+		if agent := m.agentBuffer.agent(agentID); agent != nil {
+			return agent, nil
+		}
+	}
 	return m.store.Agent(agentID)
 }
 
+// UpsertAgent adds a new Agent to the Store or updates an existing one. While the manager is running, changes are
+// buffered and written in batches unless the agent becomes disconnected or reports an error.
 func (m *manager) UpsertAgent(ctx context.Context, agentID string, updater store.AgentUpdater) (*model.Agent, error) {
-	return m.store.UpsertAgent(ctx, agentID, updater)
+	if m.agentBuffer == nil {
+		return m.store.UpsertAgent(ctx, agentID, updater)
+	}
+	return m.agentBuffer.upsertAgent(ctx, agentID, updater)
 }
 
 // AgentUpdates returns the updates that should be applied to an agent based on the current bindplane configuration
@@ -291,34 +448,56 @@ func (m *manager) AgentUpdates(ctx context.Context, agent *model.Agent) (*AgentU
 	}, nil
 }
 
-// VerifySecretKey checks to see if the specified secretKey matches configured secretKey. If the BindPlane server does not
-// have a configured secretKey, this returns true.
+// VerifySecretKey checks to see if the specified secretKey matches configured secretKey or one of the previous secret
+// keys. If the BindPlane server does not have a configured secretKey, this returns true.
 func (m *manager) VerifySecretKey(ctx context.Context, secretKey string) bool {
-	return m.secretKey == "" || m.secretKey == secretKey
+	return m.secretKey == "" || m.secretKey == secretKey || (secretKey != "" && slices.Contains(m.previousSecretKeys, secretKey))
 }
 
-// ResourceStore provides access to the store to render configurations
+// ResourceStore provides access to the store to render configurations. Secret parameters are decrypted and secret
+// references are resolved when configurations are rendered with this ResourceStore.
 func (m *manager) ResourceStore() model.ResourceStore {
-	return m.store
+	return model.WithSecretProviders(model.WithSecretKeyring(m.store, m.secretKeyring), m.secretProviders)
 }
 
-// handleAgentCleanup removes disconnected agents from the store.
-func (m *manager) handleAgentCleanup() {
-	_, span := tracer.Start(context.TODO(), "manager/handleAgentCleanup")
+// handleAgentCleanup removes agents that have been disconnected longer than the TTL of their retention policy and
+// returns the number of agents removed
+func (m *manager) handleAgentCleanup(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "manager/handleAgentCleanup")
 	defer span.End()
 
+	agents, err := m.store.Agents(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get agents: %w", err)
+	}
+
 	now := time.Now()
-	// TODO: in a cluster, move this to a job
-	err := m.store.CleanupDisconnectedAgents(now.Add(-AgentCleanupTTL))
+	var expired []string
+	for _, agent := range agents {
+		if m.agentRetention.expired(agent, now) {
+			expired = append(expired, agent.ID)
+		}
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	// agents that reconnected since they were read are not deleted
+	deleted, err := m.store.DeleteAgentsIf(ctx, expired, func(agent *model.Agent) bool {
+		return m.agentRetention.expired(agent, now)
+	})
 	if err != nil {
-		m.logger.Error("error cleaning up disconnected agents", zap.Error(err))
+		return 0, fmt.Errorf("unable to delete disconnected agents: %w", err)
 	}
+	return len(deleted), nil
 }
 
-func (m *manager) handleAgentHeartbeat() {
-	ctx, span := tracer.Start(context.TODO(), "manager/handleAgentHeartbeat")
+// handleAgentHeartbeat sends a heartbeat to each connected agent and returns the number of heartbeats sent
+func (m *manager) handleAgentHeartbeat(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "manager/handleAgentHeartbeat")
 	defer span.End()
 
+	var sent int
 	for _, p := range m.protocols {
 		ids, err := p.ConnectedAgentIDs(ctx)
 		if err != nil {
@@ -331,8 +510,10 @@ func (m *manager) handleAgentHeartbeat() {
 				m.logger.Error("unable to get send agent heartbeat", zap.String("protocol", p.Name()), zap.String("agentID", id))
 				continue
 			}
+			sent++
 		}
 	}
+	return sent, nil
 }
 
 // ----------------------------------------------------------------------
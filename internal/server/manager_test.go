@@ -17,7 +17,9 @@ package server
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/observiq/bindplane-op/internal/eventbus"
 	"github.com/observiq/bindplane-op/internal/store"
 	"github.com/observiq/bindplane-op/model"
 	"github.com/stretchr/testify/mock"
@@ -236,12 +238,116 @@ func TestHandleUpdatesNewConfigurationAndLabels(t *testing.T) {
 	testProtocol.AssertExpectations(t)
 }
 
+func TestHandleUpdatesConfigurationInstance(t *testing.T) {
+	managerTestReset()
+	template := model.NewConfigurationTemplate("region", model.ConfigurationTemplateSpec{
+		Parameters: []model.ParameterDefinition{{Name: "region", Type: "string", Required: true}},
+		Configuration: model.ConfigurationSpec{
+			Raw: "receivers: {}\n# [[ .region ]]",
+		},
+	})
+	instance := model.NewConfigurationInstance("west", "region", []model.Parameter{{Name: "region", Value: "us-west"}})
+	_, err := testMapstore.ApplyResources([]model.Resource{template, instance})
+	require.NoError(t, err)
+
+	updates := store.NewUpdates()
+	updates.ConfigurationInstances.Include(instance, store.EventTypeInsert)
+	testManager.handleUpdates(updates)
+
+	configuration, err := testMapstore.Configuration("west")
+	require.NoError(t, err)
+	require.NotNil(t, configuration)
+	require.Equal(t, "receivers: {}\n# us-west", configuration.Spec.Raw)
+	require.Equal(t, "region", configuration.Metadata.Labels.Get(model.ConfigurationTemplateLabel))
+
+	// changes to the template generate the configuration again
+	template.Spec.Configuration.Raw = "receivers: {}\n# region [[ .region ]]"
+	_, err = testMapstore.ApplyResources([]model.Resource{template})
+	require.NoError(t, err)
+
+	updates = store.NewUpdates()
+	updates.ConfigurationInstances.Include(instance, store.EventTypeUpdate)
+	testManager.handleUpdates(updates)
+
+	configuration, err = testMapstore.Configuration("west")
+	require.NoError(t, err)
+	require.Equal(t, "receivers: {}\n# region us-west", configuration.Spec.Raw)
+
+	// removing the instance deletes the configuration
+	updates = store.NewUpdates()
+	updates.ConfigurationInstances.Include(instance, store.EventTypeRemove)
+	testManager.handleUpdates(updates)
+
+	configuration, err = testMapstore.Configuration("west")
+	require.NoError(t, err)
+	require.Nil(t, configuration)
+
+	testProtocol.AssertExpectations(t)
+}
+
+func TestHandleUpdatesLabelRules(t *testing.T) {
+	managerTestReset()
+	agents := []*model.Agent{
+		{ID: "1", HostName: "web-1", Platform: "linux", Labels: model.LabelsFromValidatedMap(map[string]string{"env": "dev"})},
+		{ID: "2", HostName: "db-1", Platform: "linux", Labels: model.MakeLabels()},
+		{ID: "3", HostName: "web-2", Platform: "linux", Labels: model.LabelsFromValidatedMap(map[string]string{"app": "web"})},
+	}
+	for _, agent := range agents {
+		agent := agent
+		_, err := testMapstore.UpsertAgent(context.TODO(), agent.ID, func(current *model.Agent) { *current = *agent })
+		require.NoError(t, err)
+	}
+
+	rule := model.NewLabelRule("web", model.LabelRuleSpec{
+		Match:  model.LabelRuleMatch{HostName: "^web-"},
+		Labels: map[string]string{"app": "web"},
+	})
+	_, err := testMapstore.ApplyResources([]model.Resource{rule})
+	require.NoError(t, err)
+
+	updatesChannel, unsubscribe := eventbus.Subscribe(testMapstore.Updates())
+	defer unsubscribe()
+
+	updates := store.NewUpdates()
+	updates.LabelRules.Include(rule, store.EventTypeInsert)
+	testManager.handleUpdates(updates)
+
+	expectLabels := map[string]string{"1": "app=web,env=dev", "2": "", "3": "app=web"}
+	for agentID, labels := range expectLabels {
+		agent, err := testMapstore.Agent(agentID)
+		require.NoError(t, err)
+		require.Equal(t, labels, agent.Labels.String(), agentID)
+	}
+
+	// the label change is sent to the agent by the existing label updates. earlier updates may still be delivered.
+	require.Eventually(t, func() bool {
+		select {
+		case agentUpdates := <-updatesChannel:
+			event, ok := agentUpdates.Agents["1"]
+			return ok && event.Type == store.EventTypeLabel
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	// removing the rule does not change labels
+	updates = store.NewUpdates()
+	updates.LabelRules.Include(rule, store.EventTypeRemove)
+	testManager.handleUpdates(updates)
+	agent, err := testMapstore.Agent("1")
+	require.NoError(t, err)
+	require.Equal(t, "app=web,env=dev", agent.Labels.String())
+
+	testProtocol.AssertExpectations(t)
+}
+
 func TestManagerVerifySecretKey(t *testing.T) {
 	tests := []struct {
-		name             string
-		managerSecretKey string
-		agentSecretKey   string
-		expect           bool
+		name               string
+		managerSecretKey   string
+		previousSecretKeys []string
+		agentSecretKey     string
+		expect             bool
 	}{
 		{
 			name:             "no manager key, no agent key",
@@ -273,12 +379,27 @@ func TestManagerVerifySecretKey(t *testing.T) {
 			agentSecretKey:   "something else",
 			expect:           false,
 		},
+		{
+			name:               "previous key matches agent key",
+			managerSecretKey:   "test",
+			previousSecretKeys: []string{"old"},
+			agentSecretKey:     "old",
+			expect:             true,
+		},
+		{
+			name:               "previous keys, no agent key",
+			managerSecretKey:   "test",
+			previousSecretKeys: []string{""},
+			agentSecretKey:     "",
+			expect:             false,
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			testManager := &manager{
-				secretKey: test.managerSecretKey,
+				secretKey:          test.managerSecretKey,
+				previousSecretKeys: test.previousSecretKeys,
 			}
 			require.Equal(t, test.expect, testManager.VerifySecretKey(context.TODO(), test.agentSecretKey))
 		})
@@ -71,6 +71,29 @@ func (_m *Manager) EnableProtocol(_a0 server.Protocol) {
 	_m.Called(_a0)
 }
 
+// LabelRules provides a mock function with given fields: ctx
+func (_m *Manager) LabelRules(ctx context.Context) ([]*model.LabelRule, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.LabelRule
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.LabelRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LabelRule)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResourceStore provides a mock function with given fields:
 func (_m *Manager) ResourceStore() model.ResourceStore {
 	ret := _m.Called()
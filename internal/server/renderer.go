@@ -0,0 +1,111 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/observiq/bindplane-op/model"
+)
+
+// ConfigurationRenderer renders configurations for agents. Configurations that reference the agent are rendered for
+// each agent. All other configurations are rendered once for each platform and shared by all of the agents on that
+// platform, which avoids rendering the same configuration for every agent in a large fleet. A ConfigurationRenderer
+// should only be used for a single batch of updates because it does not notice changes to the resources in the store.
+// It is safe for concurrent use.
+type ConfigurationRenderer struct {
+	store model.ResourceStore
+
+	mtx        sync.Mutex
+	references map[string]bool
+	rendered   map[renderKey]*renderedConfiguration
+}
+
+type renderKey struct {
+	configuration string
+	platform      string
+}
+
+type renderedConfiguration struct {
+	once sync.Once
+	raw  string
+	err  error
+}
+
+type configurationRendererKey struct{}
+
+// WithConfigurationRenderer returns a context with the ConfigurationRenderer shared by the agents updated together
+func WithConfigurationRenderer(ctx context.Context, renderer *ConfigurationRenderer) context.Context {
+	return context.WithValue(ctx, configurationRendererKey{}, renderer)
+}
+
+// ConfigurationRendererFromContext returns the ConfigurationRenderer added to the context with
+// WithConfigurationRenderer or a new ConfigurationRenderer using the store if there is none
+func ConfigurationRendererFromContext(ctx context.Context, store model.ResourceStore) *ConfigurationRenderer {
+	if renderer, ok := ctx.Value(configurationRendererKey{}).(*ConfigurationRenderer); ok && renderer != nil {
+		return renderer
+	}
+	return NewConfigurationRenderer(store)
+}
+
+// NewConfigurationRenderer returns a new ConfigurationRenderer that renders configurations using the store
+func NewConfigurationRenderer(store model.ResourceStore) *ConfigurationRenderer {
+	return &ConfigurationRenderer{
+		store:      store,
+		references: map[string]bool{},
+		rendered:   map[renderKey]*renderedConfiguration{},
+	}
+}
+
+// Render renders the configuration for the agent, which may be nil
+func (r *ConfigurationRenderer) Render(ctx context.Context, configuration *model.Configuration, agent *model.Agent) (string, error) {
+	if r.referencesAgent(configuration) {
+		return configuration.Render(ctx, agent, r.store)
+	}
+
+	// only the platform of the agent affects the rendered configuration
+	platform := &model.Agent{}
+	if agent != nil {
+		platform.Platform = agent.Platform
+	}
+	rendered := r.renderedConfiguration(renderKey{configuration: configuration.Name(), platform: platform.Platform})
+	rendered.once.Do(func() {
+		rendered.raw, rendered.err = configuration.Render(ctx, platform, r.store)
+	})
+	return rendered.raw, rendered.err
+}
+
+func (r *ConfigurationRenderer) referencesAgent(configuration *model.Configuration) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	references, ok := r.references[configuration.Name()]
+	if !ok {
+		references = configuration.ReferencesAgent(r.store)
+		r.references[configuration.Name()] = references
+	}
+	return references
+}
+
+func (r *ConfigurationRenderer) renderedConfiguration(key renderKey) *renderedConfiguration {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	rendered, ok := r.rendered[key]
+	if !ok {
+		rendered = &renderedConfiguration{}
+		r.rendered[key] = rendered
+	}
+	return rendered
+}
@@ -0,0 +1,106 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/observiq/bindplane-op/internal/store"
+	"github.com/observiq/bindplane-op/model"
+	"github.com/stretchr/testify/require"
+)
+
+// countingResourceStore counts the number of times source types are retrieved to determine how many times a
+// configuration is rendered
+type countingResourceStore struct {
+	model.ResourceStore
+	sourceTypes int32
+}
+
+func (s *countingResourceStore) SourceType(name string) (*model.SourceType, error) {
+	atomic.AddInt32(&s.sourceTypes, 1)
+	return s.ResourceStore.SourceType(name)
+}
+
+func TestConfigurationRenderer(t *testing.T) {
+	s := store.NewMapStore(context.Background(), store.Options{SessionsSecret: "super-secret-key"}, logger)
+	statuses, err := s.ApplyResources([]model.Resource{
+		model.NewSourceTypeWithSpec("hostmetrics", model.ResourceTypeSpec{
+			Metrics: model.ResourceTypeOutput{
+				Receivers: "- hostmetrics:\n    scrapers:\n      load:\n",
+			},
+		}),
+		model.NewDestinationTypeWithSpec("otlp", model.ResourceTypeSpec{
+			Parameters: []model.ParameterDefinition{{Name: "tenant", Type: "string", Default: "default"}},
+			Metrics: model.ResourceTypeOutput{
+				Exporters: "- otlp:\n    headers:\n      X-Tenant: {{ .tenant }}\n",
+			},
+		}),
+	})
+	require.NoError(t, err)
+	for _, status := range statuses {
+		require.Equal(t, model.StatusCreated, status.Status, status.Reason)
+	}
+
+	configuration := func(tenant string) *model.Configuration {
+		return model.NewConfigurationWithSpec("metrics", model.ConfigurationSpec{
+			Sources: []model.ResourceConfiguration{{Type: "hostmetrics"}},
+			Destinations: []model.ResourceConfiguration{
+				{Type: "otlp", Parameters: []model.Parameter{{Name: "tenant", Value: tenant}}},
+			},
+		})
+	}
+	agents := []*model.Agent{
+		{ID: "1", HostName: "web-1", Platform: "linux"},
+		{ID: "2", HostName: "web-2", Platform: "linux"},
+		{ID: "3", HostName: "web-3", Platform: "windows"},
+	}
+
+	t.Run("renders once for each platform", func(t *testing.T) {
+		resourceStore := &countingResourceStore{ResourceStore: s}
+		renderer := NewConfigurationRenderer(resourceStore)
+		for _, agent := range agents {
+			raw, err := renderer.Render(context.Background(), configuration("production"), agent)
+			require.NoError(t, err)
+			require.Contains(t, raw, "X-Tenant: production")
+		}
+		// once to check for agent references and once for each of the two platforms
+		require.Equal(t, int32(3), resourceStore.sourceTypes)
+	})
+
+	t.Run("renders for each agent that is referenced", func(t *testing.T) {
+		resourceStore := &countingResourceStore{ResourceStore: s}
+		renderer := NewConfigurationRenderer(resourceStore)
+		for _, agent := range agents {
+			raw, err := renderer.Render(context.Background(), configuration("{{ .agent.HostName }}"), agent)
+			require.NoError(t, err)
+			require.Contains(t, raw, "X-Tenant: "+agent.HostName)
+		}
+		// once to check for agent references and once for each agent
+		require.Equal(t, int32(4), resourceStore.sourceTypes)
+	})
+}
+
+func TestConfigurationRendererFromContext(t *testing.T) {
+	renderer := NewConfigurationRenderer(testMapstore)
+	ctx := WithConfigurationRenderer(context.Background(), renderer)
+	require.Same(t, renderer, ConfigurationRendererFromContext(ctx, testMapstore))
+
+	other := ConfigurationRendererFromContext(context.Background(), testMapstore)
+	require.NotNil(t, other)
+	require.NotSame(t, renderer, other)
+}
@@ -47,7 +47,10 @@ type boltstore struct {
 	configurationIndex search.Index
 	logger             *zap.Logger
 	sync.RWMutex
-	sessionStorage sessions.Store
+	sessionStorage  sessions.Store
+	leases          *localLeases
+	secretKeyring   *model.SecretKeyring
+	secretProviders model.SecretProviders
 }
 
 var _ Store = (*boltstore)(nil)
@@ -61,7 +64,10 @@ func NewBoltStore(ctx context.Context, db *bbolt.DB, options Options, logger *za
 		configurationIndex: search.NewInMemoryIndex("configuration"),
 		logger:             logger,
 
-		sessionStorage: newBPCookieStore(options.SessionsSecret),
+		sessionStorage:  newBPCookieStore(options.SessionsSecret),
+		leases:          newLocalLeases(),
+		secretKeyring:   options.SecretKeyring,
+		secretProviders: options.SecretProviders,
 	}
 
 	// boltstore is not used for clusters, disconnect all agents
@@ -143,7 +149,7 @@ func (s *boltstore) AgentConfiguration(agentID string) (*model.Configuration, er
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (s *boltstore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := s.AgentIndex().Select(configuration.Spec.Selector.MatchLabels)
+	ids := s.AgentIndex().Select(configuration.AgentSelector().Selector)
 	return ids, nil
 }
 
@@ -210,12 +216,17 @@ func (s *boltstore) ApplyResources(resources []model.Resource) ([]model.Resource
 		// the resource already exists (using the existing resource ID)
 		resource.EnsureID()
 
-		err := resource.ValidateWithStore(s)
+		err := resource.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(s, s.secretProviders), storeAgentPlatforms(s)))
 		if err != nil {
 			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
 			continue
 		}
 
+		if err := encryptSecrets(s, s.secretKeyring, resource); err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
+			continue
+		}
+
 		err = s.db.Update(func(tx *bbolt.Tx) error {
 			// update the resource in the database
 			status, err := upsertResource(tx, resource, resource.GetKind())
@@ -405,6 +416,11 @@ func (s *boltstore) Agents(ctx context.Context, options ...QueryOption) ([]*mode
 }
 
 func (s *boltstore) DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error) {
+	return s.DeleteAgentsIf(ctx, agentIDs, anyAgent)
+}
+
+// DeleteAgentsIf deletes the agents with the specified IDs for which the condition returns true
+func (s *boltstore) DeleteAgentsIf(ctx context.Context, agentIDs []string, condition AgentCondition) ([]*model.Agent, error) {
 	updates := NewUpdates()
 	deleted := make([]*model.Agent, 0, len(agentIDs))
 
@@ -423,6 +439,9 @@ func (s *boltstore) DeleteAgents(ctx context.Context, agentIDs []string) ([]*mod
 				if err != nil {
 					return err
 				}
+				if !condition(agent) {
+					continue
+				}
 
 				agent.Status = 5
 				deleted = append(deleted, agent)
@@ -527,6 +546,76 @@ func (s *boltstore) Configuration(name string) (*model.Configuration, error) {
 	}
 	return item, err
 }
+
+// UpdateConfiguration applies the configuration returned by the updater in place of the existing configuration. The
+// configuration is only stored if it has not changed since it was given to the updater, otherwise the update is
+// attempted again.
+func (s *boltstore) UpdateConfiguration(name string, updater ConfigurationUpdater) (*model.ResourceStatus, error) {
+	key := resourceKey(model.KindConfiguration, name)
+
+	for attempt := 0; attempt < maxConfigurationUpdateAttempts; attempt++ {
+		var existing []byte
+		err := s.db.View(func(tx *bbolt.Tx) error {
+			// the data is only valid during the transaction
+			existing = append([]byte(nil), resourcesBucket(tx).Get(key)...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			return nil, ErrResourceMissing
+		}
+
+		current := &model.Configuration{}
+		if err := json.Unmarshal(existing, current); err != nil {
+			return nil, err
+		}
+		updated, err := updater(current)
+		if err != nil {
+			return nil, err
+		}
+
+		err = updated.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(s, s.secretProviders), storeAgentPlatforms(s)))
+		if err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+		}
+		if err := encryptSecrets(s, s.secretKeyring, updated); err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+		}
+
+		var status model.UpdateStatus
+		changed := false
+		err = s.db.Update(func(tx *bbolt.Tx) error {
+			if !bytes.Equal(resourcesBucket(tx).Get(key), existing) {
+				// changed by another update since it was read
+				changed = true
+				return nil
+			}
+			status, err = upsertResource(tx, updated, model.KindConfiguration)
+			return err
+		})
+		if err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusError, err.Error()), err
+		}
+		if changed {
+			continue
+		}
+
+		if err := s.configurationIndex.Upsert(updated); err != nil {
+			s.logger.Error("failed to update the search index", zap.String("configuration", updated.Name()))
+		}
+		updates := NewUpdates()
+		if status == model.StatusConfigured {
+			updates.IncludeResource(updated, EventTypeUpdate)
+		}
+		s.notify(updates)
+		return model.NewResourceStatus(updated, status), nil
+	}
+
+	return nil, fmt.Errorf("unable to update configuration %s: %w", name, ErrResourceChanged)
+}
+
 func (s *boltstore) DeleteConfiguration(name string) (*model.Configuration, error) {
 	item, exists, err := deleteResourceAndNotify(s, model.KindConfiguration, name, &model.Configuration{})
 	if !exists {
@@ -643,6 +732,60 @@ func (s *boltstore) DeleteDestinationType(name string) (*model.DestinationType,
 	return item, err
 }
 
+func (s *boltstore) ConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	item, exists, err := resource[*model.ConfigurationTemplate](s, model.KindConfigurationTemplate, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) ConfigurationTemplates() ([]*model.ConfigurationTemplate, error) {
+	return resources[*model.ConfigurationTemplate](s, model.KindConfigurationTemplate)
+}
+func (s *boltstore) DeleteConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindConfigurationTemplate, name, &model.ConfigurationTemplate{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *boltstore) ConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	item, exists, err := resource[*model.ConfigurationInstance](s, model.KindConfigurationInstance, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) ConfigurationInstances() ([]*model.ConfigurationInstance, error) {
+	return resources[*model.ConfigurationInstance](s, model.KindConfigurationInstance)
+}
+func (s *boltstore) DeleteConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindConfigurationInstance, name, &model.ConfigurationInstance{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *boltstore) LabelRule(name string) (*model.LabelRule, error) {
+	item, exists, err := resource[*model.LabelRule](s, model.KindLabelRule, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *boltstore) LabelRules() ([]*model.LabelRule, error) {
+	return resources[*model.LabelRule](s, model.KindLabelRule)
+}
+func (s *boltstore) DeleteLabelRule(name string) (*model.LabelRule, error) {
+	item, exists, err := deleteResourceAndNotify(s, model.KindLabelRule, name, &model.LabelRule{})
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
 // CleanupDisconnectedAgents removes agents that have disconnected before the specified time
 func (s *boltstore) CleanupDisconnectedAgents(since time.Time) error {
 	agents, err := s.Agents(context.TODO())
@@ -672,6 +815,12 @@ func (s *boltstore) CleanupDisconnectedAgents(since time.Time) error {
 	return nil
 }
 
+// AcquireLease acquires or renews the lease with the specified name for the holder. The bbolt database can only be
+// opened by a single server so leases are held in memory.
+func (s *boltstore) AcquireLease(_ context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	return s.leases.AcquireLease(name, holder, ttl), nil
+}
+
 // Index provides access to the search Index implementation managed by the Store
 func (s *boltstore) AgentIndex() search.Index {
 	return s.agentIndex
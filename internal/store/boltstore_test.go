@@ -532,3 +532,94 @@ func storedAgentIDs(t *testing.T, store Store) []string {
 	}
 	return result
 }
+
+func TestBoltstoreAgentPlatforms(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err, "error while initializing test database", err)
+	defer cleanupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runAgentPlatformTests(t, s)
+}
+
+func TestBoltstoreSecrets(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err, "error while initializing test database", err)
+	defer cleanupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewBoltStore(ctx, db, secretOptions(t, "old-key"), zap.NewNop())
+
+	runSecretsTests(t, s)
+
+	t.Run("rotate secrets to a new key", func(t *testing.T) {
+		options := secretOptions(t, "new-key", "old-key")
+		rotated := NewBoltStore(ctx, db, options, zap.NewNop())
+
+		encrypted := storedSecret(t, rotated)
+		require.True(t, options.SecretKeyring.NeedsRotation(encrypted))
+
+		err := RotateSecrets(rotated, options.SecretKeyring, zap.NewNop())
+		require.NoError(t, err)
+
+		encrypted = storedSecret(t, rotated)
+		require.False(t, options.SecretKeyring.NeedsRotation(encrypted))
+		decrypted, err := options.SecretKeyring.Decrypt(encrypted)
+		require.NoError(t, err)
+		require.Equal(t, "correct horse", decrypted)
+	})
+}
+
+func TestBoltstoreLeases(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err, "error while initializing test database", err)
+	defer cleanupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runLeaseTests(t, s)
+}
+
+func TestBoltstoreUpdateConfiguration(t *testing.T) {
+	db, err := initTestDB(t)
+	require.NoError(t, err, "error while initializing test database", err)
+	defer cleanupTestDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewBoltStore(ctx, db, testOptions, zap.NewNop())
+	runUpdateConfigurationTests(t, s)
+
+	t.Run("retries when the configuration changes during the update", func(t *testing.T) {
+		calls := 0
+		result, err := s.UpdateConfiguration("update-configuration", func(current *model.Configuration) (*model.Configuration, error) {
+			calls++
+			if calls == 1 {
+				// another update is stored after the configuration is read
+				_, err := s.UpdateConfiguration("update-configuration", appendRaw("two: 2"))
+				require.NoError(t, err)
+			}
+			return appendRaw("three: 3")(current)
+		})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusConfigured, result.Status)
+		require.Equal(t, 2, calls)
+
+		config, err := s.Configuration("update-configuration")
+		require.NoError(t, err)
+		require.Equal(t, "raw:\none: 1\ntwo: 2\nthree: 3", config.Spec.Raw)
+	})
+
+	t.Run("gives up when the configuration keeps changing", func(t *testing.T) {
+		calls := 0
+		_, err := s.UpdateConfiguration("update-configuration", func(current *model.Configuration) (*model.Configuration, error) {
+			calls++
+			result, err := s.UpdateConfiguration("update-configuration", appendRaw(fmt.Sprintf("more%d: true", calls)))
+			require.NoError(t, err)
+			require.Equal(t, model.StatusConfigured, result.Status)
+			return current, nil
+		})
+		require.ErrorIs(t, err, ErrResourceChanged)
+		require.Equal(t, maxConfigurationUpdateAttempts, calls)
+	})
+}
@@ -15,6 +15,7 @@
 package store
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -48,7 +49,9 @@ type googleCloudStore struct {
 	configurationIndex search.Index
 	logger             *zap.Logger
 
-	sessionStore sessions.Store
+	sessionStore    sessions.Store
+	secretKeyring   *model.SecretKeyring
+	secretProviders model.SecretProviders
 }
 
 var _ Store = (*googleCloudStore)(nil)
@@ -65,6 +68,11 @@ func NewGoogleCloudStore(ctx context.Context, cfg *common.Server, logger *zap.Lo
 		return nil, err
 	}
 
+	secretKeyring, err := NewSecretKeyring(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &googleCloudStore{
 		client:             datastoreClient,
 		pubsub:             pubsubClient,
@@ -73,7 +81,9 @@ func NewGoogleCloudStore(ctx context.Context, cfg *common.Server, logger *zap.Lo
 		configurationIndex: search.NewInMemoryIndex("configuration"),
 		logger:             logger,
 
-		sessionStore: newBPCookieStore(cfg.SessionsSecret),
+		sessionStore:    newBPCookieStore(cfg.SessionsSecret),
+		secretKeyring:   secretKeyring,
+		secretProviders: NewSecretProviders(cfg),
 	}
 
 	// start listening for events
@@ -237,6 +247,11 @@ func (s *googleCloudStore) DeleteAgents(ctx context.Context, agentIDs []string)
 	return deleteDatastoreAgents(ctx, s, agentIDs)
 }
 
+// DeleteAgentsIf deletes the agents with the specified IDs for which the condition returns true
+func (s *googleCloudStore) DeleteAgentsIf(ctx context.Context, agentIDs []string, condition AgentCondition) ([]*model.Agent, error) {
+	return deleteDatastoreAgentsIf(ctx, s, agentIDs, condition)
+}
+
 func (s *googleCloudStore) Configurations(options ...QueryOption) ([]*model.Configuration, error) {
 	opts := makeQueryOptions(options)
 	return getDatastoreResourcesWithQuery[*model.Configuration](context.TODO(), s, s.configurationIndex, model.KindConfiguration, &opts)
@@ -365,6 +380,133 @@ func (s *googleCloudStore) DeleteDestinationType(name string) (*model.Destinatio
 	return item, err
 }
 
+func (s *googleCloudStore) ConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	item, exists, err := getDatastoreResource[*model.ConfigurationTemplate](s, model.KindConfigurationTemplate, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) ConfigurationTemplates() ([]*model.ConfigurationTemplate, error) {
+	return getDatastoreResources[*model.ConfigurationTemplate](s, model.KindConfigurationTemplate, nil)
+}
+func (s *googleCloudStore) DeleteConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.ConfigurationTemplate](s, model.KindConfigurationTemplate, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *googleCloudStore) ConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	item, exists, err := getDatastoreResource[*model.ConfigurationInstance](s, model.KindConfigurationInstance, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) ConfigurationInstances() ([]*model.ConfigurationInstance, error) {
+	return getDatastoreResources[*model.ConfigurationInstance](s, model.KindConfigurationInstance, nil)
+}
+func (s *googleCloudStore) DeleteConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.ConfigurationInstance](s, model.KindConfigurationInstance, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+func (s *googleCloudStore) LabelRule(name string) (*model.LabelRule, error) {
+	item, exists, err := getDatastoreResource[*model.LabelRule](s, model.KindLabelRule, name)
+	if !exists {
+		item = nil
+	}
+	return item, err
+}
+func (s *googleCloudStore) LabelRules() ([]*model.LabelRule, error) {
+	return getDatastoreResources[*model.LabelRule](s, model.KindLabelRule, nil)
+}
+func (s *googleCloudStore) DeleteLabelRule(name string) (*model.LabelRule, error) {
+	item, exists, err := deleteDatastoreResourceAndNotify[*model.LabelRule](s, model.KindLabelRule, name)
+	if !exists {
+		return nil, err
+	}
+	return item, err
+}
+
+// UpdateConfiguration applies the configuration returned by the updater in place of the existing configuration. The
+// configuration is stored in a transaction that fails if the configuration has changed since it was given to the
+// updater, in which case the update is attempted again.
+func (s *googleCloudStore) UpdateConfiguration(name string, updater ConfigurationUpdater) (*model.ResourceStatus, error) {
+	ctx := context.TODO()
+	key := datastoreKey(model.KindConfiguration, name)
+
+	for attempt := 0; attempt < maxConfigurationUpdateAttempts; attempt++ {
+		var existing datastoreResource
+		if err := s.client.Get(ctx, key, &existing); err != nil {
+			if errors.Is(err, datastore.ErrNoSuchEntity) {
+				return nil, ErrResourceMissing
+			}
+			return nil, fmt.Errorf("failed to get the resource: %w", err)
+		}
+
+		current := &model.Configuration{}
+		if err := decodeDatastoreResource(&existing, current); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the resource: %w", err)
+		}
+		updated, err := updater(current)
+		if err != nil {
+			return nil, err
+		}
+
+		err = updated.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(s, s.secretProviders), storeAgentPlatforms(s)))
+		if err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+		}
+		if err := encryptSecrets(s, s.secretKeyring, updated); err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+		}
+
+		updated.SetID(current.ID())
+		dsr, err := newDatastoreResource(updated)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal the resource: %w", err)
+		}
+		if bytes.Equal(dsr.Body, existing.Body) {
+			return model.NewResourceStatus(updated, model.StatusUnchanged), nil
+		}
+
+		changed := false
+		_, err = s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+			changed = false
+			var latest datastoreResource
+			if err := tx.Get(key, &latest); err != nil && !errors.Is(err, datastore.ErrNoSuchEntity) {
+				return err
+			}
+			if !bytes.Equal(latest.Body, existing.Body) {
+				// changed by another update since it was read
+				changed = true
+				return nil
+			}
+			_, err := tx.Put(key, dsr)
+			return err
+		})
+		if err != nil {
+			return model.NewResourceStatusWithReason(updated, model.StatusError, err.Error()), fmt.Errorf("failed to put the resource: %w", err)
+		}
+		if changed {
+			continue
+		}
+
+		updates := NewUpdates()
+		updates.IncludeResource(updated, EventTypeUpdate)
+		s.notify(updates)
+		return model.NewResourceStatus(updated, model.StatusConfigured), nil
+	}
+
+	return nil, fmt.Errorf("unable to update configuration %s: %w", name, ErrResourceChanged)
+}
+
 // ----------------------------------------------------------------------
 
 func (s *googleCloudStore) ApplyResources(resources []model.Resource) ([]model.ResourceStatus, error) {
@@ -379,12 +521,17 @@ func (s *googleCloudStore) ApplyResources(resources []model.Resource) ([]model.R
 		// the resource already exists (using the existing resource ID)
 		resource.EnsureID()
 
-		err := resource.ValidateWithStore(s)
+		err := resource.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(s, s.secretProviders), storeAgentPlatforms(s)))
 		if err != nil {
 			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
 			continue
 		}
 
+		if err := encryptSecrets(s, s.secretKeyring, resource); err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
+			continue
+		}
+
 		status, err := upsertAnyDatastoreResource(s, resource)
 		if err != nil {
 			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusError, err.Error()))
@@ -480,7 +627,7 @@ func (s *googleCloudStore) AgentConfiguration(agentID string) (*model.Configurat
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (s *googleCloudStore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := s.AgentIndex().Select(configuration.Spec.Selector.MatchLabels)
+	ids := s.AgentIndex().Select(configuration.AgentSelector().Selector)
 	return ids, nil
 }
 
@@ -490,6 +637,33 @@ func (s *googleCloudStore) CleanupDisconnectedAgents(since time.Time) error {
 	return nil
 }
 
+// AcquireLease acquires or renews the lease with the specified name for the holder. Leases are stored in the datastore
+// and updated in a transaction so that only one of the servers sharing the datastore holds the lease.
+func (s *googleCloudStore) AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	key := datastore.NameKey(datastoreLeaseKind, name, nil)
+	var acquired bool
+	_, err := s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		acquired = false
+		var current lease
+		if err := tx.Get(key, &current); err != nil && !errors.Is(err, datastore.ErrNoSuchEntity) {
+			return err
+		}
+		now := time.Now()
+		if !current.acquire(holder, now) {
+			return nil
+		}
+		if _, err := tx.Put(key, &lease{Holder: holder, Expires: now.Add(ttl)}); err != nil {
+			return err
+		}
+		acquired = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("unable to acquire lease %s: %w", name, err)
+	}
+	return acquired, nil
+}
+
 // Updates will receive pipelines and configurations that have been updated or deleted, either because the
 // configuration changed or a component in them was updated. Agents with labels that change are also sent with
 // Updates.
@@ -590,6 +764,9 @@ func updateIndex[T indexed](s *googleCloudStore, index search.Index, event Event
 // ----------------------------------------------------------------------
 // datastore interaction
 
+// datastoreLeaseKind is the datastore kind of leases, which are not resources
+const datastoreLeaseKind = "Lease"
+
 func datastoreKey(kind model.Kind, uniqueKey string) *datastore.Key {
 	return datastore.NameKey(string(kind), uniqueKey, nil)
 }
@@ -597,11 +774,14 @@ func datastoreKey(kind model.Kind, uniqueKey string) *datastore.Key {
 func datastoreQuery(kind model.Kind, opts *queryOptions) *datastore.Query {
 	query := datastore.NewQuery(string(kind))
 	if opts != nil {
-		if opts.offset > 0 {
-			query = query.Offset(opts.offset)
-		}
-		if opts.limit > 0 {
-			query = query.Limit(opts.limit)
+		// offset and limit can only be applied by the datastore if it can also apply the entire selector
+		if datastoreSelectorComplete(opts) {
+			if opts.offset > 0 {
+				query = query.Offset(opts.offset)
+			}
+			if opts.limit > 0 {
+				query = query.Limit(opts.limit)
+			}
 		}
 		if opts.sort != "" && kind == model.KindAgent {
 			// sort currently only supported for agents for id, name, or status and id is key order
@@ -614,7 +794,8 @@ func datastoreQuery(kind model.Kind, opts *queryOptions) *datastore.Query {
 		}
 
 		if !opts.selector.Empty() {
-			// translate requirements to a query
+			// translate requirements to a query. requirements that cannot be expressed as a query, e.g. matchExpressions,
+			// are applied to the results by getDatastoreResources.
 			labels, _ := opts.selector.MatchLabels()
 			for k, v := range labels {
 				query = query.Filter("labels=", fmt.Sprintf("%s=%s", k, v))
@@ -625,6 +806,22 @@ func datastoreQuery(kind model.Kind, opts *queryOptions) *datastore.Query {
 	return query
 }
 
+// datastoreSelectorComplete returns true if the selector in the query options can be entirely expressed as a datastore
+// query
+func datastoreSelectorComplete(opts *queryOptions) bool {
+	if opts == nil || opts.selector.Empty() {
+		return true
+	}
+	_, complete := opts.selector.MatchLabels()
+	return complete
+}
+
+// datastoreSelectorMatches returns true if the resource has labels that match the selector
+func datastoreSelectorMatches(selector model.Selector, resource any) bool {
+	labeled, ok := resource.(model.Labeled)
+	return ok && selector.Matches(labeled.GetLabels())
+}
+
 // datastoreResource is the value stored in the datastore. It is common to all datastore types.
 type datastoreResource struct {
 	Key    *datastore.Key `datastore:"__key__"`
@@ -695,6 +892,12 @@ func upsertAnyDatastoreResource(s *googleCloudStore, r model.Resource) (model.Up
 		return upsertDatastoreResource(s, r.(*model.Destination))
 	case model.KindDestinationType:
 		return upsertDatastoreResource(s, r.(*model.DestinationType))
+	case model.KindConfigurationTemplate:
+		return upsertDatastoreResource(s, r.(*model.ConfigurationTemplate))
+	case model.KindConfigurationInstance:
+		return upsertDatastoreResource(s, r.(*model.ConfigurationInstance))
+	case model.KindLabelRule:
+		return upsertDatastoreResource(s, r.(*model.LabelRule))
 	default:
 		return model.StatusError, fmt.Errorf("unable to use ApplyResource with %s", string(r.GetKind()))
 	}
@@ -786,6 +989,12 @@ func deleteAnyDatastoreResource(s *googleCloudStore, r model.Resource) (model.Re
 		return deleteDatastoreResource[*model.Destination](s, r.GetKind(), r.Name())
 	case model.KindDestinationType:
 		return deleteDatastoreResource[*model.DestinationType](s, r.GetKind(), r.Name())
+	case model.KindConfigurationTemplate:
+		return deleteDatastoreResource[*model.ConfigurationTemplate](s, r.GetKind(), r.Name())
+	case model.KindConfigurationInstance:
+		return deleteDatastoreResource[*model.ConfigurationInstance](s, r.GetKind(), r.Name())
+	case model.KindLabelRule:
+		return deleteDatastoreResource[*model.LabelRule](s, r.GetKind(), r.Name())
 	default:
 		return nil, false, fmt.Errorf("unable to use DeleteResources with %s", string(r.GetKind()))
 	}
@@ -822,6 +1031,8 @@ func getDatastoreResources[R any](s *googleCloudStore, kind model.Kind, opts *qu
 		return nil, err
 	}
 
+	complete := datastoreSelectorComplete(opts)
+
 	results := make([]R, 0, len(list))
 	for _, dsr := range list {
 		dsr := dsr // copy to local variable to securely pass a reference to a loop variable
@@ -830,9 +1041,19 @@ func getDatastoreResources[R any](s *googleCloudStore, kind model.Kind, opts *qu
 			s.logger.Error("unable to decode datastore resource", zap.String("name", dsr.Name), zap.String("kind", string(kind)))
 			continue
 		}
+		if !complete && !datastoreSelectorMatches(opts.selector, result) {
+			continue
+		}
 		results = append(results, result)
 	}
 
+	if !complete {
+		// the datastore already sorted the results, but offset and limit must be applied after filtering
+		pageOpts := *opts
+		pageOpts.sort = ""
+		results = applySortOffsetAndLimit(results, pageOpts, nil)
+	}
+
 	return results, nil
 }
 
@@ -914,6 +1135,49 @@ func deleteDatastoreAgents(ctx context.Context, s *googleCloudStore, ids []strin
 	return agents, nil
 }
 
+// deleteDatastoreAgentsIf deletes the agents for which the condition returns true in a transaction so that agents
+// updated after they are read are not deleted
+func deleteDatastoreAgentsIf(ctx context.Context, s *googleCloudStore, ids []string, condition AgentCondition) ([]*model.Agent, error) {
+	ctx, span := tracer.Start(ctx, "store/deleteDatastoreAgentsIf")
+	defer span.End()
+
+	var agents []*model.Agent
+	_, err := s.client.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		agents = nil
+		keys := []*datastore.Key{}
+		for _, id := range ids {
+			key := datastoreKey(model.KindAgent, id)
+			var dsr datastoreResource
+			if err := tx.Get(key, &dsr); err != nil {
+				if errors.Is(err, datastore.ErrNoSuchEntity) {
+					continue
+				}
+				return err
+			}
+			agent := &model.Agent{}
+			if err := decodeDatastoreResource(&dsr, agent); err != nil {
+				return err
+			}
+			if condition(agent) {
+				agents = append(agents, agent)
+				keys = append(keys, key)
+			}
+		}
+		return tx.DeleteMulti(keys)
+	})
+	if err != nil {
+		span.SetAttributes(attribute.String("error", err.Error()))
+		return nil, err
+	}
+
+	// set deleted status on the agents that have been deleted
+	for _, agent := range agents {
+		agent.Status = model.Deleted
+	}
+
+	return agents, nil
+}
+
 // ----------------------------------------------------------------------
 // google cloud client creation
 
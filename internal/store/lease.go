@@ -0,0 +1,56 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"sync"
+	"time"
+)
+
+// lease is held by a single server until it expires
+type lease struct {
+	Holder  string    `datastore:"holder"`
+	Expires time.Time `datastore:"expires"`
+}
+
+// acquire returns true if the lease can be acquired or renewed by the holder at the specified time
+func (l *lease) acquire(holder string, now time.Time) bool {
+	return l.Holder == "" || l.Holder == holder || !now.Before(l.Expires)
+}
+
+// localLeases are leases held in memory. They are used by stores that cannot be shared by multiple servers, where
+// the single server will always acquire the lease.
+type localLeases struct {
+	leases map[string]lease
+	mtx    sync.Mutex
+}
+
+func newLocalLeases() *localLeases {
+	return &localLeases{leases: map[string]lease{}}
+}
+
+// AcquireLease acquires or renews the lease with the specified name for the holder
+func (l *localLeases) AcquireLease(name string, holder string, ttl time.Duration) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now()
+	current := l.leases[name]
+	if !current.acquire(holder, now) {
+		return false
+	}
+	l.leases[name] = lease{Holder: holder, Expires: now.Add(ttl)}
+	return true
+}
@@ -43,13 +43,20 @@ type mapStore struct {
 	destinations     resourceStore[*model.Destination]
 	destinationTypes resourceStore[*model.DestinationType]
 
+	configurationTemplates resourceStore[*model.ConfigurationTemplate]
+	configurationInstances resourceStore[*model.ConfigurationInstance]
+	labelRules             resourceStore[*model.LabelRule]
+
 	updates            *storeUpdates
 	agentIndex         search.Index
 	configurationIndex search.Index
 	logger             *zap.Logger
 	sync.RWMutex
 
-	sessionStore sessions.Store
+	sessionStore    sessions.Store
+	leases          *localLeases
+	secretKeyring   *model.SecretKeyring
+	secretProviders model.SecretProviders
 }
 
 var _ Store = (*mapStore)(nil)
@@ -57,19 +64,27 @@ var _ Store = (*mapStore)(nil)
 // NewMapStore returns an in memory Store
 func NewMapStore(ctx context.Context, options Options, logger *zap.Logger) Store {
 	return &mapStore{
-		agents:             make(map[string]*model.Agent),
-		configurations:     newResourceStore[*model.Configuration](),
-		sources:            newResourceStore[*model.Source](),
-		sourceTypes:        newResourceStore[*model.SourceType](),
-		processors:         newResourceStore[*model.Processor](),
-		processorTypes:     newResourceStore[*model.ProcessorType](),
-		destinations:       newResourceStore[*model.Destination](),
-		destinationTypes:   newResourceStore[*model.DestinationType](),
+		agents:           make(map[string]*model.Agent),
+		configurations:   newResourceStore[*model.Configuration](),
+		sources:          newResourceStore[*model.Source](),
+		sourceTypes:      newResourceStore[*model.SourceType](),
+		processors:       newResourceStore[*model.Processor](),
+		processorTypes:   newResourceStore[*model.ProcessorType](),
+		destinations:     newResourceStore[*model.Destination](),
+		destinationTypes: newResourceStore[*model.DestinationType](),
+
+		configurationTemplates: newResourceStore[*model.ConfigurationTemplate](),
+		configurationInstances: newResourceStore[*model.ConfigurationInstance](),
+		labelRules:             newResourceStore[*model.LabelRule](),
+
 		updates:            newStoreUpdates(ctx, options.MaxEventsToMerge),
 		agentIndex:         search.NewInMemoryIndex("agent"),
 		configurationIndex: search.NewInMemoryIndex("configuration"),
 		logger:             logger,
 		sessionStore:       newBPCookieStore(options.SessionsSecret),
+		leases:             newLocalLeases(),
+		secretKeyring:      options.SecretKeyring,
+		secretProviders:    options.SecretProviders,
 	}
 }
 
@@ -184,6 +199,9 @@ func (mapstore *mapStore) Clear() {
 	mapstore.sourceTypes.clear()
 	mapstore.destinations.clear()
 	mapstore.destinationTypes.clear()
+	mapstore.configurationTemplates.clear()
+	mapstore.configurationInstances.clear()
+	mapstore.labelRules.clear()
 }
 
 func (mapstore *mapStore) UpsertAgents(ctx context.Context, agentIDs []string, updater AgentUpdater) ([]*model.Agent, error) {
@@ -222,9 +240,14 @@ func (mapstore *mapStore) Agents(ctx context.Context, options ...QueryOption) ([
 	result := make([]*model.Agent, 0, len(mapstore.agents))
 
 	for _, value := range mapstore.agents {
-		if opts.selector.Matches(value.Labels) {
-			result = append(result, value)
+		if !opts.selector.Matches(value.Labels) {
+			continue
+		}
+		// search is implemented using the search index
+		if opts.query != nil && !mapstore.agentIndex.Matches(opts.query, value.ID) {
+			continue
 		}
+		result = append(result, value)
 	}
 
 	if opts.sort == "" {
@@ -240,6 +263,18 @@ func (mapstore *mapStore) Agents(ctx context.Context, options ...QueryOption) ([
 	}), nil
 }
 
+// agentPlatforms returns the platforms of the agents matching the selector. It is used to validate configurations in
+// ApplyResources and expects the lock to be held by the caller.
+func (mapstore *mapStore) agentPlatforms(selector model.Selector) ([]string, error) {
+	agents := []*model.Agent{}
+	for _, agent := range mapstore.agents {
+		if selector.Matches(agent.Labels) {
+			agents = append(agents, agent)
+		}
+	}
+	return agentPlatforms(agents), nil
+}
+
 func (mapstore *mapStore) AgentsCount(ctx context.Context, options ...QueryOption) (int, error) {
 	agents, err := mapstore.Agents(ctx, options...)
 	if err != nil {
@@ -255,6 +290,11 @@ func (mapstore *mapStore) Agent(id string) (*model.Agent, error) {
 }
 
 func (mapstore *mapStore) DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error) {
+	return mapstore.DeleteAgentsIf(ctx, agentIDs, anyAgent)
+}
+
+// DeleteAgentsIf deletes the agents with the specified IDs for which the condition returns true
+func (mapstore *mapStore) DeleteAgentsIf(ctx context.Context, agentIDs []string, condition AgentCondition) ([]*model.Agent, error) {
 	deleted := make([]*model.Agent, 0, len(agentIDs))
 	updates := NewUpdates()
 
@@ -262,7 +302,7 @@ func (mapstore *mapStore) DeleteAgents(ctx context.Context, agentIDs []string) (
 	defer mapstore.Unlock()
 
 	for _, id := range agentIDs {
-		if agent, ok := mapstore.agents[id]; ok {
+		if agent, ok := mapstore.agents[id]; ok && condition(agent) {
 			// set status deleted
 			agent.Status = 5
 
@@ -313,6 +353,42 @@ func (mapstore *mapStore) DeleteConfiguration(name string) (*model.Configuration
 	return item, nil
 }
 
+// UpdateConfiguration applies the configuration returned by the updater in place of the existing configuration. Other
+// updates are blocked until it is stored.
+func (mapstore *mapStore) UpdateConfiguration(name string, updater ConfigurationUpdater) (*model.ResourceStatus, error) {
+	mapstore.Lock()
+	defer mapstore.Unlock()
+
+	current := mapstore.configurations.get(name)
+	if current == nil {
+		return nil, ErrResourceMissing
+	}
+	updated, err := updater(current)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updated.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(mapstore, mapstore.secretProviders), mapstore.agentPlatforms))
+	if err != nil {
+		return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+	}
+	if err := encryptSecrets(mapstore, mapstore.secretKeyring, updated); err != nil {
+		return model.NewResourceStatusWithReason(updated, model.StatusInvalid, err.Error()), nil
+	}
+
+	resourceStatus := mapstore.configurations.add(updated)
+	if err := mapstore.configurationIndex.Upsert(updated); err != nil {
+		mapstore.logger.Error("error updating configuration in the search index", zap.Error(err))
+	}
+
+	updates := NewUpdates()
+	if resourceStatus.Status == model.StatusConfigured {
+		updates.IncludeResource(updated, EventTypeUpdate)
+	}
+	mapstore.notify(updates)
+	return resourceStatus, nil
+}
+
 func (mapstore *mapStore) Source(name string) (*model.Source, error) {
 	return mapstore.sources.get(name), nil
 }
@@ -421,6 +497,60 @@ func (mapstore *mapStore) DeleteDestinationType(name string) (*model.Destination
 	return item, nil
 }
 
+func (mapstore *mapStore) ConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	return mapstore.configurationTemplates.get(name), nil
+}
+func (mapstore *mapStore) ConfigurationTemplates() ([]*model.ConfigurationTemplate, error) {
+	return mapstore.configurationTemplates.list(), nil
+}
+func (mapstore *mapStore) DeleteConfigurationTemplate(name string) (*model.ConfigurationTemplate, error) {
+	item, exists, err := mapstore.configurationTemplates.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (mapstore *mapStore) ConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	return mapstore.configurationInstances.get(name), nil
+}
+func (mapstore *mapStore) ConfigurationInstances() ([]*model.ConfigurationInstance, error) {
+	return mapstore.configurationInstances.list(), nil
+}
+func (mapstore *mapStore) DeleteConfigurationInstance(name string) (*model.ConfigurationInstance, error) {
+	item, exists, err := mapstore.configurationInstances.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (mapstore *mapStore) LabelRule(name string) (*model.LabelRule, error) {
+	return mapstore.labelRules.get(name), nil
+}
+func (mapstore *mapStore) LabelRules() ([]*model.LabelRule, error) {
+	return mapstore.labelRules.list(), nil
+}
+func (mapstore *mapStore) DeleteLabelRule(name string) (*model.LabelRule, error) {
+	item, exists, err := mapstore.labelRules.removeAndNotify(name, mapstore)
+	if err != nil {
+		return item, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+	return item, nil
+}
+
 func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.ResourceStatus, error) {
 	mapstore.Lock()
 	defer mapstore.Unlock()
@@ -430,12 +560,17 @@ func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.Re
 	resourceStatuses := make([]model.ResourceStatus, 0)
 
 	for _, resource := range resources {
-		err := resource.ValidateWithStore(mapstore)
+		err := resource.ValidateWithStore(model.WithAgentPlatforms(model.WithSecretProviders(mapstore, mapstore.secretProviders), mapstore.agentPlatforms))
 		if err != nil {
 			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
 			continue
 		}
 
+		if err := encryptSecrets(mapstore, mapstore.secretKeyring, resource); err != nil {
+			resourceStatuses = append(resourceStatuses, *model.NewResourceStatusWithReason(resource, model.StatusInvalid, err.Error()))
+			continue
+		}
+
 		var resourceStatus *model.ResourceStatus
 		switch r := resource.(type) {
 		case *model.Configuration:
@@ -455,6 +590,12 @@ func (mapstore *mapStore) ApplyResources(resources []model.Resource) ([]model.Re
 			resourceStatus = mapstore.destinations.add(r)
 		case *model.DestinationType:
 			resourceStatus = mapstore.destinationTypes.add(r)
+		case *model.ConfigurationTemplate:
+			resourceStatus = mapstore.configurationTemplates.add(r)
+		case *model.ConfigurationInstance:
+			resourceStatus = mapstore.configurationInstances.add(r)
+		case *model.LabelRule:
+			resourceStatus = mapstore.labelRules.add(r)
 		default:
 			resourceStatus = model.NewResourceStatusWithReason(resource, model.StatusInvalid, fmt.Sprintf("unknown resource type in apply: %s", r.Name()))
 		}
@@ -529,6 +670,15 @@ func (mapstore *mapStore) DeleteResources(resources []model.Resource) ([]model.R
 		case *model.DestinationType:
 			_, exists = mapstore.destinationTypes.remove(r.Name())
 
+		case *model.ConfigurationTemplate:
+			_, exists = mapstore.configurationTemplates.remove(r.Name())
+
+		case *model.ConfigurationInstance:
+			_, exists = mapstore.configurationInstances.remove(r.Name())
+
+		case *model.LabelRule:
+			_, exists = mapstore.labelRules.remove(r.Name())
+
 		default:
 			continue
 		}
@@ -567,7 +717,7 @@ func (mapstore *mapStore) AgentConfiguration(agentID string) (*model.Configurati
 
 // AgentsIDsMatchingConfiguration returns the list of agent IDs that are using the specified configuration
 func (mapstore *mapStore) AgentsIDsMatchingConfiguration(configuration *model.Configuration) ([]string, error) {
-	ids := mapstore.agentIndex.Select(configuration.Spec.Selector.MatchLabels)
+	ids := mapstore.agentIndex.Select(configuration.AgentSelector().Selector)
 	return ids, nil
 }
 
@@ -594,6 +744,12 @@ func (mapstore *mapStore) CleanupDisconnectedAgents(since time.Time) error {
 	return nil
 }
 
+// AcquireLease acquires or renews the lease with the specified name for the holder. The mapstore cannot be shared by
+// multiple servers so leases are held in memory.
+func (mapstore *mapStore) AcquireLease(_ context.Context, name string, holder string, ttl time.Duration) (bool, error) {
+	return mapstore.leases.AcquireLease(name, holder, ttl), nil
+}
+
 // Index provides access to the search Index implementation managed by the Store
 func (mapstore *mapStore) AgentIndex() search.Index {
 	return mapstore.agentIndex
@@ -172,3 +172,31 @@ func TestMapstoreUpsertAgents(t *testing.T) {
 	store := NewMapStore(ctx, testOptions, zap.NewNop())
 	runTestUpsertAgents(t, store)
 }
+
+func TestMapstoreAgentPlatforms(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runAgentPlatformTests(t, store)
+}
+
+func TestMapstoreSecrets(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, secretOptions(t, "secret-key"), zap.NewNop())
+	runSecretsTests(t, store)
+}
+
+func TestMapstoreLeases(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runLeaseTests(t, store)
+}
+
+func TestMapstoreUpdateConfiguration(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewMapStore(ctx, testOptions, zap.NewNop())
+	runUpdateConfigurationTests(t, store)
+}
@@ -22,6 +22,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var tracer = otel.Tracer("search")
@@ -60,8 +61,8 @@ type Index interface {
 	// there are no suggestions that match or there is one suggestion that is an exact match, no suggestions are returned.
 	Suggestions(query *Query) ([]*Suggestion, error)
 
-	// Select returns the matching ids
-	Select(labels map[string]string) []string
+	// Select returns the ids of the resources with labels matching the selector
+	Select(selector labels.Selector) []string
 }
 
 type index struct {
@@ -189,27 +190,20 @@ func (i *index) Suggestions(query *Query) ([]*Suggestion, error) {
 	return suggestions, nil
 }
 
-// Select returns the matching ids
-func (i *index) Select(selector map[string]string) []string {
+// Select returns the ids of the resources with labels matching the selector
+func (i *index) Select(selector labels.Selector) []string {
+	i.mtx.RLock()
+	defer i.mtx.RUnlock()
+
 	results := []string{}
 	for _, doc := range i.documents {
-		if i.selectorMatchesDocument(selector, doc) {
+		if selector.Matches(labels.Set(doc.labels)) {
 			results = append(results, doc.id)
 		}
 	}
 	return results
 }
 
-// selectorMatchesDocument returns true if all of the labels in the selector match the document
-func (i *index) selectorMatchesDocument(selector map[string]string, doc *document) bool {
-	for k, v := range selector {
-		if doc.labels[k] != v {
-			return false
-		}
-	}
-	return true
-}
-
 // tokenMatches returns the ids matching the specified token. If ids is specified, we only look at those ids.
 func (i *index) tokenMatches(token *QueryToken, ids []string) []string {
 	if token.Empty() {
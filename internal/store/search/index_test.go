@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 func testIndex() *index {
@@ -582,7 +583,48 @@ func TestIndexSelect(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			results := index.Select(test.selector)
+			results := index.Select(labels.SelectorFromSet(test.selector))
+			require.ElementsMatch(t, test.expect, results)
+		})
+	}
+
+	expressionTests := []struct {
+		name     string
+		selector string
+		expect   []string
+	}{
+		{
+			name:     "app in (bindplane,cabin) matches 1,2,3,4",
+			selector: "app in (bindplane,cabin)",
+			expect:   []string{"1", "2", "3", "4"},
+		},
+		{
+			name:     "env notin (production) matches 2,4,5",
+			selector: "env notin (production)",
+			expect:   []string{"2", "4", "5"},
+		},
+		{
+			name:     "apple exists matches 4",
+			selector: "apple",
+			expect:   []string{"4"},
+		},
+		{
+			name:     "app does not exist matches 5",
+			selector: "!app",
+			expect:   []string{"5"},
+		},
+		{
+			name:     "app=cabin,apple does not exist matches 3",
+			selector: "app=cabin,!apple",
+			expect:   []string{"3"},
+		},
+	}
+
+	for _, test := range expressionTests {
+		t.Run(test.name, func(t *testing.T) {
+			selector, err := labels.Parse(test.selector)
+			require.NoError(t, err)
+			results := index.Select(selector)
 			require.ElementsMatch(t, test.expect, results)
 		})
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/gorilla/sessions"
 	"github.com/hashicorp/go-multierror"
+	"github.com/observiq/bindplane-op/common"
 	"github.com/observiq/bindplane-op/internal/eventbus"
 	"github.com/observiq/bindplane-op/internal/store/search"
 	"github.com/observiq/bindplane-op/model"
@@ -39,6 +40,12 @@ type Options struct {
 	// MaxEventsToMerge is the maximum number of update events (inserts, updates, deletes, etc) to merge into a single
 	// event.
 	MaxEventsToMerge int
+	// SecretKeyring is used to encrypt the values of secret parameters before they are stored. If it is nil, secrets
+	// are stored as they are applied.
+	SecretKeyring *model.SecretKeyring
+	// SecretProviders are used to validate secret references like ${bindplane:env:NAME} in secret parameters of
+	// resources. If nil, secret references are not validated.
+	SecretProviders model.SecretProviders
 }
 
 // Store handles interacting with a storage backend,
@@ -52,10 +59,19 @@ type Store interface {
 	UpsertAgent(ctx context.Context, agentID string, updater AgentUpdater) (*model.Agent, error)
 	UpsertAgents(ctx context.Context, agentIDs []string, updater AgentUpdater) ([]*model.Agent, error)
 	DeleteAgents(ctx context.Context, agentIDs []string) ([]*model.Agent, error)
+	// DeleteAgentsIf deletes the agents with the specified IDs for which the condition returns true. The condition is
+	// checked in the same transaction as the delete so that agents updated concurrently are not deleted based on their
+	// previous state.
+	DeleteAgentsIf(ctx context.Context, agentIDs []string, condition AgentCondition) ([]*model.Agent, error)
 
 	Configurations(options ...QueryOption) ([]*model.Configuration, error)
 	Configuration(string) (*model.Configuration, error)
 	DeleteConfiguration(string) (*model.Configuration, error)
+	// UpdateConfiguration applies the configuration returned by the updater in place of the existing configuration with
+	// the specified name. If the configuration is changed by another update before the result is stored, the updater is
+	// called again with the new configuration so that no changes are lost. ErrResourceMissing is returned if the
+	// configuration does not exist.
+	UpdateConfiguration(name string, updater ConfigurationUpdater) (*model.ResourceStatus, error)
 
 	Source(name string) (*model.Source, error)
 	Sources() ([]*model.Source, error)
@@ -81,6 +97,18 @@ type Store interface {
 	DestinationTypes() ([]*model.DestinationType, error)
 	DeleteDestinationType(name string) (*model.DestinationType, error)
 
+	ConfigurationTemplate(name string) (*model.ConfigurationTemplate, error)
+	ConfigurationTemplates() ([]*model.ConfigurationTemplate, error)
+	DeleteConfigurationTemplate(name string) (*model.ConfigurationTemplate, error)
+
+	ConfigurationInstance(name string) (*model.ConfigurationInstance, error)
+	ConfigurationInstances() ([]*model.ConfigurationInstance, error)
+	DeleteConfigurationInstance(name string) (*model.ConfigurationInstance, error)
+
+	LabelRule(name string) (*model.LabelRule, error)
+	LabelRules() ([]*model.LabelRule, error)
+	DeleteLabelRule(name string) (*model.LabelRule, error)
+
 	ApplyResources([]model.Resource) ([]model.ResourceStatus, error)
 	// Batch delete of a slice of resources, returns the successfully deleted resources or an error.
 	DeleteResources([]model.Resource) ([]model.ResourceStatus, error)
@@ -94,6 +122,11 @@ type Store interface {
 	// CleanupDisconnectedAgents removes agents that have disconnected before the specified time
 	CleanupDisconnectedAgents(since time.Time) error
 
+	// AcquireLease acquires or renews the lease with the specified name for the holder and returns true if the holder
+	// has the lease. The lease expires after the ttl unless it is renewed. Leases elect a single server to run
+	// background jobs when multiple servers share a store.
+	AcquireLease(ctx context.Context, name string, holder string, ttl time.Duration) (bool, error)
+
 	// Updates will receive pipelines and configurations that have been updated or deleted, either because the
 	// configuration changed or a component in them was updated. Agents inserted/updated from UpsertAgent and agents
 	// removed from CleanupDisconnectedAgents are also sent with Updates.
@@ -114,7 +147,25 @@ type Store interface {
 // Store implementation.
 type AgentUpdater func(current *model.Agent)
 
-// ErrResourceMissing is used in delete functions to indicate the delete
+// AgentCondition returns true if the current Agent should be deleted by DeleteAgentsIf
+type AgentCondition func(current *model.Agent) bool
+
+// anyAgent is the AgentCondition used to delete agents unconditionally
+func anyAgent(*model.Agent) bool { return true }
+
+// ConfigurationUpdater is given the current Configuration and returns the Configuration to store in its place. It must
+// not modify the current Configuration because it may be called more than once if there are concurrent updates.
+type ConfigurationUpdater func(current *model.Configuration) (*model.Configuration, error)
+
+// maxConfigurationUpdateAttempts is the number of times UpdateConfiguration calls the updater before giving up because
+// the configuration keeps changing
+const maxConfigurationUpdateAttempts = 5
+
+// ErrResourceChanged is used in update functions to indicate the update could not be performed because the resource
+// kept changing while it was updated
+var ErrResourceChanged = errors.New("resource changed during the update")
+
+// ErrResourceMissing is used in delete and update functions to indicate the operation
 // could not be performed because no such resource exists
 var ErrResourceMissing = errors.New("resource not found")
 
@@ -248,6 +299,151 @@ func seedDir(dir string, store Store, logger *zap.Logger) error {
 	return nil
 }
 
+// ----------------------------------------------------------------------
+// secrets
+
+// NewSecretKeyring returns the keyring used to encrypt secret parameters based on the server configuration. It returns
+// nil if no encryption key is configured.
+func NewSecretKeyring(cfg *common.Server) (*model.SecretKeyring, error) {
+	key := cfg.SecretEncryptionKey()
+	if key == "" {
+		return nil, nil
+	}
+	return model.NewSecretKeyring(key, cfg.PreviousEncryptionKeys...)
+}
+
+// NewSecretProviders returns the providers of secret references enabled by the server configuration
+func NewSecretProviders(cfg *common.Server) model.SecretProviders {
+	return model.NewSecretProviders(cfg.SecretProviders.EnvPrefixes, cfg.SecretProviders.PathPrefixes)
+}
+
+// storeAgentPlatforms returns model.AgentPlatforms that finds the platforms of agents using Store.Agents
+func storeAgentPlatforms(s Store) model.AgentPlatforms {
+	return func(selector model.Selector) ([]string, error) {
+		agents, err := s.Agents(context.Background(), WithSelector(selector))
+		if err != nil {
+			return nil, err
+		}
+		return agentPlatforms(agents), nil
+	}
+}
+
+// agentPlatforms returns the sorted distinct platforms of the agents. Agents that have not reported a platform are
+// ignored.
+func agentPlatforms(agents []*model.Agent) []string {
+	platforms := []string{}
+	seen := map[string]bool{}
+	for _, agent := range agents {
+		if agent.Platform == "" || seen[agent.Platform] {
+			continue
+		}
+		seen[agent.Platform] = true
+		platforms = append(platforms, agent.Platform)
+	}
+	sort.Strings(platforms)
+	return platforms
+}
+
+// encryptSecrets encrypts the values of secret parameters of a resource before it is stored. The stored version of the
+// resource is used to keep secrets that are redacted in the applied resource.
+func encryptSecrets(s Store, keyring *model.SecretKeyring, resource model.Resource) error {
+	if keyring == nil {
+		return nil
+	}
+	previous, err := storedResource(s, resource)
+	if err != nil {
+		return err
+	}
+	_, err = model.EncryptSecrets(resource, previous, s, keyring)
+	return err
+}
+
+// storedResource returns the stored version of a Source, Processor, Destination, or Configuration or nil if it does
+// not exist or is another kind of resource
+func storedResource(s Store, resource model.Resource) (model.Resource, error) {
+	switch resource.GetKind() {
+	case model.KindSource:
+		return nonNilResource(s.Source(resource.Name()))
+	case model.KindProcessor:
+		return nonNilResource(s.Processor(resource.Name()))
+	case model.KindDestination:
+		return nonNilResource(s.Destination(resource.Name()))
+	case model.KindConfiguration:
+		return nonNilResource(s.Configuration(resource.Name()))
+	}
+	return nil, nil
+}
+
+// nonNilResource avoids returning a typed nil as a model.Resource
+func nonNilResource[R interface {
+	model.Resource
+	comparable
+}](resource R, err error) (model.Resource, error) {
+	var empty R
+	if err != nil || resource == empty {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// RotateSecrets re-encrypts secrets that were encrypted with a previous key of the keyring using the current key.
+// Resources with rotated secrets are applied to the store.
+func RotateSecrets(s Store, keyring *model.SecretKeyring, logger *zap.Logger) error {
+	if keyring == nil {
+		return nil
+	}
+	var resources []model.Resource
+	var errs error
+
+	sources, err := s.Sources()
+	if err != nil {
+		return err
+	}
+	resources = appendRotated(resources, sources, s, keyring, &errs)
+
+	processors, err := s.Processors()
+	if err != nil {
+		return err
+	}
+	resources = appendRotated(resources, processors, s, keyring, &errs)
+
+	destinations, err := s.Destinations()
+	if err != nil {
+		return err
+	}
+	resources = appendRotated(resources, destinations, s, keyring, &errs)
+
+	configurations, err := s.Configurations()
+	if err != nil {
+		return err
+	}
+	resources = appendRotated(resources, configurations, s, keyring, &errs)
+
+	if len(resources) > 0 {
+		if _, err := s.ApplyResources(resources); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		logger.Info("Rotated secrets", zap.Int("resources", len(resources)))
+	}
+	return errs
+}
+
+func appendRotated[R model.Resource](resources []model.Resource, list []R, s Store, keyring *model.SecretKeyring, errs *error) []model.Resource {
+	for _, resource := range list {
+		rotated, changed, err := model.RotateSecrets(resource, s, keyring)
+		if err != nil {
+			*errs = multierror.Append(*errs, fmt.Errorf("%s %s: %w", resource.GetKind(), resource.Name(), err))
+			continue
+		}
+		if changed {
+			resources = append(resources, rotated)
+		}
+	}
+	return resources
+}
+
+// ----------------------------------------------------------------------
+
 type dependency struct {
 	name string
 	kind model.Kind
@@ -314,6 +510,37 @@ func FindDependentResources(ctx context.Context, s Store, r model.Resource) (Dep
 		for _, id := range ids {
 			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
 		}
+
+	case model.KindProcessor:
+		ids, err := search.Field(ctx, s.ConfigurationIndex(), "processor", r.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
+		}
+
+	case model.KindConfiguration:
+		// configurations that extend the configuration
+		ids, err := search.Field(ctx, s.ConfigurationIndex(), "extends", r.Name())
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			dependencies.add(dependency{name: id, kind: model.KindConfiguration})
+		}
+
+	case model.KindConfigurationTemplate:
+		// instances are found directly because an instance may not have generated a configuration
+		instances, err := s.ConfigurationInstances()
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range instances {
+			if instance.Spec.Template == r.Name() {
+				dependencies.add(dependency{name: instance.Name(), kind: model.KindConfigurationInstance})
+			}
+		}
 	}
 
 	return dependencies, nil
@@ -131,10 +131,47 @@ var (
 		},
 	})
 
+	batchProcessorType = model.NewProcessorType("batch", []model.ParameterDefinition{})
+	batchProcessor     = model.NewProcessor("batch-1", "batch", []model.Parameter{})
+
+	testProcessorConfiguration = model.NewConfigurationWithSpec("configuration-processors", model.ConfigurationSpec{
+		Sources: []model.ResourceConfiguration{
+			{
+				Name: macosSource.Name(),
+			},
+		},
+		Destinations: []model.ResourceConfiguration{
+			{
+				Name: cabinDestination1.Name(),
+				Processors: []model.ResourceConfiguration{
+					{
+						Name: batchProcessor.Name(),
+					},
+				},
+			},
+		},
+	})
+
+	testExtendedConfiguration = model.NewConfigurationWithSpec("configuration-extended", model.ConfigurationSpec{
+		Extends: []string{testConfiguration.Name()},
+	})
+
+	testConfigurationTemplate  = model.NewConfigurationTemplate("configuration-template", model.ConfigurationTemplateSpec{})
+	testConfigurationInstance  = model.NewConfigurationInstance("configuration-instance", testConfigurationTemplate.Name(), nil)
+	testGeneratedConfiguration = generateTestConfiguration(testConfigurationInstance, testConfigurationTemplate)
+
 	testRawConfiguration1 = model.NewRawConfiguration("test-configuration-1", "raw:")
 	testRawConfiguration2 = model.NewRawConfiguration("test-configuration-2", "raw:")
 )
 
+func generateTestConfiguration(instance *model.ConfigurationInstance, template *model.ConfigurationTemplate) *model.Configuration {
+	configuration, err := instance.Generate(template)
+	if err != nil {
+		panic(err)
+	}
+	return configuration
+}
+
 func applyTestTypes(t *testing.T, store Store) {
 	statuses, err := store.ApplyResources([]model.Resource{
 		cabinDestinationType,
@@ -799,6 +836,9 @@ func runDeleteResourcesReturnTests(t *testing.T, store Store) {
 }
 
 func runDependentResourcesTests(t *testing.T, s Store) {
+	pendingTemplate := model.NewConfigurationTemplate("configuration-template-pending", model.ConfigurationTemplateSpec{})
+	pendingInstance := model.NewConfigurationInstance("configuration-instance-pending", pendingTemplate.Name(), nil)
+
 	tests := []struct {
 		description      string
 		initialResources []model.Resource
@@ -839,6 +879,72 @@ func runDependentResourcesTests(t *testing.T, s Store) {
 				},
 			},
 		},
+		{
+			description: "destination processor has configuration dependency",
+			initialResources: []model.Resource{
+				macosSourceType,
+				macosSource,
+				cabinDestinationType,
+				cabinDestination1,
+				batchProcessorType,
+				batchProcessor,
+				testProcessorConfiguration,
+			},
+			testResource: batchProcessor,
+			expect: DependentResources{
+				{
+					name: testProcessorConfiguration.Name(),
+					kind: model.KindConfiguration,
+				},
+			},
+		},
+		{
+			description: "base configuration has configuration dependency",
+			initialResources: []model.Resource{
+				macosSourceType,
+				macosSource,
+				cabinDestinationType,
+				cabinDestination1,
+				testConfiguration,
+				testExtendedConfiguration,
+			},
+			testResource: testConfiguration,
+			expect: DependentResources{
+				{
+					name: testExtendedConfiguration.Name(),
+					kind: model.KindConfiguration,
+				},
+			},
+		},
+		{
+			description: "configuration template has configuration instance dependency",
+			initialResources: []model.Resource{
+				testConfigurationTemplate,
+				testConfigurationInstance,
+				testGeneratedConfiguration,
+			},
+			testResource: testConfigurationTemplate,
+			expect: DependentResources{
+				{
+					name: testConfigurationInstance.Name(),
+					kind: model.KindConfigurationInstance,
+				},
+			},
+		},
+		{
+			description: "configuration template has configuration instance dependency without a generated configuration",
+			initialResources: []model.Resource{
+				pendingTemplate,
+				pendingInstance,
+			},
+			testResource: pendingTemplate,
+			expect: DependentResources{
+				{
+					name: pendingInstance.Name(),
+					kind: model.KindConfigurationInstance,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -991,7 +1097,7 @@ func verifyAgentsRemove(t *testing.T, done chan bool, Updates <-chan *Updates, e
 	}
 }
 
-// runDeleteAgentsTests tests store.DeleteAgents
+// runDeleteAgentsTests tests store.DeleteAgents and store.DeleteAgentsIf
 func runDeleteAgentsTests(t *testing.T, store Store) {
 	deleteTests := []struct {
 		description    string
@@ -1057,6 +1163,23 @@ func runDeleteAgentsTests(t *testing.T, store Store) {
 		})
 	}
 
+	t.Run("only agents matching the condition are deleted", func(t *testing.T) {
+		store.Clear()
+		ctx := context.Background()
+		addAgent(store, &model.Agent{ID: "1", Labels: model.MakeLabels()})
+		addAgent(store, &model.Agent{ID: "2", Name: "keep", Labels: model.MakeLabels()})
+
+		deleted, err := store.DeleteAgentsIf(ctx, []string{"1", "2", "42"}, func(agent *model.Agent) bool {
+			return agent.Name != "keep"
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.Agent{{ID: "1", Status: 5, Labels: model.MakeLabels()}}, deleted)
+
+		rest, err := store.Agents(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.Agent{{ID: "2", Name: "keep", Labels: model.MakeLabels()}}, rest)
+	})
+
 	t.Run("deleting an agent removes it from the index", func(t *testing.T) {
 		// setup
 		store.Clear()
@@ -1312,3 +1435,190 @@ func requireOkStatuses(t *testing.T, statuses []model.ResourceStatus) {
 		}, status.Status)
 	}
 }
+
+func secretOptions(t *testing.T, current string, previous ...string) Options {
+	keyring, err := model.NewSecretKeyring(current, previous...)
+	require.NoError(t, err)
+	options := testOptions
+	options.SecretKeyring = keyring
+	return options
+}
+
+func secretDestination(value string) *model.Destination {
+	return model.NewDestination("secret-1", "secret", []model.Parameter{
+		{
+			Name:  "password",
+			Value: value,
+		},
+	})
+}
+
+var secretDestinationType = model.NewDestinationType("secret", []model.ParameterDefinition{
+	{
+		Name: "password",
+		Type: "secret",
+	},
+})
+
+func storedSecret(t *testing.T, store Store) string {
+	destination, err := store.Destination("secret-1")
+	require.NoError(t, err)
+	require.NotNil(t, destination)
+	return destination.Spec.Parameters[0].Value.(string)
+}
+
+func runSecretsTests(t *testing.T, store Store) {
+	statuses, err := store.ApplyResources([]model.Resource{secretDestinationType, secretDestination("hunter2")})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	// the secret is encrypted in the store
+	encrypted := storedSecret(t, store)
+	require.True(t, model.IsEncryptedSecret(encrypted))
+
+	t.Run("applying the same secret is unchanged", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{secretDestination("hunter2")})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusUnchanged, statuses[0].Status)
+		require.Equal(t, encrypted, storedSecret(t, store))
+	})
+
+	t.Run("applying a redacted secret keeps the secret", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{secretDestination(model.RedactedSecret)})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusUnchanged, statuses[0].Status)
+		require.Equal(t, encrypted, storedSecret(t, store))
+	})
+
+	t.Run("applying a new secret encrypts it", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{secretDestination("correct horse")})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusConfigured, statuses[0].Status)
+		updated := storedSecret(t, store)
+		require.True(t, model.IsEncryptedSecret(updated))
+		require.NotEqual(t, encrypted, updated)
+	})
+
+	t.Run("applying a redacted secret for a new resource is invalid", func(t *testing.T) {
+		destination := secretDestination(model.RedactedSecret)
+		destination.Metadata.Name = "secret-2"
+		statuses, err := store.ApplyResources([]model.Resource{destination})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusInvalid, statuses[0].Status)
+	})
+}
+
+func runAgentPlatformTests(t *testing.T, store Store) {
+	journaldSourceType := model.NewSourceTypeWithSpec("journald", model.ResourceTypeSpec{
+		SupportedPlatforms: []string{"linux"},
+	})
+	journaldSource := model.NewSource("journald-1", "journald", []model.Parameter{})
+	configuration := func(selector string) *model.Configuration {
+		return model.NewConfigurationWithSpec("journald", model.ConfigurationSpec{
+			Selector: model.AgentSelector{
+				MatchLabels: model.MatchLabels{"app": selector},
+			},
+			Sources: []model.ResourceConfiguration{
+				{
+					Name: journaldSource.Name(),
+				},
+			},
+			Destinations: []model.ResourceConfiguration{
+				{
+					Name: cabinDestination1.Name(),
+				},
+			},
+		})
+	}
+
+	statuses, err := store.ApplyResources([]model.Resource{journaldSourceType, journaldSource, cabinDestinationType, cabinDestination1})
+	require.NoError(t, err)
+	requireOkStatuses(t, statuses)
+
+	require.NoError(t, addAgent(store, &model.Agent{ID: "linux", Platform: "linux", Labels: labels(map[string]string{"app": "journald"})}))
+	require.NoError(t, addAgent(store, &model.Agent{ID: "windows", Platform: "windows", Labels: labels(map[string]string{"app": "eventlog"})}))
+
+	t.Run("configuration selects supported agents", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{configuration("journald")})
+		require.NoError(t, err)
+		requireOkStatuses(t, statuses)
+	})
+
+	t.Run("configuration selects unsupported agents", func(t *testing.T) {
+		statuses, err := store.ApplyResources([]model.Resource{configuration("eventlog")})
+		require.NoError(t, err)
+		require.Equal(t, model.StatusInvalid, statuses[0].Status)
+		require.Contains(t, statuses[0].Reason, "SourceType journald does not support platform windows of the selected agents")
+	})
+}
+
+func runLeaseTests(t *testing.T, store Store) {
+	ctx := context.Background()
+
+	acquired, err := store.AcquireLease(ctx, "job/test", "server-1", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired, "first holder acquires the lease")
+
+	acquired, err = store.AcquireLease(ctx, "job/test", "server-1", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired, "holder renews the lease")
+
+	acquired, err = store.AcquireLease(ctx, "job/test", "server-2", time.Minute)
+	require.NoError(t, err)
+	require.False(t, acquired, "other servers cannot acquire a held lease")
+
+	acquired, err = store.AcquireLease(ctx, "job/other", "server-2", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired, "leases are independent")
+
+	// expire the lease
+	acquired, err = store.AcquireLease(ctx, "job/expiring", "server-1", time.Nanosecond)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	time.Sleep(time.Millisecond)
+	acquired, err = store.AcquireLease(ctx, "job/expiring", "server-2", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired, "expired leases can be acquired by other servers")
+}
+
+// appendRaw returns an updater that appends the line to the raw configuration
+func appendRaw(line string) ConfigurationUpdater {
+	return func(current *model.Configuration) (*model.Configuration, error) {
+		updated := model.NewRawConfiguration(current.Name(), fmt.Sprintf("%s\n%s", current.Spec.Raw, line))
+		return updated, nil
+	}
+}
+
+func runUpdateConfigurationTests(t *testing.T, store Store) {
+	status, err := store.ApplyResources([]model.Resource{model.NewRawConfiguration("update-configuration", "raw:")})
+	require.NoError(t, err)
+	requireOkStatuses(t, status)
+
+	t.Run("missing configuration", func(t *testing.T) {
+		_, err := store.UpdateConfiguration("missing", appendRaw("one: 1"))
+		require.ErrorIs(t, err, ErrResourceMissing)
+	})
+
+	t.Run("updater error", func(t *testing.T) {
+		_, err := store.UpdateConfiguration("update-configuration", func(current *model.Configuration) (*model.Configuration, error) {
+			return nil, fmt.Errorf("updater failed")
+		})
+		require.EqualError(t, err, "updater failed")
+	})
+
+	t.Run("invalid configuration", func(t *testing.T) {
+		result, err := store.UpdateConfiguration("update-configuration", appendRaw("key: [unclosed"))
+		require.NoError(t, err)
+		require.Equal(t, model.StatusInvalid, result.Status)
+	})
+
+	t.Run("updates configuration", func(t *testing.T) {
+		result, err := store.UpdateConfiguration("update-configuration", appendRaw("one: 1"))
+		require.NoError(t, err)
+		require.Equal(t, model.StatusConfigured, result.Status)
+
+		config, err := store.Configuration("update-configuration")
+		require.NoError(t, err)
+		require.Equal(t, "raw:\none: 1", config.Spec.Raw)
+	})
+}
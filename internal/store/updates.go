@@ -33,6 +33,10 @@ type Updates struct {
 	Destinations     Events[*model.Destination]
 	DestinationTypes Events[*model.DestinationType]
 	Configurations   Events[*model.Configuration]
+
+	ConfigurationTemplates Events[*model.ConfigurationTemplate]
+	ConfigurationInstances Events[*model.ConfigurationInstance]
+	LabelRules             Events[*model.LabelRule]
 }
 
 // NewUpdates returns a New Updates struct
@@ -47,6 +51,10 @@ func NewUpdates() *Updates {
 		Destinations:     NewEvents[*model.Destination](),
 		DestinationTypes: NewEvents[*model.DestinationType](),
 		Configurations:   NewEvents[*model.Configuration](),
+
+		ConfigurationTemplates: NewEvents[*model.ConfigurationTemplate](),
+		ConfigurationInstances: NewEvents[*model.ConfigurationInstance](),
+		LabelRules:             NewEvents[*model.LabelRule](),
 	}
 }
 
@@ -74,6 +82,12 @@ func (updates *Updates) IncludeResource(r model.Resource, eventType EventType) {
 		updates.DestinationTypes.Include(r, eventType)
 	case *model.Configuration:
 		updates.Configurations.Include(r, eventType)
+	case *model.ConfigurationTemplate:
+		updates.ConfigurationTemplates.Include(r, eventType)
+	case *model.ConfigurationInstance:
+		updates.ConfigurationInstances.Include(r, eventType)
+	case *model.LabelRule:
+		updates.LabelRules.Include(r, eventType)
 	}
 }
 
@@ -91,7 +105,10 @@ func (updates *Updates) Size() int {
 		len(updates.ProcessorTypes) +
 		len(updates.Destinations) +
 		len(updates.DestinationTypes) +
-		len(updates.Configurations)
+		len(updates.Configurations) +
+		len(updates.ConfigurationTemplates) +
+		len(updates.ConfigurationInstances) +
+		len(updates.LabelRules)
 }
 
 // ----------------------------------------------------------------------
@@ -108,6 +125,8 @@ func (updates *Updates) addTransitiveUpdates(s Store) error {
 	// for sources and sourceTypes, add configurations
 	// for processors and processorTypes, add configurations
 	// for destinations and destinationTypes, add configurations
+	// for configurations, add configurations that extend them
+	// for configurationTemplates, add configurationInstances
 
 	var errs error
 
@@ -131,6 +150,11 @@ func (updates *Updates) addTransitiveUpdates(s Store) error {
 		errs = multierror.Append(errs, err)
 	}
 
+	err = updates.addConfigurationInstanceUpdates(s)
+	if err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	return errs
 }
 
@@ -235,6 +259,33 @@ func (updates *Updates) addDestinationUpdates(s Store) error {
 	return nil
 }
 
+func (updates *Updates) addConfigurationInstanceUpdates(s Store) error {
+	if updates.ConfigurationTemplates.Empty() {
+		return nil
+	}
+
+	instances, err := s.ConfigurationInstances()
+	if err != nil {
+		return err
+	}
+
+	// updates to a ConfigurationTemplate will trigger updates of all of the ConfigurationInstances that use it so that
+	// their configurations are generated again.
+	for _, templateEvent := range updates.ConfigurationTemplates {
+		if templateEvent.Type == EventTypeUpdate {
+			templateName := templateEvent.Item.Name()
+
+			for _, instance := range instances {
+				if instance.Spec.Template == templateName {
+					updates.ConfigurationInstances.Include(instance, EventTypeUpdate)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func (updates *Updates) addConfigurationUpdates(s Store) error {
 	configurations, err := s.Configurations()
 	if err != nil {
@@ -249,9 +300,30 @@ func (updates *Updates) addConfigurationUpdates(s Store) error {
 		}
 		updates.addConfigurationUpdatesFromComponents(configuration, s)
 	}
+	updates.addConfigurationUpdatesFromBases(configurations)
 	return nil
 }
 
+// addConfigurationUpdatesFromBases adds updates for configurations that extend updated configurations, repeating until
+// the configurations that extend those configurations are also included
+func (updates *Updates) addConfigurationUpdatesFromBases(configurations []*model.Configuration) {
+	for added := true; added; {
+		added = false
+		for _, configuration := range configurations {
+			if _, ok := updates.Configurations[configuration.Name()]; ok {
+				continue
+			}
+			for _, base := range configuration.Spec.Extends {
+				if updates.Configurations.Contains(base, EventTypeUpdate) {
+					updates.Configurations.Include(configuration, EventTypeUpdate)
+					added = true
+					break
+				}
+			}
+		}
+	}
+}
+
 func (updates *Updates) addConfigurationUpdatesFromComponents(configuration *model.Configuration, s Store) {
 	for _, source := range configuration.Spec.Sources {
 		if _, ok := updates.Sources[source.Name]; ok {
@@ -262,6 +334,10 @@ func (updates *Updates) addConfigurationUpdatesFromComponents(configuration *mod
 			updates.Configurations.Include(configuration, EventTypeUpdate)
 			return
 		}
+		if updates.hasProcessorUpdates(source.Processors) {
+			updates.Configurations.Include(configuration, EventTypeUpdate)
+			return
+		}
 	}
 	for _, destination := range configuration.Spec.Destinations {
 		if _, ok := updates.Destinations[destination.Name]; ok {
@@ -272,7 +348,27 @@ func (updates *Updates) addConfigurationUpdatesFromComponents(configuration *mod
 			updates.Configurations.Include(configuration, EventTypeUpdate)
 			return
 		}
+		if updates.hasProcessorUpdates(destination.Processors) {
+			updates.Configurations.Include(configuration, EventTypeUpdate)
+			return
+		}
+	}
+	if updates.hasProcessorUpdates(configuration.Spec.Processors) {
+		updates.Configurations.Include(configuration, EventTypeUpdate)
+	}
+}
+
+// hasProcessorUpdates returns true if any of the processors or their processor types have been updated
+func (updates *Updates) hasProcessorUpdates(processors []model.ResourceConfiguration) bool {
+	for _, processor := range processors {
+		if _, ok := updates.Processors[processor.Name]; ok {
+			return true
+		}
+		if _, ok := updates.ProcessorTypes[processor.Type]; ok {
+			return true
+		}
 	}
+	return false
 }
 
 // ----------------------------------------------------------------------
@@ -285,7 +381,10 @@ func mergeUpdates(into, single *Updates) bool {
 		into.SourceTypes.CanSafelyMerge(single.SourceTypes) &&
 		into.Destinations.CanSafelyMerge(single.Destinations) &&
 		into.DestinationTypes.CanSafelyMerge(single.DestinationTypes) &&
-		into.Configurations.CanSafelyMerge(single.Configurations)
+		into.Configurations.CanSafelyMerge(single.Configurations) &&
+		into.ConfigurationTemplates.CanSafelyMerge(single.ConfigurationTemplates) &&
+		into.ConfigurationInstances.CanSafelyMerge(single.ConfigurationInstances) &&
+		into.LabelRules.CanSafelyMerge(single.LabelRules)
 
 	if !safe {
 		return false
@@ -298,6 +397,9 @@ func mergeUpdates(into, single *Updates) bool {
 	into.Destinations.Merge(single.Destinations)
 	into.DestinationTypes.Merge(single.DestinationTypes)
 	into.Configurations.Merge(single.Configurations)
+	into.ConfigurationTemplates.Merge(single.ConfigurationTemplates)
+	into.ConfigurationInstances.Merge(single.ConfigurationInstances)
+	into.LabelRules.Merge(single.LabelRules)
 
 	return true
 }
@@ -65,6 +65,13 @@ func updatesTestSetup(t *testing.T) {
 		newTestConfiguration("c5", nil, nil, nil, nil),
 		newTestConfiguration("c6", []string{"s4"}, nil, []string{"d3"}, nil),
 		newTestConfiguration("c7", nil, []string{"st5"}, []string{"d3"}, nil),
+		newTestExtendedConfiguration("c8", "c7"),
+		newTestExtendedConfiguration("c9", "c8"),
+		model.NewConfigurationTemplate("ct1", model.ConfigurationTemplateSpec{}),
+		model.NewConfigurationTemplate("ct2", model.ConfigurationTemplateSpec{}),
+		model.NewConfigurationInstance("ci1", "ct1", nil),
+		model.NewConfigurationInstance("ci2", "ct1", nil),
+		model.NewConfigurationInstance("ci3", "ct2", nil),
 	}
 	for _, resource := range resources {
 		resourceMap[resource.Name()] = resource
@@ -129,6 +136,12 @@ func newTestConfiguration(name string, sources []string, sourceTypes []string, d
 	return c
 }
 
+func newTestExtendedConfiguration(name string, base string) *model.Configuration {
+	c := newTestConfiguration(name, nil, nil, nil, nil)
+	c.Spec.Extends = []string{base}
+	return c
+}
+
 func addUpdates[T model.Resource](t *testing.T, names []string, events Events[T]) {
 	for _, name := range names {
 		resource, ok := resourceMap[name]
@@ -150,6 +163,8 @@ func TestTransitiveUpdates(t *testing.T) {
 		DestinationTypes []string
 		Configurations   []string
 
+		ConfigurationTemplates []string
+
 		ExpectSources          []string
 		ExpectSourceTypes      []string
 		ExpectProcessors       []string
@@ -157,6 +172,9 @@ func TestTransitiveUpdates(t *testing.T) {
 		ExpectDestinations     []string
 		ExpectDestinationTypes []string
 		ExpectConfigurations   []string
+
+		ExpectConfigurationTemplates []string
+		ExpectConfigurationInstances []string
 	}{
 		{
 			Name:                 "s1 source",
@@ -236,6 +254,23 @@ func TestTransitiveUpdates(t *testing.T) {
 			ExpectProcessorTypes: []string{"pt1"},
 			ExpectConfigurations: []string{"c6"},
 		},
+		{
+			Name:                 "c7 configuration",
+			Configurations:       []string{"c7"},
+			ExpectConfigurations: []string{"c7", "c8", "c9"},
+		},
+		{
+			Name:                 "d3 destination",
+			Destinations:         []string{"d3"},
+			ExpectDestinations:   []string{"d3"},
+			ExpectConfigurations: []string{"c3", "c6", "c7", "c8", "c9"},
+		},
+		{
+			Name:                         "ct1 configuration template",
+			ConfigurationTemplates:       []string{"ct1"},
+			ExpectConfigurationTemplates: []string{"ct1"},
+			ExpectConfigurationInstances: []string{"ci1", "ci2"},
+		},
 	}
 
 	for _, test := range tests {
@@ -250,6 +285,7 @@ func TestTransitiveUpdates(t *testing.T) {
 			addUpdates(t, test.Destinations, updates.Destinations)
 			addUpdates(t, test.DestinationTypes, updates.DestinationTypes)
 			addUpdates(t, test.Configurations, updates.Configurations)
+			addUpdates(t, test.ConfigurationTemplates, updates.ConfigurationTemplates)
 
 			// add transitive
 			err := updates.addTransitiveUpdates(updatesTestStore)
@@ -263,6 +299,8 @@ func TestTransitiveUpdates(t *testing.T) {
 			require.ElementsMatch(t, test.ExpectDestinations, updates.Destinations.Keys(), "Destinations")
 			require.ElementsMatch(t, test.ExpectDestinationTypes, updates.DestinationTypes.Keys(), "DestinationTypes")
 			require.ElementsMatch(t, test.ExpectConfigurations, updates.Configurations.Keys(), "Configurations")
+			require.ElementsMatch(t, test.ExpectConfigurationTemplates, updates.ConfigurationTemplates.Keys(), "ConfigurationTemplates")
+			require.ElementsMatch(t, test.ExpectConfigurationInstances, updates.ConfigurationInstances.Keys(), "ConfigurationInstances")
 		})
 	}
 }
@@ -0,0 +1,245 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrictest provides a metric.Meter that records measurements so that tests can verify the metrics reported
+// by a component.
+package metrictest
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/nonrecording"
+)
+
+// Measurement is a value recorded by an instrument
+type Measurement struct {
+	Value      float64
+	Attributes attribute.Set
+}
+
+// Meter records the measurements of the instruments it creates by instrument name. Asynchronous instruments are only
+// observed when Collect is called.
+type Meter struct {
+	noop         metric.Meter
+	mtx          sync.Mutex
+	measurements map[string][]Measurement
+	callbacks    []func(context.Context)
+}
+
+var _ metric.Meter = (*Meter)(nil)
+
+// NewMeter returns a new Meter without any measurements
+func NewMeter() *Meter {
+	return &Meter{
+		noop:         nonrecording.NewNoopMeter(),
+		measurements: map[string][]Measurement{},
+	}
+}
+
+// Collect calls the registered callbacks to observe the asynchronous instruments
+func (m *Meter) Collect(ctx context.Context) {
+	m.mtx.Lock()
+	callbacks := append([]func(context.Context){}, m.callbacks...)
+	m.mtx.Unlock()
+	for _, callback := range callbacks {
+		callback(ctx)
+	}
+}
+
+// Measurements returns the measurements recorded by the instrument with the specified name in the order they were
+// recorded
+func (m *Meter) Measurements(name string) []Measurement {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return append([]Measurement{}, m.measurements[name]...)
+}
+
+// Sum returns the sum of the measurements recorded by the instrument with the specified name with exactly the
+// specified attributes
+func (m *Meter) Sum(name string, attrs ...attribute.KeyValue) float64 {
+	set := attribute.NewSet(attrs...)
+	sum := 0.0
+	for _, measurement := range m.Measurements(name) {
+		if measurement.Attributes.Equals(&set) {
+			sum += measurement.Value
+		}
+	}
+	return sum
+}
+
+// Last returns the last measurement recorded by the instrument with the specified name with exactly the specified
+// attributes and false if there is none
+func (m *Meter) Last(name string, attrs ...attribute.KeyValue) (float64, bool) {
+	set := attribute.NewSet(attrs...)
+	measurements := m.Measurements(name)
+	for i := len(measurements) - 1; i >= 0; i-- {
+		if measurements[i].Attributes.Equals(&set) {
+			return measurements[i].Value, true
+		}
+	}
+	return 0, false
+}
+
+func (m *Meter) record(name string, value float64, attrs []attribute.KeyValue) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.measurements[name] = append(m.measurements[name], Measurement{Value: value, Attributes: attribute.NewSet(attrs...)})
+}
+
+// AsyncInt64 returns the provider of asynchronous int64 instruments
+func (m *Meter) AsyncInt64() asyncint64.InstrumentProvider { return asyncInt64Provider{m} }
+
+// AsyncFloat64 returns the provider of asynchronous float64 instruments
+func (m *Meter) AsyncFloat64() asyncfloat64.InstrumentProvider { return asyncFloat64Provider{m} }
+
+// SyncInt64 returns the provider of synchronous int64 instruments
+func (m *Meter) SyncInt64() syncint64.InstrumentProvider { return syncInt64Provider{m} }
+
+// SyncFloat64 returns the provider of synchronous float64 instruments
+func (m *Meter) SyncFloat64() syncfloat64.InstrumentProvider { return syncFloat64Provider{m} }
+
+// RegisterCallback registers the function called by Collect
+func (m *Meter) RegisterCallback(_ []instrument.Asynchronous, function func(context.Context)) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.callbacks = append(m.callbacks, function)
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// the instruments embed the noop instruments to implement the unexported methods of the instrument interfaces
+
+type syncInt64Provider struct{ m *Meter }
+
+func (p syncInt64Provider) Counter(name string, opts ...instrument.Option) (syncint64.Counter, error) {
+	noop, err := p.m.noop.SyncInt64().Counter(name, opts...)
+	return syncInt64{Counter: noop, m: p.m, name: name}, err
+}
+
+func (p syncInt64Provider) UpDownCounter(name string, opts ...instrument.Option) (syncint64.UpDownCounter, error) {
+	noop, err := p.m.noop.SyncInt64().Counter(name, opts...)
+	return syncInt64{Counter: noop, m: p.m, name: name}, err
+}
+
+func (p syncInt64Provider) Histogram(name string, opts ...instrument.Option) (syncint64.Histogram, error) {
+	noop, err := p.m.noop.SyncInt64().Counter(name, opts...)
+	return syncInt64{Counter: noop, m: p.m, name: name}, err
+}
+
+type syncInt64 struct {
+	syncint64.Counter
+	m    *Meter
+	name string
+}
+
+func (i syncInt64) Add(_ context.Context, incr int64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, float64(incr), attrs)
+}
+
+func (i syncInt64) Record(_ context.Context, value int64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, float64(value), attrs)
+}
+
+type syncFloat64Provider struct{ m *Meter }
+
+func (p syncFloat64Provider) Counter(name string, opts ...instrument.Option) (syncfloat64.Counter, error) {
+	noop, err := p.m.noop.SyncFloat64().Counter(name, opts...)
+	return syncFloat64{Counter: noop, m: p.m, name: name}, err
+}
+
+func (p syncFloat64Provider) UpDownCounter(name string, opts ...instrument.Option) (syncfloat64.UpDownCounter, error) {
+	noop, err := p.m.noop.SyncFloat64().Counter(name, opts...)
+	return syncFloat64{Counter: noop, m: p.m, name: name}, err
+}
+
+func (p syncFloat64Provider) Histogram(name string, opts ...instrument.Option) (syncfloat64.Histogram, error) {
+	noop, err := p.m.noop.SyncFloat64().Counter(name, opts...)
+	return syncFloat64{Counter: noop, m: p.m, name: name}, err
+}
+
+type syncFloat64 struct {
+	syncfloat64.Counter
+	m    *Meter
+	name string
+}
+
+func (i syncFloat64) Add(_ context.Context, incr float64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, incr, attrs)
+}
+
+func (i syncFloat64) Record(_ context.Context, value float64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, value, attrs)
+}
+
+type asyncInt64Provider struct{ m *Meter }
+
+func (p asyncInt64Provider) Counter(name string, opts ...instrument.Option) (asyncint64.Counter, error) {
+	noop, err := p.m.noop.AsyncInt64().Gauge(name, opts...)
+	return asyncInt64{Gauge: noop, m: p.m, name: name}, err
+}
+
+func (p asyncInt64Provider) UpDownCounter(name string, opts ...instrument.Option) (asyncint64.UpDownCounter, error) {
+	noop, err := p.m.noop.AsyncInt64().Gauge(name, opts...)
+	return asyncInt64{Gauge: noop, m: p.m, name: name}, err
+}
+
+func (p asyncInt64Provider) Gauge(name string, opts ...instrument.Option) (asyncint64.Gauge, error) {
+	noop, err := p.m.noop.AsyncInt64().Gauge(name, opts...)
+	return asyncInt64{Gauge: noop, m: p.m, name: name}, err
+}
+
+type asyncInt64 struct {
+	asyncint64.Gauge
+	m    *Meter
+	name string
+}
+
+func (i asyncInt64) Observe(_ context.Context, x int64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, float64(x), attrs)
+}
+
+type asyncFloat64Provider struct{ m *Meter }
+
+func (p asyncFloat64Provider) Counter(name string, opts ...instrument.Option) (asyncfloat64.Counter, error) {
+	noop, err := p.m.noop.AsyncFloat64().Gauge(name, opts...)
+	return asyncFloat64{Gauge: noop, m: p.m, name: name}, err
+}
+
+func (p asyncFloat64Provider) UpDownCounter(name string, opts ...instrument.Option) (asyncfloat64.UpDownCounter, error) {
+	noop, err := p.m.noop.AsyncFloat64().Gauge(name, opts...)
+	return asyncFloat64{Gauge: noop, m: p.m, name: name}, err
+}
+
+func (p asyncFloat64Provider) Gauge(name string, opts ...instrument.Option) (asyncfloat64.Gauge, error) {
+	noop, err := p.m.noop.AsyncFloat64().Gauge(name, opts...)
+	return asyncFloat64{Gauge: noop, m: p.m, name: name}, err
+}
+
+type asyncFloat64 struct {
+	asyncfloat64.Gauge
+	m    *Meter
+	name string
+}
+
+func (i asyncFloat64) Observe(_ context.Context, x float64, attrs ...attribute.KeyValue) {
+	i.m.record(i.name, x, attrs)
+}
@@ -77,6 +77,70 @@ type Agent struct {
 	// used by the agent management protocol
 	Protocol string      `json:"protocol,omitempty" yaml:"protocol,omitempty"`
 	State    interface{} `json:"state,omitempty" yaml:"state,omitempty"`
+
+	// ConnectionSettings are the connection settings most recently offered to the agent, e.g. to move it to another
+	// server or rotate the secret key
+	ConnectionSettings *AgentConnectionSettings `json:"connectionSettings,omitempty" yaml:"connectionSettings,omitempty"`
+
+	// Attributes are all of the attributes reported in the agent description
+	Attributes *AgentAttributes `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+
+	// Duplicate is set if the agent connected using the ID of another agent and was assigned a new ID
+	Duplicate *AgentDuplicate `json:"duplicate,omitempty" yaml:"duplicate,omitempty"`
+}
+
+// AgentAttributes are the identifying and non-identifying attributes reported by an agent, e.g. service.name,
+// host.name, cloud.region, or k8s.cluster.name. Values that are not strings are converted to strings.
+type AgentAttributes struct {
+	Identifying    map[string]string `json:"identifying,omitempty" yaml:"identifying,omitempty"`
+	NonIdentifying map[string]string `json:"nonIdentifying,omitempty" yaml:"nonIdentifying,omitempty"`
+}
+
+// AttributePrefix is the prefix of agent attributes in the search index, e.g. attr.cloud.region
+const AttributePrefix = "attr."
+
+// DuplicateReason is the reason an agent was considered a duplicate of another agent with the same ID
+type DuplicateReason string
+
+const (
+	// DuplicateConnection is the reason if another agent was connected with the same ID from a different address
+	DuplicateConnection DuplicateReason = "connection"
+
+	// DuplicateIdentity is the reason if the agent with the same ID has a different MAC address or host name
+	DuplicateIdentity DuplicateReason = "identity"
+)
+
+// AgentDuplicate records that an agent connected using the ID of another agent, e.g. because both were cloned from the
+// same VM image. The agent is assigned a new ID so that each agent is tracked separately.
+type AgentDuplicate struct {
+	// OriginalID is the ID used by the agent before it was assigned a new ID
+	OriginalID string          `json:"originalId" yaml:"originalId"`
+	Reason     DuplicateReason `json:"reason" yaml:"reason"`
+	DetectedAt *time.Time      `json:"detectedAt,omitempty" yaml:"detectedAt,omitempty"`
+}
+
+// ConnectionSettingsStatus is the status of connection settings offered to an agent
+type ConnectionSettingsStatus string
+
+const (
+	// ConnectionSettingsOffered is the status of connection settings sent to the agent that it has not used to connect
+	ConnectionSettingsOffered ConnectionSettingsStatus = "offered"
+
+	// ConnectionSettingsAccepted is the status of connection settings after the agent connects using them
+	ConnectionSettingsAccepted ConnectionSettingsStatus = "accepted"
+)
+
+// AgentConnectionSettings tracks the connection settings offered to an agent. Header values are not stored, only a
+// hash of the endpoint and headers that is compared with the connections from the agent.
+type AgentConnectionSettings struct {
+	Status   ConnectionSettingsStatus `json:"status" yaml:"status"`
+	Endpoint string                   `json:"endpoint" yaml:"endpoint"`
+	// Headers are the names of the offered headers
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Hash identifies the offered endpoint and header values
+	Hash       string     `json:"hash" yaml:"hash"`
+	OfferedAt  *time.Time `json:"offeredAt,omitempty" yaml:"offeredAt,omitempty"`
+	AcceptedAt *time.Time `json:"acceptedAt,omitempty" yaml:"acceptedAt,omitempty"`
 }
 
 var _ search.Indexed = (*Agent)(nil)
@@ -126,6 +190,18 @@ func (a *Agent) DisconnectedDurationDisplayText() string {
 	return durationDisplay(a.DisconnectedAt)
 }
 
+// Attribute returns the value of the identifying or non-identifying attribute with the specified name or "" if the
+// agent did not report it
+func (a *Agent) Attribute(name string) string {
+	if a.Attributes == nil {
+		return ""
+	}
+	if value, ok := a.Attributes.Identifying[name]; ok {
+		return value
+	}
+	return a.Attributes.NonIdentifying[name]
+}
+
 // MatchesSelector returns true if the given selector matches the agent's labels.
 func (a *Agent) MatchesSelector(selector Selector) bool {
 	return selector.Matches(a.Labels)
@@ -133,7 +209,7 @@ func (a *Agent) MatchesSelector(selector Selector) bool {
 
 // DisconnectedSince returns true if the agent has been disconnected since a given time.
 func (a *Agent) DisconnectedSince(since time.Time) bool {
-	return a.DisconnectedAt != nil || a.DisconnectedAt.Before(since)
+	return a.DisconnectedAt != nil && a.DisconnectedAt.Before(since)
 }
 
 // Connect updates the ConnectedAt and DisconnectedAt fields of the agent and should be called when the
@@ -202,6 +278,22 @@ func (a *Agent) IndexFields(index search.Indexer) {
 	index("macAddress", a.MacAddress)
 	index("type", a.Type)
 	index("status", a.StatusDisplayText())
+	index("protocol", a.Protocol)
+	if a.ConnectionSettings != nil {
+		index("connectionSettings", string(a.ConnectionSettings.Status))
+	}
+	if a.Duplicate != nil {
+		index("duplicate", string(a.Duplicate.Reason))
+		index("duplicateOf", a.Duplicate.OriginalID)
+	}
+	if a.Attributes != nil {
+		for name, value := range a.Attributes.NonIdentifying {
+			index(AttributePrefix+name, value)
+		}
+		for name, value := range a.Attributes.Identifying {
+			index(AttributePrefix+name, value)
+		}
+	}
 }
 
 // IndexLabels returns a map of label name to label value to be stored in the index
@@ -0,0 +1,239 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// agentTemplateKey is the name of the AgentTemplateContext in resource type templates and parameter values, e.g.
+// {{ .agent.HostName }}
+const agentTemplateKey = "agent"
+
+// AgentTemplateContext describes the agent receiving a configuration. It is available as .agent in resource type
+// templates and in parameter values so that a configuration can include the hostname, labels, or other details of each
+// agent. When a configuration is rendered without an agent, all of the fields are empty.
+type AgentTemplateContext struct {
+	ID       string
+	Name     string
+	HostName string
+	Labels   map[string]string
+	Platform string
+	Version  string
+}
+
+// newAgentTemplateContext returns the AgentTemplateContext for the agent, which may be nil
+func newAgentTemplateContext(agent *Agent) AgentTemplateContext {
+	if agent == nil {
+		return AgentTemplateContext{Labels: map[string]string{}}
+	}
+	labels := agent.Labels.Set
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return AgentTemplateContext{
+		ID:       agent.ID,
+		Name:     agent.Name,
+		HostName: agent.HostName,
+		Labels:   labels,
+		Platform: agent.Platform,
+		Version:  agent.Version,
+	}
+}
+
+// agentPlatform returns the platform of the agent, which may be nil
+func agentPlatform(agent *Agent) string {
+	if agent == nil {
+		return ""
+	}
+	return agent.Platform
+}
+
+var agentReferenceRegexp = regexp.MustCompile(`\{\{[^}]*\.agent\b`)
+
+// referencesAgent returns true if the template or parameter value uses the AgentTemplateContext. Only strings and
+// lists of strings can reference the agent.
+func referencesAgent(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return agentReferenceRegexp.MatchString(v)
+	case ResourceTypeTemplate:
+		return agentReferenceRegexp.MatchString(string(v))
+	case []string:
+		for _, s := range v {
+			if referencesAgent(s) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if referencesAgent(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evalAgentParameters renders the parameter values that reference the agent, e.g. {{ .agent.HostName }}, replacing
+// them with the rendered values
+func evalAgentParameters(params map[string]any, agentContext AgentTemplateContext, errorHandler TemplateErrorHandler) {
+	for name, value := range params {
+		if !referencesAgent(value) {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			params[name] = evalAgentValue(name, v, agentContext, errorHandler)
+		case []string:
+			values := make([]string, len(v))
+			for i, s := range v {
+				values[i] = evalAgentValue(name, s, agentContext, errorHandler)
+			}
+			params[name] = values
+		case []any:
+			values := make([]any, len(v))
+			for i, item := range v {
+				if s, ok := item.(string); ok {
+					values[i] = evalAgentValue(name, s, agentContext, errorHandler)
+				} else {
+					values[i] = item
+				}
+			}
+			params[name] = values
+		}
+	}
+}
+
+// parameterTemplateFuncs are the template functions available in parameter values. Parameter values are not trusted
+// like the templates of resource types, so functions that read the environment of the server or are not repeatable,
+// e.g. env and now, are not available.
+var parameterTemplateFuncs = sprig.HermeticTxtFuncMap()
+
+func evalAgentValue(name string, value string, agentContext AgentTemplateContext, errorHandler TemplateErrorHandler) string {
+	if !referencesAgent(value) {
+		return value
+	}
+	t, err := template.New(name).Option("missingkey=error").Funcs(parameterTemplateFuncs).Parse(value)
+	if err != nil {
+		errorHandler(fmt.Errorf("parameter %s: %w", name, err))
+		return value
+	}
+	var writer bytes.Buffer
+	if err := t.Execute(&writer, map[string]any{agentTemplateKey: agentContext}); err != nil {
+		errorHandler(fmt.Errorf("parameter %s: %w", name, err))
+		return value
+	}
+	return writer.String()
+}
+
+// validateAgentReferences returns an error if a parameter value that references the agent cannot be rendered
+func validateAgentReferences(parameter Parameter) error {
+	var err error
+	params := map[string]any{parameter.Name: parameter.Value}
+	evalAgentParameters(params, newAgentTemplateContext(nil), func(e error) {
+		if err == nil {
+			err = e
+		}
+	})
+	return err
+}
+
+// ----------------------------------------------------------------------
+
+// referencesAgent returns true if any of the templates of the resource type use the AgentTemplateContext
+func (s *ResourceTypeSpec) referencesAgent() bool {
+	outputs := []ResourceTypeOutput{s.Logs, s.Metrics, s.Traces, s.LogsMetrics, s.LogsTraces, s.MetricsTraces, s.LogsMetricsTraces}
+	for _, platform := range s.Platforms {
+		outputs = append(outputs, platform.Logs, platform.Metrics, platform.Traces, platform.LogsMetrics, platform.LogsTraces,
+			platform.MetricsTraces, platform.LogsMetricsTraces)
+	}
+	for _, output := range outputs {
+		if referencesAgent(output.Receivers) || referencesAgent(output.Processors) ||
+			referencesAgent(output.Exporters) || referencesAgent(output.Extensions) {
+			return true
+		}
+	}
+	for _, p := range s.Parameters {
+		if referencesAgent(p.Default) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferencesAgent returns true if the configuration uses the AgentTemplateContext in any of the templates of its
+// resource types or in any of its parameter values. Configurations that reference the agent must be rendered for each
+// agent while all other configurations render the same way for every agent on the same platform. Resources that cannot
+// be found are ignored and will be reported when the configuration is rendered. Configurations with overrides are also
+// rendered for each agent.
+func (c *Configuration) ReferencesAgent(store ResourceStore) bool {
+	if c.Spec.Raw != "" {
+		return false
+	}
+	c, err := c.Resolve(store)
+	if err != nil {
+		// unable to resolve the configuration, the error will be reported when the configuration is rendered
+		return false
+	}
+	if len(c.Spec.Overrides) > 0 {
+		return true
+	}
+	processorNames := inlineProcessorNames(configurationProcessorScope, c.Spec.Processors)
+	for i, processor := range c.Spec.Processors {
+		if resourceReferencesAgent(KindProcessor, processor, processorNames[i], store) {
+			return true
+		}
+	}
+	for i, source := range c.Spec.Sources {
+		if resourceReferencesAgent(KindSource, source, fmt.Sprintf("source%d", i), store) {
+			return true
+		}
+	}
+	for i, destination := range c.Spec.Destinations {
+		if resourceReferencesAgent(KindDestination, destination, fmt.Sprintf("destination%d", i), store) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceReferencesAgent(kind Kind, rc ResourceConfiguration, defaultName string, store ResourceStore) bool {
+	for i, processor := range rc.Processors {
+		if resourceReferencesAgent(KindProcessor, processor, fmt.Sprintf("%s__processor%d", defaultName, i), store) {
+			return true
+		}
+	}
+	resource, resourceType, err := findResourceAndType(kind, &rc, defaultName, store)
+	if err != nil || resourceType == nil {
+		return false
+	}
+	if resourceType.Spec.referencesAgent() {
+		return true
+	}
+	if parameterized, ok := resource.(parameterizedResource); ok {
+		for _, p := range parameterized.ResourceParameters() {
+			if referencesAgent(p.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
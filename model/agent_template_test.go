@@ -0,0 +1,154 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func testAgentTemplateStore(t *testing.T) *testResourceStore {
+	store := newTestResourceStore()
+	otlp := testResource[*SourceType](t, "sourcetype-otlp.yaml")
+	store.sourceTypes[otlp.Name()] = otlp
+	otlpDestination := testResource[*DestinationType](t, "destinationtype-otlp.yaml")
+	store.destinationTypes[otlpDestination.Name()] = otlpDestination
+	otlpAgent := testResource[*DestinationType](t, "destinationtype-otlp-agent.yaml")
+	store.destinationTypes[otlpAgent.Name()] = otlpAgent
+	return store
+}
+
+func testAgentTemplateConfiguration(destination ResourceConfiguration) *Configuration {
+	return NewConfigurationWithSpec("agent-template", ConfigurationSpec{
+		Sources:      []ResourceConfiguration{{Type: "otlp"}},
+		Destinations: []ResourceConfiguration{destination},
+	})
+}
+
+func TestReferencesAgent(t *testing.T) {
+	tests := []struct {
+		value  any
+		expect bool
+	}{
+		{"{{ .agent.HostName }}", true},
+		{"prefix-{{ index .agent.Labels \"env\" }}", true},
+		{"{{ $.agent.Name }}", true},
+		{ResourceTypeTemplate("- otlp:\n    endpoint: {{ .agent.HostName }}:4317"), true},
+		{[]any{"one", "{{ .agent.ID }}"}, true},
+		{[]string{"one", "two"}, false},
+		{"{{ .agentless }}", false},
+		{"agent.HostName", false},
+		{5, false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.expect, referencesAgent(test.value), "%v", test.value)
+	}
+}
+
+func TestRenderAgentTemplate(t *testing.T) {
+	store := testAgentTemplateStore(t)
+	configuration := testAgentTemplateConfiguration(ResourceConfiguration{
+		Type: "otlp-agent",
+		Parameters: []Parameter{
+			{Name: "tenant", Value: `{{ index .agent.Labels "env" }}.{{ .agent.Platform }}`},
+		},
+	})
+	require.NoError(t, configuration.ValidateWithStore(store))
+	require.True(t, configuration.ReferencesAgent(store))
+
+	agent := &Agent{
+		ID:       "1",
+		Name:     "web-1",
+		HostName: "web-1.example.com",
+		Labels:   LabelsFromValidatedMap(map[string]string{"env": "production"}),
+		Platform: "linux",
+		Version:  "1.6.0",
+	}
+
+	rendered := func(agent *Agent) map[string]any {
+		result, err := configuration.Render(context.TODO(), agent, store)
+		require.NoError(t, err)
+		var parsed map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(result), &parsed))
+		return parsed
+	}
+
+	parsed := rendered(agent)
+	exporter := parsed["exporters"].(map[string]any)["otlp/otlp-agent__destination0"].(map[string]any)
+	require.Equal(t, map[string]any{"X-Tenant": "production.linux"}, exporter["headers"])
+	processor := parsed["processors"].(map[string]any)["resource/otlp-agent__destination0"].(map[string]any)
+	require.Equal(t, "web-1.example.com", processor["attributes"].([]any)[0].(map[string]any)["value"])
+
+	// without an agent, the fields are empty
+	parsed = rendered(nil)
+	exporter = parsed["exporters"].(map[string]any)["otlp/otlp-agent__destination0"].(map[string]any)
+	require.Equal(t, map[string]any{"X-Tenant": "."}, exporter["headers"])
+}
+
+func TestConfigurationReferencesAgent(t *testing.T) {
+	store := testAgentTemplateStore(t)
+
+	require.False(t, testAgentTemplateConfiguration(ResourceConfiguration{Type: "otlp"}).ReferencesAgent(store))
+	require.True(t, testAgentTemplateConfiguration(ResourceConfiguration{Type: "otlp-agent"}).ReferencesAgent(store))
+
+	// parameters of processors on destinations are included
+	processorType := testResource[*ProcessorType](t, "processortype-resourceattributetransposer.yaml")
+	store.processorTypes[processorType.Name()] = processorType
+	configuration := testAgentTemplateConfiguration(ResourceConfiguration{
+		Type: "otlp",
+		Processors: []ResourceConfiguration{
+			{
+				Type: processorType.Name(),
+				Parameters: []Parameter{
+					{Name: "from", Value: "host"},
+					{Name: "to", Value: "{{ .agent.Name }}"},
+				},
+			},
+		},
+	})
+	require.True(t, configuration.ReferencesAgent(store))
+
+	require.False(t, NewRawConfiguration("raw", "receivers: {}").ReferencesAgent(store))
+}
+
+func TestValidateAgentTemplate(t *testing.T) {
+	store := testAgentTemplateStore(t)
+
+	configuration := testAgentTemplateConfiguration(ResourceConfiguration{
+		Type: "otlp-agent",
+		Parameters: []Parameter{
+			{Name: "tenant", Value: "{{ .agent.Unknown }}"},
+		},
+	})
+	err := configuration.ValidateWithStore(store)
+	require.ErrorContains(t, err, "parameter tenant: template: tenant:1:9: executing \"tenant\" at <.agent.Unknown>: can't evaluate field Unknown")
+
+	// functions that read the server environment are not available
+	configuration = testAgentTemplateConfiguration(ResourceConfiguration{
+		Type: "otlp-agent",
+		Parameters: []Parameter{
+			{Name: "tenant", Value: `{{ .agent.ID }}{{ env "BINDPLANE_CONFIG_SECRET_KEY" }}`},
+		},
+	})
+	err = configuration.ValidateWithStore(store)
+	require.ErrorContains(t, err, "parameter tenant: template: tenant:1: function \"env\" not defined")
+
+	destinationType := testResource[*DestinationType](t, "destinationtype-otlp-agent.yaml")
+	destinationType.Spec.Parameters = append(destinationType.Spec.Parameters, ParameterDefinition{Name: "agent", Type: "string"})
+	require.ErrorContains(t, destinationType.Validate(), "parameter name 'agent' is reserved for the agent template context")
+}
@@ -15,9 +15,12 @@
 package model
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/observiq/bindplane-op/internal/store/search"
 )
 
 func TestAgentApplyLabels(t *testing.T) {
@@ -113,3 +116,61 @@ func TestAgentMatchesSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentAttributes(t *testing.T) {
+	agent := &Agent{ID: "1"}
+	require.Equal(t, "", agent.Attribute("cloud.region"))
+
+	agent.Attributes = &AgentAttributes{
+		Identifying:    map[string]string{"service.name": "com.observiq.collector"},
+		NonIdentifying: map[string]string{"cloud.region": "us-east1", "k8s.cluster.name": "prod"},
+	}
+	require.Equal(t, "com.observiq.collector", agent.Attribute("service.name"))
+	require.Equal(t, "us-east1", agent.Attribute("cloud.region"))
+	require.Equal(t, "", agent.Attribute("missing"))
+
+	index := search.NewInMemoryIndex("agent")
+	require.NoError(t, index.Upsert(agent))
+	require.NoError(t, index.Upsert(&Agent{ID: "2"}))
+
+	tests := []struct {
+		query  string
+		expect []string
+	}{
+		{query: "attr.cloud.region:us-east1", expect: []string{"1"}},
+		{query: "attr.k8s.cluster.name:prod", expect: []string{"1"}},
+		{query: "attr.service.name:com.observiq.collector", expect: []string{"1"}},
+		{query: "attr.cloud.region:eu-west1", expect: []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			ids, err := index.Search(context.Background(), search.ParseQuery(test.query))
+			require.NoError(t, err)
+			require.ElementsMatch(t, test.expect, ids)
+		})
+	}
+}
+
+func TestAgentDuplicateIndex(t *testing.T) {
+	index := search.NewInMemoryIndex("agent")
+	require.NoError(t, index.Upsert(&Agent{ID: "1"}))
+	require.NoError(t, index.Upsert(&Agent{ID: "2", Duplicate: &AgentDuplicate{OriginalID: "1", Reason: DuplicateConnection}}))
+	require.NoError(t, index.Upsert(&Agent{ID: "3", Duplicate: &AgentDuplicate{OriginalID: "1", Reason: DuplicateIdentity}}))
+
+	tests := []struct {
+		query  string
+		expect []string
+	}{
+		{query: "duplicateOf:", expect: []string{"2", "3"}},
+		{query: "duplicateOf:1", expect: []string{"2", "3"}},
+		{query: "duplicate:identity", expect: []string{"3"}},
+		{query: "-duplicateOf:", expect: []string{"1"}},
+	}
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			ids, err := index.Search(context.Background(), search.ParseQuery(test.query))
+			require.NoError(t, err)
+			require.ElementsMatch(t, test.expect, ids)
+		})
+	}
+}
@@ -0,0 +1,982 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// customProcessorType is the name of the ProcessorType used for processors that do not match any other ProcessorType
+const customProcessorType = "custom"
+
+// maxImportCombinations limits the number of combinations of bool and enum parameters rendered when inferring the
+// parameters of a component
+const maxImportCombinations = 256
+
+// CollectorImportTypes are the resource types that the components of an imported collector configuration are matched
+// against
+type CollectorImportTypes struct {
+	SourceTypes      []*SourceType
+	DestinationTypes []*DestinationType
+	ProcessorTypes   []*ProcessorType
+}
+
+// ImportStatus describes how a component of an imported collector configuration was imported
+type ImportStatus string
+
+const (
+	// ImportStatusMatched indicates that the component matched a resource type and was imported as a Source,
+	// Destination, or Processor with the inferred parameters
+	ImportStatusMatched ImportStatus = "matched"
+
+	// ImportStatusCustom indicates that the processor did not match a ProcessorType and was imported as a custom
+	// Processor
+	ImportStatusCustom ImportStatus = "custom"
+
+	// ImportStatusFragment indicates that the component was included in the fragment of the imported Configuration
+	ImportStatusFragment ImportStatus = "fragment"
+)
+
+// ImportedComponent reports how a single component of a collector configuration was imported
+type ImportedComponent struct {
+	// Kind is the section of the collector configuration containing the component, e.g. receiver
+	Kind string `json:"kind" yaml:"kind"`
+	ID   string `json:"id" yaml:"id"`
+
+	Status ImportStatus `json:"status" yaml:"status"`
+
+	// ResourceType and Resource are the type and name of the resource created for the component. They are empty for
+	// components included in the fragment.
+	ResourceType string `json:"resourceType,omitempty" yaml:"resourceType,omitempty"`
+	Resource     string `json:"resource,omitempty" yaml:"resource,omitempty"`
+
+	// Note explains why the component was not matched or any differences in the imported configuration
+	Note string `json:"note,omitempty" yaml:"note,omitempty"`
+}
+
+// CollectorImport contains the resources created by importing a collector configuration and a report of how each
+// component was imported
+type CollectorImport struct {
+	Configuration *Configuration
+	Sources       []*Source
+	Processors    []*Processor
+	Destinations  []*Destination
+	Components    []ImportedComponent
+}
+
+// Resources returns the imported resources in the order they should be applied, with the Configuration last
+func (i *CollectorImport) Resources() []Resource {
+	var resources []Resource
+	for _, source := range i.Sources {
+		resources = append(resources, source)
+	}
+	for _, processor := range i.Processors {
+		resources = append(resources, processor)
+	}
+	for _, destination := range i.Destinations {
+		resources = append(resources, destination)
+	}
+	return append(resources, i.Configuration)
+}
+
+// ImportCollectorConfiguration creates a Configuration with the specified name from an OpenTelemetry collector
+// configuration. Receivers and exporters are matched against the SourceTypes and DestinationTypes by rendering their
+// templates and the parameters of each matching Source and Destination are inferred from the component configuration.
+// Processors that do not match a ProcessorType become custom processors. Pipelines whose receivers and exporters can
+// all be imported become routes of the Configuration and everything else, including all extensions, is kept in the
+// fragment of the Configuration.
+func ImportCollectorConfiguration(name string, raw string, types CollectorImportTypes) (*CollectorImport, error) {
+	config, err := otel.ParseConfiguration(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse collector configuration: %w", err)
+	}
+	if !config.HasPipelines() {
+		return nil, errors.New("collector configuration has no pipelines")
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid collector configuration: %w", err)
+	}
+
+	for _, components := range []otel.ComponentMap{config.Receivers, config.Processors, config.Exporters} {
+		for id, component := range components {
+			components[id] = normalizeImportValue(component)
+		}
+	}
+
+	importer := newCollectorImporter(name, config, types)
+	importer.matchComponents()
+	importer.findConvertiblePipelines()
+	return importer.result()
+}
+
+// ----------------------------------------------------------------------
+
+type collectorImporter struct {
+	name   string
+	config *otel.Configuration
+	types  CollectorImportTypes
+
+	// telemetry types of the pipelines using each component, by kind
+	usage map[string]map[otel.ComponentID][]otel.PipelineType
+
+	// matches of the components used in pipelines, by kind. Components that did not match have a nil match.
+	matches map[string]map[otel.ComponentID]*importMatch
+	reasons map[string]map[otel.ComponentID]string
+
+	convertible map[string]bool
+	names       map[string]bool
+}
+
+// importMatch is a resource type matching a component and the parameters inferred for it
+type importMatch struct {
+	resourceType string
+	parameters   []Parameter
+	custom       bool
+
+	// extra processors rendered by the resource type in addition to the component
+	extra []otel.ComponentID
+}
+
+const (
+	importReceivers  = "receivers"
+	importProcessors = "processors"
+	importExporters  = "exporters"
+)
+
+func newCollectorImporter(name string, config *otel.Configuration, types CollectorImportTypes) *collectorImporter {
+	ci := &collectorImporter{
+		name:        name,
+		config:      config,
+		types:       types,
+		usage:       map[string]map[otel.ComponentID][]otel.PipelineType{},
+		matches:     map[string]map[otel.ComponentID]*importMatch{},
+		reasons:     map[string]map[otel.ComponentID]string{},
+		convertible: map[string]bool{},
+		names:       map[string]bool{},
+	}
+	for _, kind := range []string{importReceivers, importProcessors, importExporters} {
+		ci.usage[kind] = map[otel.ComponentID][]otel.PipelineType{}
+		ci.matches[kind] = map[otel.ComponentID]*importMatch{}
+		ci.reasons[kind] = map[otel.ComponentID]string{}
+	}
+	for _, name := range sortedImportKeys(config.Service.Pipelines) {
+		pipeline := config.Service.Pipelines[name]
+		pipelineType := importPipelineType(name)
+		ci.addUsage(importReceivers, pipeline.Receivers, pipelineType)
+		ci.addUsage(importProcessors, pipeline.Processors, pipelineType)
+		ci.addUsage(importExporters, pipeline.Exporters, pipelineType)
+	}
+	return ci
+}
+
+func (ci *collectorImporter) addUsage(kind string, ids []otel.ComponentID, pipelineType otel.PipelineType) {
+	for _, id := range ids {
+		if !slices.Contains(ci.usage[kind][id], pipelineType) {
+			ci.usage[kind][id] = append(ci.usage[kind][id], pipelineType)
+		}
+	}
+}
+
+func importPipelineType(pipeline string) otel.PipelineType {
+	pipelineType, _ := otel.ParseComponentID(otel.ComponentID(pipeline))
+	return otel.PipelineType(pipelineType)
+}
+
+// matchComponents matches each component used in a pipeline against the resource types
+func (ci *collectorImporter) matchComponents() {
+	var sourceTypes, destinationTypes, processorTypes []*ResourceType
+	for _, t := range ci.types.SourceTypes {
+		sourceTypes = append(sourceTypes, &t.ResourceType)
+	}
+	for _, t := range ci.types.DestinationTypes {
+		destinationTypes = append(destinationTypes, &t.ResourceType)
+	}
+	var hasCustom bool
+	for _, t := range ci.types.ProcessorTypes {
+		if t.Name() == customProcessorType {
+			hasCustom = true
+			continue
+		}
+		processorTypes = append(processorTypes, &t.ResourceType)
+	}
+
+	ci.matchKind(importReceivers, ci.config.Receivers, sourceTypes, "source type")
+	ci.matchKind(importExporters, ci.config.Exporters, destinationTypes, "destination type")
+	ci.matchKind(importProcessors, ci.config.Processors, processorTypes, "processor type")
+
+	// processors that do not match a ProcessorType are imported as custom processors
+	for id, match := range ci.matches[importProcessors] {
+		if match != nil || !hasCustom {
+			continue
+		}
+		configuration, err := yaml.Marshal(map[string]any{string(id): ci.config.Processors[id]})
+		if err != nil {
+			ci.reasons[importProcessors][id] = fmt.Sprintf("unable to marshal processor: %s", err)
+			continue
+		}
+		ci.matches[importProcessors][id] = &importMatch{
+			resourceType: customProcessorType,
+			parameters:   []Parameter{{Name: "configuration", Value: string(configuration)}},
+			custom:       true,
+		}
+	}
+}
+
+func (ci *collectorImporter) matchKind(kind string, components otel.ComponentMap, types []*ResourceType, description string) {
+	sort.Slice(types, func(i, j int) bool { return types[i].Name() < types[j].Name() })
+	for id, telemetryTypes := range ci.usage[kind] {
+		var best *importMatch
+		for _, rt := range types {
+			if !coversTelemetryTypes(rt.Spec.TelemetryTypes(), telemetryTypes) {
+				continue
+			}
+			match := inferParameters(rt, kind, id, components[id], telemetryTypes)
+			if match != nil && (best == nil || len(match.extra) < len(best.extra)) {
+				best = match
+			}
+		}
+		ci.matches[kind][id] = best
+		if best == nil {
+			ci.reasons[kind][id] = fmt.Sprintf("no matching %s", description)
+		}
+	}
+}
+
+func coversTelemetryTypes(supported, required []otel.PipelineType) bool {
+	for _, t := range required {
+		if !slices.Contains(supported, t) {
+			return false
+		}
+	}
+	return true
+}
+
+// findConvertiblePipelines determines the pipelines that can be imported as routes. A pipeline can be imported if all
+// of its components were matched, its receivers and exporters are not used by pipelines that cannot be imported, and
+// its exporters are used with the same processors in every pipeline. The processors of a pipeline are imported as
+// processors of its destinations.
+func (ci *collectorImporter) findConvertiblePipelines() {
+	pipelines := ci.config.Service.Pipelines
+	for name, pipeline := range pipelines {
+		ci.convertible[name] = ci.allMatched(importReceivers, pipeline.Receivers) &&
+			ci.allMatched(importProcessors, pipeline.Processors) &&
+			ci.allMatched(importExporters, pipeline.Exporters)
+	}
+
+	for changed := true; changed; {
+		changed = false
+		// receivers and exporters cannot be both imported and in the fragment
+		for _, kind := range []string{importReceivers, importExporters} {
+			for id := range ci.usage[kind] {
+				names := ci.pipelinesUsing(kind, id)
+				if !ci.allConvertible(names) {
+					changed = ci.markNotConvertible(names) || changed
+				}
+			}
+		}
+		// destination processors apply to every route of the destination
+		for id := range ci.usage[importExporters] {
+			names := ci.pipelinesUsing(importExporters, id)
+			for _, name := range names {
+				if !slices.Equal(pipelines[name].Processors, pipelines[names[0]].Processors) {
+					changed = ci.markNotConvertible(names) || changed
+					break
+				}
+			}
+		}
+	}
+}
+
+func (ci *collectorImporter) allMatched(kind string, ids []otel.ComponentID) bool {
+	for _, id := range ids {
+		if ci.matches[kind][id] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (ci *collectorImporter) pipelinesUsing(kind string, id otel.ComponentID) []string {
+	var names []string
+	for _, name := range sortedImportKeys(ci.config.Service.Pipelines) {
+		pipeline := ci.config.Service.Pipelines[name]
+		ids := pipeline.Receivers
+		switch kind {
+		case importProcessors:
+			ids = pipeline.Processors
+		case importExporters:
+			ids = pipeline.Exporters
+		}
+		if slices.Contains(ids, id) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (ci *collectorImporter) allConvertible(names []string) bool {
+	for _, name := range names {
+		if !ci.convertible[name] {
+			return false
+		}
+	}
+	return true
+}
+
+func (ci *collectorImporter) markNotConvertible(names []string) bool {
+	var changed bool
+	for _, name := range names {
+		if ci.convertible[name] {
+			ci.convertible[name] = false
+			changed = true
+		}
+	}
+	return changed
+}
+
+// usedByConvertible returns true if the component is used by a pipeline that can be imported
+func (ci *collectorImporter) usedByConvertible(kind string, id otel.ComponentID) bool {
+	for _, name := range ci.pipelinesUsing(kind, id) {
+		if ci.convertible[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// usedByFragment returns true if the component is not used by any pipeline or is used by a pipeline that cannot be
+// imported
+func (ci *collectorImporter) usedByFragment(kind string, id otel.ComponentID) bool {
+	names := ci.pipelinesUsing(kind, id)
+	return len(names) == 0 || !ci.allConvertible(names)
+}
+
+// resourceName returns a unique resource name for the component, prefixed with the name of the configuration
+func (ci *collectorImporter) resourceName(id otel.ComponentID) string {
+	base := sanitizeImportName(fmt.Sprintf("%s-%s", ci.name, id))
+	name := base
+	for i := 2; ci.names[name]; i++ {
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+	ci.names[name] = true
+	return name
+}
+
+var invalidImportNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// sanitizeImportName replaces the characters that are not allowed in resource names
+func sanitizeImportName(name string) string {
+	name = invalidImportNameRegexp.ReplaceAllString(name, "-")
+	if len(name) > 60 {
+		name = name[:60]
+	}
+	return strings.Trim(name, "-_.")
+}
+
+func (ci *collectorImporter) result() (*CollectorImport, error) {
+	result := &CollectorImport{}
+	resourceNames := map[string]map[otel.ComponentID]string{
+		importReceivers:  {},
+		importProcessors: {},
+		importExporters:  {},
+	}
+
+	// create resources for the components of the imported pipelines
+	for _, kind := range []string{importReceivers, importProcessors, importExporters} {
+		for _, id := range sortedImportKeys(ci.usage[kind]) {
+			if !ci.usedByConvertible(kind, id) {
+				continue
+			}
+			match := ci.matches[kind][id]
+			name := ci.resourceName(id)
+			resourceNames[kind][id] = name
+			switch kind {
+			case importReceivers:
+				result.Sources = append(result.Sources, NewSource(name, match.resourceType, match.parameters))
+			case importProcessors:
+				result.Processors = append(result.Processors, NewProcessor(name, match.resourceType, match.parameters))
+			case importExporters:
+				result.Destinations = append(result.Destinations, NewDestination(name, match.resourceType, match.parameters))
+			}
+		}
+	}
+
+	spec := ConfigurationSpec{
+		Selector: AgentSelector{
+			MatchLabels: MatchLabels{"configuration": ci.name},
+		},
+	}
+	for _, source := range result.Sources {
+		spec.Sources = append(spec.Sources, ResourceConfiguration{Name: source.Name()})
+	}
+	for _, id := range sortedImportKeys(resourceNames[importExporters]) {
+		destination := ResourceConfiguration{Name: resourceNames[importExporters][id]}
+		if names := ci.pipelinesUsing(importExporters, id); len(names) > 0 {
+			for _, processor := range ci.config.Service.Pipelines[names[0]].Processors {
+				destination.Processors = append(destination.Processors, ResourceConfiguration{Name: resourceNames[importProcessors][processor]})
+			}
+		}
+		spec.Destinations = append(spec.Destinations, destination)
+	}
+	for _, name := range sortedImportKeys(ci.config.Service.Pipelines) {
+		if !ci.convertible[name] {
+			continue
+		}
+		pipeline := ci.config.Service.Pipelines[name]
+		route := Route{TelemetryTypes: []otel.PipelineType{importPipelineType(name)}}
+		for _, id := range pipeline.Receivers {
+			route.Sources = append(route.Sources, resourceNames[importReceivers][id])
+		}
+		for _, id := range pipeline.Exporters {
+			route.Destinations = append(route.Destinations, resourceNames[importExporters][id])
+		}
+		if !containsEqual(spec.Routes, route) {
+			spec.Routes = append(spec.Routes, route)
+		}
+	}
+
+	fragment, err := ci.fragment()
+	if err != nil {
+		return nil, err
+	}
+	spec.Fragment = fragment
+
+	result.Configuration = NewConfigurationWithSpec(ci.name, spec)
+	result.Components = ci.report(resourceNames)
+	return result, nil
+}
+
+// fragment returns the yaml of the pipelines that cannot be imported, their components, the unused components, and
+// the extensions
+func (ci *collectorImporter) fragment() (string, error) {
+	fragment := otel.NewConfiguration()
+	fragment.Extensions = ci.config.Extensions
+	fragment.Service.Extensions = ci.config.Service.Extensions
+
+	sections := []struct {
+		kind       string
+		components otel.ComponentMap
+		fragment   otel.ComponentMap
+	}{
+		{importReceivers, ci.config.Receivers, fragment.Receivers},
+		{importProcessors, ci.config.Processors, fragment.Processors},
+		{importExporters, ci.config.Exporters, fragment.Exporters},
+	}
+	for _, section := range sections {
+		for id, component := range section.components {
+			if ci.usedByFragment(section.kind, id) {
+				section.fragment[id] = component
+			}
+		}
+	}
+	for name, pipeline := range ci.config.Service.Pipelines {
+		if !ci.convertible[name] {
+			fragment.Service.Pipelines[name] = pipeline
+		}
+	}
+
+	if len(fragment.Receivers)+len(fragment.Processors)+len(fragment.Exporters)+len(fragment.Extensions) == 0 {
+		return "", nil
+	}
+	bytes, err := yaml.Marshal(fragment)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal fragment: %w", err)
+	}
+	return string(bytes), nil
+}
+
+func (ci *collectorImporter) report(resourceNames map[string]map[otel.ComponentID]string) []ImportedComponent {
+	var report []ImportedComponent
+	sections := []struct {
+		kind       string
+		components otel.ComponentMap
+	}{
+		{importReceivers, ci.config.Receivers},
+		{importProcessors, ci.config.Processors},
+		{importExporters, ci.config.Exporters},
+	}
+	for _, section := range sections {
+		singular := strings.TrimSuffix(section.kind, "s")
+		for _, id := range sortedImportKeys(section.components) {
+			component := ImportedComponent{Kind: singular, ID: string(id), Status: ImportStatusFragment}
+			match := ci.matches[section.kind][id]
+			switch {
+			case resourceNames[section.kind][id] != "":
+				component.Status = ImportStatusMatched
+				if match.custom {
+					component.Status = ImportStatusCustom
+				}
+				component.ResourceType = match.resourceType
+				component.Resource = resourceNames[section.kind][id]
+				if len(match.extra) > 0 {
+					component.Note = fmt.Sprintf("%s type also adds processors %s", match.resourceType, joinComponentIDs(match.extra))
+				}
+				if ci.usedByFragment(section.kind, id) {
+					component.Note = "also used by pipelines in the fragment"
+				}
+			case len(ci.usage[section.kind][id]) == 0:
+				component.Note = "not used by any pipeline"
+			case ci.reasons[section.kind][id] != "":
+				component.Note = ci.reasons[section.kind][id]
+			default:
+				component.Note = "used by pipelines that cannot be imported"
+			}
+			report = append(report, component)
+		}
+	}
+	for _, id := range sortedImportKeys(ci.config.Extensions) {
+		report = append(report, ImportedComponent{Kind: "extension", ID: string(id), Status: ImportStatusFragment})
+	}
+	return report
+}
+
+func joinComponentIDs(ids []otel.ComponentID) string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = string(id)
+	}
+	return strings.Join(values, ", ")
+}
+
+func sortedImportKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// ----------------------------------------------------------------------
+// parameter inference
+
+// importResource renders a resource type while importing. ComponentIDs are not made unique so that the rendered
+// components can be compared with the components of the imported configuration.
+type importResource struct {
+	resourceType string
+	parameters   []Parameter
+}
+
+var _ parameterizedResource = (*importResource)(nil)
+
+func (r *importResource) ComponentID(name string) otel.ComponentID { return otel.ComponentID(name) }
+func (r *importResource) Name() string                             { return "import" }
+func (r *importResource) ResourceTypeName() string                 { return r.resourceType }
+func (r *importResource) ResourceParameters() []Parameter          { return r.parameters }
+
+// importSentinelRegexp matches the placeholder values rendered for parameters whose values are inferred. The index of
+// the parameter is captured.
+var importSentinelRegexp = regexp.MustCompile(`__bindplane_import_(\d+)__`)
+
+func importSentinel(index int) string {
+	return fmt.Sprintf("__bindplane_import_%d__", index)
+}
+
+// inferParameters returns the parameters for the resource type that render the component or nil if the resource type
+// cannot render it. Each combination of bool and enum parameters is rendered with placeholders for the other scalar
+// parameters and the values of the placeholders are extracted from the component. The parameters are verified by
+// rendering the resource type again with the inferred values.
+func inferParameters(rt *ResourceType, kind string, id otel.ComponentID, component any, telemetryTypes []otel.PipelineType) *importMatch {
+	rt = importableType(rt)
+	componentType, _ := otel.ParseComponentID(id)
+
+	for _, choices := range parameterChoices(rt.Spec.Parameters) {
+		for _, placeholders := range []bool{true, false} {
+			var parameters []Parameter
+			for i, p := range rt.Spec.Parameters {
+				switch value, ok := choices[p.Name]; {
+				case ok:
+					parameters = append(parameters, Parameter{Name: p.Name, Value: value})
+				case placeholders && inferredParameterType(p.Type):
+					parameters = append(parameters, Parameter{Name: p.Name, Value: importSentinel(i)})
+				}
+			}
+			rendered, _, ok := renderImportComponent(rt, kind, componentType, parameters, telemetryTypes)
+			if !ok {
+				continue
+			}
+			extracted := map[int]any{}
+			if !matchRendered(rendered, component, extracted) {
+				continue
+			}
+			inferred, ok := inferredParameters(rt, choices, extracted)
+			if !ok {
+				continue
+			}
+			verified, extra, ok := renderImportComponent(rt, kind, componentType, inferred, telemetryTypes)
+			if !ok || !importValuesEqual(verified, component) {
+				continue
+			}
+			return &importMatch{resourceType: rt.Name(), parameters: inferred, extra: extra}
+		}
+	}
+	return nil
+}
+
+// importableType returns a copy of the resource type with secret parameters rendered as strings so that their values
+// are not redacted
+func importableType(rt *ResourceType) *ResourceType {
+	copy := *rt
+	copy.Spec.Parameters = slices.Clone(rt.Spec.Parameters)
+	for i, p := range copy.Spec.Parameters {
+		if p.Type == secretType {
+			copy.Spec.Parameters[i].Type = stringType
+		}
+	}
+	return &copy
+}
+
+func inferredParameterType(parameterType string) bool {
+	switch parameterType {
+	case stringType, intType, floatType, secretType, durationType, timezoneType:
+		return true
+	}
+	return false
+}
+
+// parameterChoices returns combinations of values of bool and enum parameters, starting with the defaults. Parameters
+// are only included while the number of combinations is at most maxImportCombinations.
+func parameterChoices(definitions []ParameterDefinition) []map[string]any {
+	combinations := []map[string]any{{}}
+	for _, p := range definitions {
+		var values []any
+		switch p.Type {
+		case boolType:
+			value, _ := p.Default.(bool)
+			values = []any{value, !value}
+		case enumType:
+			if p.Default != nil {
+				values = append(values, p.Default)
+			}
+			for _, v := range p.ValidValues {
+				if v != p.Default {
+					values = append(values, v)
+				}
+			}
+		}
+		if len(values) == 0 || len(combinations)*len(values) > maxImportCombinations {
+			continue
+		}
+		var next []map[string]any
+		for _, combination := range combinations {
+			for _, value := range values {
+				c := make(map[string]any, len(combination)+1)
+				for name, v := range combination {
+					c[name] = v
+				}
+				c[p.Name] = value
+				next = append(next, c)
+			}
+		}
+		combinations = next
+	}
+	return combinations
+}
+
+// inferredParameters returns the parameters with the chosen and extracted values, omitting values equal to the
+// defaults. It returns false if an extracted value is not valid for its parameter.
+func inferredParameters(rt *ResourceType, choices map[string]any, extracted map[int]any) ([]Parameter, bool) {
+	var parameters []Parameter
+	for i, p := range rt.Spec.Parameters {
+		value, ok := choices[p.Name]
+		if extractedValue, found := extracted[i]; found {
+			value, ok = importParameterValue(p, extractedValue)
+			if !ok {
+				return nil, false
+			}
+		}
+		if !ok || (p.Default != nil && importValuesEqual(value, p.Default)) {
+			continue
+		}
+		parameters = append(parameters, Parameter{Name: p.Name, Value: value})
+	}
+	return parameters, true
+}
+
+// importParameterValue converts a value extracted from a component to the type of the parameter
+func importParameterValue(p ParameterDefinition, value any) (any, bool) {
+	switch p.Type {
+	case intType:
+		switch v := value.(type) {
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, false
+			}
+			value = int(v)
+		case string:
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, false
+			}
+			value = i
+		}
+	case floatType:
+		switch v := value.(type) {
+		case int:
+			value = float64(v)
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, false
+			}
+			value = f
+		}
+	default:
+		if !isImportScalar(value) {
+			return nil, false
+		}
+		value = fmt.Sprint(value)
+	}
+	if p.validateValue(value) != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// renderImportComponent renders the resource type and returns the configuration of the single component of the
+// specified kind and type and the ids of any other processors rendered. It returns false if the resource type cannot
+// be rendered or renders other components.
+func renderImportComponent(rt *ResourceType, kind string, componentType string, parameters []Parameter, telemetryTypes []otel.PipelineType) (any, []otel.ComponentID, bool) {
+	var failed bool
+	partials := rt.eval(&importResource{resourceType: rt.Name(), parameters: parameters}, nil, secretResolver{}, func(error) {
+		failed = true
+	})
+	if failed {
+		return nil, nil, false
+	}
+
+	rendered := map[string]otel.ComponentMap{}
+	add := func(section string, components otel.ComponentList) bool {
+		if rendered[section] == nil {
+			rendered[section] = otel.ComponentMap{}
+		}
+		for _, c := range components {
+			for id, value := range c {
+				if existing, ok := rendered[section][id]; ok && !importValuesEqual(existing, value) {
+					return false
+				}
+				rendered[section][id] = value
+			}
+		}
+		return true
+	}
+	for _, t := range telemetryTypes {
+		partial := partials[t]
+		if !add(importReceivers, partial.Receivers) || !add(importProcessors, partial.Processors) ||
+			!add(importExporters, partial.Exporters) || !add("extensions", partial.Extensions) {
+			return nil, nil, false
+		}
+	}
+
+	components := rendered[kind]
+	if len(components) != 1 || len(rendered["extensions"]) > 0 {
+		return nil, nil, false
+	}
+	var extra []otel.ComponentID
+	for section, sectionComponents := range rendered {
+		if section == kind {
+			continue
+		}
+		if section != importProcessors && len(sectionComponents) > 0 {
+			return nil, nil, false
+		}
+		extra = append(extra, sortedImportKeys(sectionComponents)...)
+	}
+	if kind == importProcessors && len(extra) > 0 {
+		return nil, nil, false
+	}
+	for id, value := range components {
+		if renderedType, _ := otel.ParseComponentID(id); renderedType != componentType {
+			return nil, nil, false
+		}
+		return value, extra, true
+	}
+	return nil, nil, false
+}
+
+// matchRendered returns true if the rendered configuration matches the component, assigning the values of the
+// placeholders in the rendered configuration to values by parameter index
+func matchRendered(rendered, component any, values map[int]any) bool {
+	if isEmptyImportValue(rendered) && isEmptyImportValue(component) {
+		return true
+	}
+	switch r := rendered.(type) {
+	case map[string]any:
+		c, ok := component.(map[string]any)
+		if !ok || len(c) != len(r) {
+			return false
+		}
+		for key, value := range r {
+			componentValue, ok := c[key]
+			if !ok || !matchRendered(value, componentValue, values) {
+				return false
+			}
+		}
+		return true
+
+	case []any:
+		c, ok := component.([]any)
+		if !ok || len(c) != len(r) {
+			return false
+		}
+		for i := range r {
+			if !matchRendered(r[i], c[i], values) {
+				return false
+			}
+		}
+		return true
+
+	case string:
+		if importSentinelRegexp.MatchString(r) {
+			return matchPlaceholders(r, component, values)
+		}
+	}
+	return importValuesEqual(rendered, component)
+}
+
+// matchPlaceholders matches a rendered string containing placeholders against the component value
+func matchPlaceholders(rendered string, component any, values map[int]any) bool {
+	if match := importSentinelRegexp.FindStringSubmatch(rendered); match[0] == rendered {
+		return assignImportValue(values, match[1], component)
+	}
+	if !isImportScalar(component) {
+		return false
+	}
+
+	// replace each placeholder with a capture group
+	var pattern strings.Builder
+	var indexes []string
+	position := 0
+	for _, match := range importSentinelRegexp.FindAllStringSubmatchIndex(rendered, -1) {
+		pattern.WriteString(regexp.QuoteMeta(rendered[position:match[0]]))
+		pattern.WriteString("(.*?)")
+		indexes = append(indexes, rendered[match[2]:match[3]])
+		position = match[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(rendered[position:]))
+
+	captures := regexp.MustCompile("^" + pattern.String() + "$").FindStringSubmatch(fmt.Sprint(component))
+	if captures == nil {
+		return false
+	}
+	for i, index := range indexes {
+		if !assignImportValue(values, index, captures[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// assignImportValue assigns the value of a placeholder, returning false if a different value is already assigned
+func assignImportValue(values map[int]any, index string, value any) bool {
+	i, _ := strconv.Atoi(index)
+	if existing, ok := values[i]; ok {
+		return importValuesEqual(existing, value)
+	}
+	values[i] = value
+	return true
+}
+
+// importValuesEqual compares rendered and imported configuration. Scalars are compared by their text so that quoted
+// and unquoted values are equal, e.g. "4317" and 4317, and empty components are equal to nil, e.g. batch: and batch: {}.
+func importValuesEqual(a, b any) bool {
+	if isEmptyImportValue(a) && isEmptyImportValue(b) {
+		return true
+	}
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !importValuesEqual(value, other) {
+				return false
+			}
+		}
+		return true
+
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !importValuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return isImportScalar(a) && isImportScalar(b) && fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// normalizeImportValue converts the nested maps of a parsed component to map[string]any so that they can be compared
+// with rendered components. Nested maps are parsed with the type of the enclosing map, e.g. otel.ComponentMap.
+func normalizeImportValue(value any) any {
+	switch v := value.(type) {
+	case []any:
+		for i := range v {
+			v[i] = normalizeImportValue(v[i])
+		}
+		return v
+	case map[string]any:
+		for key := range v {
+			v[key] = normalizeImportValue(v[key])
+		}
+		return v
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return value
+	}
+	result := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		result[iter.Key().String()] = normalizeImportValue(iter.Value().Interface())
+	}
+	return result
+}
+
+func isEmptyImportValue(value any) bool {
+	if value == nil {
+		return true
+	}
+	m, ok := value.(map[string]any)
+	return ok && len(m) == 0
+}
+
+func isImportScalar(value any) bool {
+	switch value.(type) {
+	case string, bool, int, int64, float64:
+		return true
+	}
+	return false
+}
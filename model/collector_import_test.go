@@ -0,0 +1,287 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/stretchr/testify/require"
+)
+
+func testCollectorImportTypes(t *testing.T) CollectorImportTypes {
+	otlpSourceType := NewSourceTypeWithSpec("otlp", ResourceTypeSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "listen_address", Type: "string", Default: "0.0.0.0"},
+			{Name: "grpc_port", Type: "int", Default: 4317},
+			{Name: "enable_http", Type: "bool", Default: true},
+		},
+		LogsMetricsTraces: ResourceTypeOutput{
+			Receivers: `
+- otlp:
+    protocols:
+      grpc:
+        endpoint: {{ .listen_address }}:{{ .grpc_port }}
+      {{- if .enable_http }}
+      http:
+      {{- end }}
+`,
+		},
+	})
+	otlpDestinationType := NewDestinationTypeWithSpec("otlp", ResourceTypeSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "hostname", Type: "string", Required: true},
+			{Name: "port", Type: "int", Default: 4317},
+			{Name: "compression", Type: "enum", Default: "gzip", ValidValues: []string{"none", "gzip"}},
+		},
+		LogsMetricsTraces: ResourceTypeOutput{
+			Processors: `
+- batch:
+`,
+			Exporters: `
+- otlp:
+    endpoint: {{ .hostname }}:{{ .port }}
+    compression: {{ .compression }}
+`,
+		},
+	})
+	return CollectorImportTypes{
+		SourceTypes:      []*SourceType{otlpSourceType},
+		DestinationTypes: []*DestinationType{otlpDestinationType},
+		ProcessorTypes: []*ProcessorType{
+			testResource[*ProcessorType](t, "processortype-custom.yaml"),
+			testResource[*ProcessorType](t, "processortype-resourceattributetransposer.yaml"),
+		},
+	}
+}
+
+func TestImportCollectorConfiguration(t *testing.T) {
+	raw := `
+receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: 127.0.0.1:4317
+  prometheus:
+    config:
+      scrape_configs:
+        - job_name: collector
+processors:
+  resourceattributetransposer:
+    operations:
+      - from: host.name
+        to: hostname
+  batch/large:
+    send_batch_size: 10000
+exporters:
+  otlp/gateway:
+    endpoint: gateway.example.com:4318
+    compression: none
+  logging:
+extensions:
+  health_check:
+service:
+  extensions: [health_check]
+  pipelines:
+    metrics:
+      receivers: [otlp]
+      processors: [resourceattributetransposer, batch/large]
+      exporters: [otlp/gateway]
+    traces:
+      receivers: [otlp]
+      processors: [resourceattributetransposer, batch/large]
+      exporters: [otlp/gateway]
+    metrics/prometheus:
+      receivers: [prometheus]
+      exporters: [logging]
+`
+	result, err := ImportCollectorConfiguration("edge", raw, testCollectorImportTypes(t))
+	require.NoError(t, err)
+
+	require.Len(t, result.Sources, 1)
+	require.Equal(t, "edge-otlp", result.Sources[0].Name())
+	require.Equal(t, "otlp", result.Sources[0].Spec.Type)
+	require.Equal(t, []Parameter{
+		{Name: "listen_address", Value: "127.0.0.1"},
+		{Name: "enable_http", Value: false},
+	}, result.Sources[0].Spec.Parameters)
+
+	require.Len(t, result.Destinations, 1)
+	require.Equal(t, "edge-otlp-gateway", result.Destinations[0].Name())
+	require.Equal(t, []Parameter{
+		{Name: "hostname", Value: "gateway.example.com"},
+		{Name: "port", Value: 4318},
+		{Name: "compression", Value: "none"},
+	}, result.Destinations[0].Spec.Parameters)
+
+	require.Len(t, result.Processors, 2)
+	require.Equal(t, "edge-batch-large", result.Processors[0].Name())
+	require.Equal(t, "custom", result.Processors[0].Spec.Type)
+	require.Equal(t, []Parameter{{Name: "configuration", Value: "batch/large:\n    send_batch_size: 10000\n"}}, result.Processors[0].Spec.Parameters)
+	require.Equal(t, "edge-resourceattributetransposer", result.Processors[1].Name())
+	require.Equal(t, "resource-attribute-transposer", result.Processors[1].Spec.Type)
+	require.Equal(t, []Parameter{
+		{Name: "from", Value: "host.name"},
+		{Name: "to", Value: "hostname"},
+	}, result.Processors[1].Spec.Parameters)
+
+	spec := result.Configuration.Spec
+	require.Equal(t, MatchLabels{"configuration": "edge"}, spec.Selector.MatchLabels)
+	require.Equal(t, []ResourceConfiguration{{Name: "edge-otlp"}}, spec.Sources)
+	require.Equal(t, []ResourceConfiguration{{
+		Name: "edge-otlp-gateway",
+		Processors: []ResourceConfiguration{
+			{Name: "edge-resourceattributetransposer"},
+			{Name: "edge-batch-large"},
+		},
+	}}, spec.Destinations)
+	require.Equal(t, []Route{
+		{Sources: []string{"edge-otlp"}, Destinations: []string{"edge-otlp-gateway"}, TelemetryTypes: []otel.PipelineType{otel.Metrics}},
+		{Sources: []string{"edge-otlp"}, Destinations: []string{"edge-otlp-gateway"}, TelemetryTypes: []otel.PipelineType{otel.Traces}},
+	}, spec.Routes)
+
+	fragment, err := otel.ParseConfiguration(spec.Fragment)
+	require.NoError(t, err)
+	require.Contains(t, fragment.Receivers, otel.ComponentID("prometheus"))
+	require.Contains(t, fragment.Exporters, otel.ComponentID("logging"))
+	require.Contains(t, fragment.Extensions, otel.ComponentID("health_check"))
+	require.Equal(t, []otel.ComponentID{"health_check"}, fragment.Service.Extensions)
+	require.Len(t, fragment.Service.Pipelines, 1)
+	require.Contains(t, fragment.Service.Pipelines, "metrics/prometheus")
+	require.NoError(t, result.Configuration.Validate())
+
+	require.Equal(t, []ImportedComponent{
+		{Kind: "receiver", ID: "otlp", Status: ImportStatusMatched, ResourceType: "otlp", Resource: "edge-otlp"},
+		{Kind: "receiver", ID: "prometheus", Status: ImportStatusFragment, Note: "no matching source type"},
+		{Kind: "processor", ID: "batch/large", Status: ImportStatusCustom, ResourceType: "custom", Resource: "edge-batch-large"},
+		{Kind: "processor", ID: "resourceattributetransposer", Status: ImportStatusMatched, ResourceType: "resource-attribute-transposer", Resource: "edge-resourceattributetransposer"},
+		{Kind: "exporter", ID: "logging", Status: ImportStatusFragment, Note: "no matching destination type"},
+		{Kind: "exporter", ID: "otlp/gateway", Status: ImportStatusMatched, ResourceType: "otlp", Resource: "edge-otlp-gateway", Note: "otlp type also adds processors batch"},
+		{Kind: "extension", ID: "health_check", Status: ImportStatusFragment},
+	}, result.Components)
+
+	require.Len(t, result.Resources(), 5)
+}
+
+func TestImportCollectorConfigurationSharedComponents(t *testing.T) {
+	// the otlp receiver is also used by a pipeline with an exporter that cannot be imported, so both pipelines are kept
+	// in the fragment
+	raw := `
+receivers:
+  otlp:
+    protocols:
+      grpc:
+        endpoint: 0.0.0.0:4317
+      http:
+exporters:
+  otlp:
+    endpoint: gateway:4317
+    compression: gzip
+  logging:
+service:
+  pipelines:
+    metrics:
+      receivers: [otlp]
+      exporters: [otlp]
+    logs:
+      receivers: [otlp]
+      exporters: [logging]
+`
+	result, err := ImportCollectorConfiguration("shared", raw, testCollectorImportTypes(t))
+	require.NoError(t, err)
+	require.Empty(t, result.Sources)
+	require.Empty(t, result.Destinations)
+	require.Empty(t, result.Configuration.Spec.Routes)
+
+	fragment, err := otel.ParseConfiguration(result.Configuration.Spec.Fragment)
+	require.NoError(t, err)
+	require.Len(t, fragment.Service.Pipelines, 2)
+
+	require.Equal(t, ImportedComponent{Kind: "receiver", ID: "otlp", Status: ImportStatusFragment, Note: "used by pipelines that cannot be imported"}, result.Components[0])
+	require.Equal(t, ImportedComponent{Kind: "exporter", ID: "otlp", Status: ImportStatusFragment, Note: "used by pipelines that cannot be imported"}, result.Components[2])
+}
+
+func TestImportCollectorConfigurationErrors(t *testing.T) {
+	types := testCollectorImportTypes(t)
+
+	_, err := ImportCollectorConfiguration("bad", "receivers: [", types)
+	require.ErrorContains(t, err, "unable to parse collector configuration")
+
+	_, err = ImportCollectorConfiguration("empty", "receivers:\n  otlp:\n", types)
+	require.EqualError(t, err, "collector configuration has no pipelines")
+
+	_, err = ImportCollectorConfiguration("invalid", "service:\n  pipelines:\n    metrics:\n      receivers: [otlp]\n      exporters: [logging]\n", types)
+	require.ErrorContains(t, err, "invalid collector configuration")
+}
+
+func TestMatchRendered(t *testing.T) {
+	tests := []struct {
+		name     string
+		rendered any
+		imported any
+		match    bool
+		values   map[int]any
+	}{
+		{
+			name:     "whole value",
+			rendered: map[string]any{"port": "__bindplane_import_0__"},
+			imported: map[string]any{"port": 4317},
+			match:    true,
+			values:   map[int]any{0: 4317},
+		},
+		{
+			name:     "embedded values",
+			rendered: "__bindplane_import_0__:__bindplane_import_1__",
+			imported: "localhost:4317",
+			match:    true,
+			values:   map[int]any{0: "localhost", 1: "4317"},
+		},
+		{
+			name:     "inconsistent values",
+			rendered: []any{"__bindplane_import_0__", "__bindplane_import_0__"},
+			imported: []any{"a", "b"},
+			match:    false,
+			values:   map[int]any{0: "a"},
+		},
+		{
+			name:     "empty component",
+			rendered: map[string]any{"batch": nil},
+			imported: map[string]any{"batch": map[string]any{}},
+			match:    true,
+			values:   map[int]any{},
+		},
+		{
+			name:     "quoted scalar",
+			rendered: map[string]any{"port": "4317"},
+			imported: map[string]any{"port": 4317},
+			match:    true,
+			values:   map[int]any{},
+		},
+		{
+			name:     "missing key",
+			rendered: map[string]any{"endpoint": "localhost"},
+			imported: map[string]any{"endpoint": "localhost", "tls": map[string]any{"insecure": true}},
+			match:    false,
+			values:   map[int]any{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values := map[int]any{}
+			require.Equal(t, test.match, matchRendered(test.rendered, test.imported, values))
+			require.Equal(t, test.values, values)
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/observiq/bindplane-op/model/otel"
 	"github.com/observiq/bindplane-op/model/validation"
 	otelExt "go.opentelemetry.io/otel"
+	"golang.org/x/exp/slices"
 	"gopkg.in/yaml.v3"
 )
 
@@ -90,15 +91,52 @@ type ConfigurationSpec struct {
 	Raw          string                  `json:"raw,omitempty" yaml:"raw,omitempty" mapstructure:"raw"`
 	Sources      []ResourceConfiguration `json:"sources,omitempty" yaml:"sources,omitempty" mapstructure:"sources"`
 	Destinations []ResourceConfiguration `json:"destinations,omitempty" yaml:"destinations,omitempty" mapstructure:"destinations"`
+	Processors   []ResourceConfiguration `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+	Routes       []Route                 `json:"routes,omitempty" yaml:"routes,omitempty" mapstructure:"routes"`
 	Selector     AgentSelector           `json:"selector" yaml:"selector" mapstructure:"selector"`
+	Overrides    []ParameterOverride     `json:"overrides,omitempty" yaml:"overrides,omitempty" mapstructure:"overrides"`
+
+	// Fragment is raw OpenTelemetry configuration with receivers, processors, exporters, extensions, and pipelines that
+	// are merged into the configuration generated from the sources and destinations. Pipelines in the fragment can
+	// reference generated components by their ids. Fragments are not inherited by configurations that extend this one.
+	Fragment string `json:"fragment,omitempty" yaml:"fragment,omitempty" mapstructure:"fragment"`
+
+	// Extends lists the names of base configurations whose sources, destinations, processors, routes, and overrides are
+	// inherited by this configuration
+	Extends []string `json:"extends,omitempty" yaml:"extends,omitempty" mapstructure:"extends"`
+	// Remove lists the inherited sources, destinations, and processors that are not included in this configuration
+	Remove *ConfigurationRemovals `json:"remove,omitempty" yaml:"remove,omitempty" mapstructure:"remove"`
+	// Replace replaces the values of parameters of inherited sources and destinations
+	Replace []InheritedParameters `json:"replace,omitempty" yaml:"replace,omitempty" mapstructure:"replace"`
 }
 
+// Route connects sources to destinations in a modular configuration. Sources and destinations are referenced by name
+// or, for inline sources and destinations without a name, by their position in the configuration, e.g. source0 or
+// destination1. If TelemetryTypes is empty, the route carries logs, metrics, and traces. If a configuration has no
+// Routes, every source is connected to every destination.
+type Route struct {
+	Sources        []string            `json:"sources" yaml:"sources" mapstructure:"sources"`
+	Destinations   []string            `json:"destinations" yaml:"destinations" mapstructure:"destinations"`
+	TelemetryTypes []otel.PipelineType `json:"telemetryTypes,omitempty" yaml:"telemetryTypes,omitempty" mapstructure:"telemetryTypes"`
+}
+
+// pipelineTypes returns the types of telemetry carried by the route
+func (r *Route) pipelineTypes() []otel.PipelineType {
+	if len(r.TelemetryTypes) == 0 {
+		return allPipelineTypes
+	}
+	return r.TelemetryTypes
+}
+
+var allPipelineTypes = []otel.PipelineType{otel.Logs, otel.Metrics, otel.Traces}
+
 // ResourceConfiguration represents a source or destination configuration
 type ResourceConfiguration struct {
-	Name       string                  `json:"name,omitempty" yaml:"name,omitempty" mapstructure:"name"`
-	Type       string                  `json:"type,omitempty" yaml:"type,omitempty" mapstructure:"type"`
-	Parameters []Parameter             `json:"parameters,omitempty" yaml:"parameters,omitempty" mapstructure:"parameters"`
-	Processors []ResourceConfiguration `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+	Name        string                  `json:"name,omitempty" yaml:"name,omitempty" mapstructure:"name"`
+	Type        string                  `json:"type,omitempty" yaml:"type,omitempty" mapstructure:"type"`
+	Parameters  []Parameter             `json:"parameters,omitempty" yaml:"parameters,omitempty" mapstructure:"parameters"`
+	Processors  []ResourceConfiguration `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+	FlowControl *FlowControl            `json:"flowControl,omitempty" yaml:"flowControl,omitempty" mapstructure:"flowControl"`
 }
 
 // Validate validates most of the configuration, but if a store is available, ValidateWithStore should be used to
@@ -121,11 +159,68 @@ func (c *Configuration) ValidateWithStore(store ResourceStore) error {
 	errors := validation.NewErrors()
 
 	c.validate(errors)
-	c.Spec.validateSourcesAndDestinations(errors, store)
+
+	resolved, err := c.Resolve(store)
+	if err != nil {
+		errors.Add(err)
+		return errors.Result()
+	}
+	// routes and overrides are validated with the inherited sources and destinations but inherited routes and overrides
+	// have already been validated with their configurations
+	own := resolved.Spec
+	own.Routes, own.Overrides = c.Spec.Routes, c.Spec.Overrides
+	if len(c.Spec.Extends) > 0 {
+		own.validateRoutes(errors)
+		own.validateOverrides(errors)
+	}
+	resolved.Spec.validateSourcesAndDestinations(errors, store)
+	own.validateOverrideParameters(errors, store)
+	resolved.validatePlatforms(errors, store)
+	if errors.Result() == nil {
+		resolved.validateFragment(errors, store)
+	}
 
 	return errors.Result()
 }
 
+// validateFragment ensures that the fragment does not conflict with the generated configuration and that the pipelines
+// of the merged configuration are valid. It is only checked once the rest of the configuration is valid.
+func (c *Configuration) validateFragment(errors validation.Errors, store ResourceStore) {
+	if c.Spec.Fragment == "" {
+		return
+	}
+	if _, err := c.otelConfiguration(nil, store); err != nil {
+		errors.Add(err)
+	}
+}
+
+// validatePlatforms ensures that the source types of the configuration support the platforms of the agents selected by
+// the configuration. It is only checked if the store provides AgentPlatforms.
+func (c *Configuration) validatePlatforms(errors validation.Errors, store ResourceStore) {
+	agentPlatforms := storeAgentPlatforms(store)
+	if agentPlatforms == nil || len(c.Spec.Sources) == 0 {
+		return
+	}
+	platforms, err := agentPlatforms(c.AgentSelector())
+	if err != nil {
+		errors.Add(fmt.Errorf("unable to find the platforms of the selected agents: %w", err))
+		return
+	}
+	for i, source := range c.Spec.Sources {
+		source := source
+		src, srcType, err := findSourceAndType(&source, fmt.Sprintf("source%d", i), store)
+		if err != nil {
+			// reported by validateSourcesAndDestinations
+			continue
+		}
+		for _, platform := range platforms {
+			if !srcType.Spec.SupportsPlatform(platform) {
+				errors.Add(fmt.Errorf("%s %s does not support platform %s of the selected agents", KindSourceType, src.Spec.Type, platform))
+			}
+		}
+	}
+}
+
 // Type returns the ConfigurationType. It is based on the presence of the Raw, Sources, and Destinations fields.
 func (c *Configuration) Type() ConfigurationType {
 	if c.Spec.Raw != "" {
@@ -147,16 +242,83 @@ func (c *Configuration) IsForAgent(agent *Agent) bool {
 
 // ResourceStore provides access to resources required to render configurations that use Sources and Destinations.
 type ResourceStore interface {
+	Configuration(name string) (*Configuration, error)
 	Source(name string) (*Source, error)
 	SourceType(name string) (*SourceType, error)
 	Processor(name string) (*Processor, error)
 	ProcessorType(name string) (*ProcessorType, error)
 	Destination(name string) (*Destination, error)
 	DestinationType(name string) (*DestinationType, error)
+	ConfigurationTemplate(name string) (*ConfigurationTemplate, error)
+	ConfigurationInstances() ([]*ConfigurationInstance, error)
+}
+
+// WithSecretKeyring returns a ResourceStore that provides the keyring used to decrypt secret parameters when rendering
+// configurations for agents. Configurations rendered with any other ResourceStore contain redacted secrets.
+func WithSecretKeyring(store ResourceStore, keyring *SecretKeyring) ResourceStore {
+	result := newContextResourceStore(store)
+	result.secrets.keyring = keyring
+	return result
+}
+
+// WithSecretProviders returns a ResourceStore that provides the SecretProviders used to validate and resolve secret
+// references like ${bindplane:env:NAME} in secret parameters. Configurations rendered with any other ResourceStore
+// contain the secret references unresolved.
+func WithSecretProviders(store ResourceStore, providers SecretProviders) ResourceStore {
+	result := newContextResourceStore(store)
+	result.secrets.providers = providers
+	return result
+}
+
+// AgentPlatforms returns the platforms of the agents that match the selector
+type AgentPlatforms func(selector Selector) ([]string, error)
+
+// WithAgentPlatforms returns a ResourceStore that provides the platforms of the agents selected by a configuration so
+// that validation can ensure that the source types of the configuration support them. Configurations validated with
+// any other ResourceStore are not checked against the platforms of agents.
+func WithAgentPlatforms(store ResourceStore, agentPlatforms AgentPlatforms) ResourceStore {
+	result := newContextResourceStore(store)
+	result.agentPlatforms = agentPlatforms
+	return result
+}
+
+// contextResourceStore is a ResourceStore with additional context used to validate and render configurations
+type contextResourceStore struct {
+	ResourceStore
+	secrets        secretResolver
+	agentPlatforms AgentPlatforms
+}
+
+func newContextResourceStore(store ResourceStore) *contextResourceStore {
+	if s, ok := store.(*contextResourceStore); ok {
+		result := *s
+		return &result
+	}
+	return &contextResourceStore{ResourceStore: store}
 }
 
-// Render converts the Configuration model to a configuration that can be sent to an agent
-func (c *Configuration) Render(ctx context.Context, store ResourceStore) (string, error) {
+// storeSecrets returns the secretResolver provided by WithSecretKeyring and WithSecretProviders. If the store does not
+// provide one, secrets are redacted and secret references are not resolved.
+func storeSecrets(store ResourceStore) secretResolver {
+	if s, ok := store.(*contextResourceStore); ok {
+		return s.secrets
+	}
+	return secretResolver{}
+}
+
+// storeAgentPlatforms returns the AgentPlatforms provided by WithAgentPlatforms or nil if the store does not provide
+// them
+func storeAgentPlatforms(store ResourceStore) AgentPlatforms {
+	if s, ok := store.(*contextResourceStore); ok {
+		return s.agentPlatforms
+	}
+	return nil
+}
+
+// Render converts the Configuration model to a configuration that can be sent to the agent. Resource types can override
+// their templates for the platform of the agent and templates and parameter values can reference the agent as .agent.
+// If the agent is nil, the default templates are used and the fields of .agent are empty.
+func (c *Configuration) Render(ctx context.Context, agent *Agent, store ResourceStore) (string, error) {
 	ctx, span := tracer.Start(ctx, "model/Configuration/Render")
 	defer span.End()
 
@@ -164,68 +326,157 @@ func (c *Configuration) Render(ctx context.Context, store ResourceStore) (string
 		// we always prefer raw
 		return c.Spec.Raw, nil
 	}
-	return c.renderComponents(store)
+	return c.renderComponents(agent, store)
 }
 
-func (c *Configuration) renderComponents(store ResourceStore) (string, error) {
-	configuration, err := c.otelConfiguration(store)
+func (c *Configuration) renderComponents(agent *Agent, store ResourceStore) (string, error) {
+	configuration, err := c.otelConfiguration(agent, store)
 	if err != nil {
 		return "", err
 	}
 	return configuration.YAML()
 }
 
-func (c *Configuration) otelConfiguration(store ResourceStore) (*otel.Configuration, error) {
+func (c *Configuration) otelConfiguration(agent *Agent, store ResourceStore) (*otel.Configuration, error) {
+	// include the components inherited from base configurations before evaluating them
+	c, err := c.Resolve(store)
+	if err != nil {
+		return nil, err
+	}
+	configuration, err := c.componentsConfiguration(agent, store)
+	if err != nil || c.Spec.Fragment == "" {
+		return configuration, err
+	}
+	return c.mergeFragment(configuration)
+}
+
+// mergeFragment merges the fragment into the configuration generated from the sources and destinations and validates
+// the result
+func (c *Configuration) mergeFragment(configuration *otel.Configuration) (*otel.Configuration, error) {
+	fragment, err := otel.ParseConfiguration(c.Spec.Fragment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse spec.fragment as yaml: %w", err)
+	}
+	if configuration == nil {
+		configuration = otel.NewConfiguration()
+	}
+	if err := configuration.Merge(fragment); err != nil {
+		return nil, fmt.Errorf("unable to merge spec.fragment: %w", err)
+	}
+	if err := configuration.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration with spec.fragment: %w", err)
+	}
+	return configuration, nil
+}
+
+// componentsConfiguration generates the configuration from the sources and destinations of a resolved configuration
+func (c *Configuration) componentsConfiguration(agent *Agent, store ResourceStore) (*otel.Configuration, error) {
 	if len(c.Spec.Sources) == 0 || len(c.Spec.Destinations) == 0 {
 		return nil, nil
 	}
 
 	configuration := otel.NewConfiguration()
 
-	// match each source with each destination to produce a pipeline
-	sources, destinations, err := c.evalComponents(store)
+	sources, destinations, err := c.evalComponents(agent, store)
 	if err != nil {
 		return nil, err
 	}
 
-	for sourceName, source := range sources {
-		for destinationName, destination := range destinations {
-			name := fmt.Sprintf("%s__%s", sourceName, destinationName)
-			configuration.AddPipeline(name, otel.Logs, source, destination)
-			configuration.AddPipeline(name, otel.Metrics, source, destination)
-			configuration.AddPipeline(name, otel.Traces, source, destination)
+	if len(c.Spec.Routes) == 0 {
+		// match each source with each destination to produce a pipeline
+		for _, source := range sources {
+			for _, destination := range destinations {
+				addPipelines(configuration, source, destination, allPipelineTypes)
+			}
+		}
+		return configuration, nil
+	}
+
+	// only produce the pipelines specified by the routes
+	for _, route := range c.Spec.Routes {
+		for _, sourceName := range route.Sources {
+			source, ok := sources[sourceName]
+			if !ok {
+				return nil, fmt.Errorf("route references unknown source: %s", sourceName)
+			}
+			for _, destinationName := range route.Destinations {
+				destination, ok := destinations[destinationName]
+				if !ok {
+					return nil, fmt.Errorf("route references unknown destination: %s", destinationName)
+				}
+				addPipelines(configuration, source, destination, route.pipelineTypes())
+			}
 		}
 	}
 
 	return configuration, nil
 }
 
-func (c *Configuration) evalComponents(store ResourceStore) (sources map[string]otel.Partials, destinations map[string]otel.Partials, err error) {
+// pipelinePartials are the partial configurations of a source or destination along with the name used to identify
+// them in the names of the pipelines
+type pipelinePartials struct {
+	pipelineName string
+	partials     otel.Partials
+}
+
+func addPipelines(configuration *otel.Configuration, source, destination pipelinePartials, pipelineTypes []otel.PipelineType) {
+	name := fmt.Sprintf("%s__%s", source.pipelineName, destination.pipelineName)
+	for _, pipelineType := range pipelineTypes {
+		configuration.AddPipeline(name, pipelineType, source.partials, destination.partials)
+	}
+}
+
+// evalComponents evaluates the sources and destinations of the configuration. The results are keyed by the name used
+// to reference each source and destination in Routes.
+func (c *Configuration) evalComponents(agent *Agent, store ResourceStore) (sources map[string]pipelinePartials, destinations map[string]pipelinePartials, err error) {
 	errorHandler := func(e error) {
 		if e != nil {
 			err = multierror.Append(err, e)
 		}
 	}
 
-	sources = map[string]otel.Partials{}
-	destinations = map[string]otel.Partials{}
+	sources = map[string]pipelinePartials{}
+	destinations = map[string]pipelinePartials{}
+
+	// configuration processors apply to all pipelines and are rendered after the source processors and before the
+	// destination processors
+	processors := otel.NewPartials()
+	processorNames := inlineProcessorNames(configurationProcessorScope, c.Spec.Processors)
+	for i, processor := range c.Spec.Processors {
+		processor := processor // copy to local variable to securely pass a reference to a loop variable
+		_, processorParts := evalProcessor(&processor, processorNames[i], agent, store, errorHandler)
+		if processorParts == nil {
+			continue
+		}
+		processors.Add(processorParts)
+	}
 
 	for i, source := range c.Spec.Sources {
 		source := source // copy to local variable to securely pass a reference to a loop variable
-		sourceName, srcParts := evalSource(&source, fmt.Sprintf("source%d", i), store, errorHandler)
-		sources[sourceName] = srcParts
+		defaultName := fmt.Sprintf("source%d", i)
+		source.Parameters = c.Spec.overrideParameters(KindSource, source.routeName(defaultName), source.Parameters, agent)
+		sourceName, srcParts := evalSource(&source, defaultName, agent, store, errorHandler)
+		sources[source.routeName(defaultName)] = pipelinePartials{sourceName, srcParts}
 	}
 
 	for i, destination := range c.Spec.Destinations {
 		destination := destination // copy to local variable to securely pass a reference to a loop variable
-		destName, destParts := evalDestination(&destination, fmt.Sprintf("destination%d", i), store, errorHandler)
-		destinations[destName] = destParts
+		defaultName := fmt.Sprintf("destination%d", i)
+		destination.Parameters = c.Spec.overrideParameters(KindDestination, destination.routeName(defaultName), destination.Parameters, agent)
+		destName, destParts := evalDestination(&destination, defaultName, agent, store, errorHandler)
+		if destParts == nil {
+			continue
+		}
+		partials := otel.NewPartials()
+		partials.Add(processors)
+		partials.Add(destParts)
+		destinations[destination.routeName(defaultName)] = pipelinePartials{destName, partials}
 	}
 
 	return sources, destinations, err
 }
 
-func evalSource(source *ResourceConfiguration, defaultName string, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
+func evalSource(source *ResourceConfiguration, defaultName string, agent *Agent, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
 	src, srcType, err := findSourceAndType(source, defaultName, store)
 	if err != nil {
 		errorHandler(err)
@@ -233,39 +484,81 @@ func evalSource(source *ResourceConfiguration, defaultName string, store Resourc
 	}
 
 	srcName := fmt.Sprintf("%s__%s", src.Spec.Type, src.Name())
-	partials := srcType.eval(src, errorHandler)
+	partials := srcType.eval(src, agent, storeSecrets(store), errorHandler)
 
 	// evaluate the processors associated with the source
 	for i, processor := range source.Processors {
 		processor := processor
-		_, processorParts := evalProcessor(&processor, fmt.Sprintf("%s__processor%d", srcName, i), store, errorHandler)
+		_, processorParts := evalProcessor(&processor, fmt.Sprintf("%s__processor%d", srcName, i), agent, store, errorHandler)
 		if processorParts == nil {
 			continue
 		}
 		partials.Add(processorParts)
 	}
 
+	// flow control is applied to the telemetry leaving the source
+	partials.Add(source.FlowControl.partials(src.Spec.Type, src.Name()))
+
 	return srcName, partials
 }
 
-func evalProcessor(processor *ResourceConfiguration, defaultName string, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
+func evalProcessor(processor *ResourceConfiguration, defaultName string, agent *Agent, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
 	prc, prcType, err := findProcessorAndType(processor, defaultName, store)
 	if err != nil {
 		errorHandler(err)
 		return "", nil
 	}
 
-	return prc.Name(), prcType.eval(prc, errorHandler)
+	return prc.Name(), prcType.eval(prc, agent, storeSecrets(store), errorHandler)
 }
 
-func evalDestination(destination *ResourceConfiguration, defaultName string, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
+func evalDestination(destination *ResourceConfiguration, defaultName string, agent *Agent, store ResourceStore, errorHandler TemplateErrorHandler) (string, otel.Partials) {
 	dest, destType, err := findDestinationAndType(destination, defaultName, store)
 	if err != nil {
 		errorHandler(err)
 		return "", nil
 	}
 
-	return dest.Name(), destType.eval(dest, errorHandler)
+	destName := dest.Name()
+
+	// flow control is applied to the telemetry entering the destination
+	partials := destination.FlowControl.partials(dest.Spec.Type, destName)
+
+	// evaluate the processors associated with the destination. they are rendered before the processors of the
+	// destination type, which typically end with a batch processor.
+	processorNames := inlineProcessorNames(fmt.Sprintf("%s__%s", dest.Spec.Type, destName), destination.Processors)
+	for i, processor := range destination.Processors {
+		processor := processor
+		_, processorParts := evalProcessor(&processor, processorNames[i], agent, store, errorHandler)
+		if processorParts == nil {
+			continue
+		}
+		partials.Add(processorParts)
+	}
+	partials.Add(destType.eval(dest, agent, storeSecrets(store), errorHandler))
+
+	return destName, partials
+}
+
+// configurationProcessorScope is used in the names of inline processors that apply to all pipelines of a configuration
+const configurationProcessorScope = "configuration"
+
+// inlineProcessorNames returns the names given to inline processors, which are used in their component IDs along with
+// the processor type. The names are derived from the scope rather than the position of the processor so that the
+// component IDs do not change when processors of other types are added, removed, or reordered. Additional inline
+// processors of the same type are numbered, e.g. configuration and configuration1.
+func inlineProcessorNames(scope string, processors []ResourceConfiguration) []string {
+	names := make([]string, len(processors))
+	counts := map[string]int{}
+	for i, processor := range processors {
+		name := scope
+		if count := counts[processor.Type]; count > 0 {
+			name = fmt.Sprintf("%s%d", name, count)
+		}
+		counts[processor.Type]++
+		names[i] = name
+	}
+	return names
 }
 
 func findSourceAndType(source *ResourceConfiguration, defaultName string, store ResourceStore) (*Source, *SourceType, error) {
@@ -348,6 +641,15 @@ func findResourceAndType(resourceKind Kind, resource *ResourceConfiguration, def
 func (cs *ConfigurationSpec) validate(errors validation.Errors) {
 	cs.validateSpecFields(errors)
 	cs.validateRaw(errors)
+	cs.validateFragment(errors)
+	cs.validateExtends(errors)
+	if len(cs.Extends) == 0 {
+		// routes and overrides of configurations that extend other configurations can reference inherited sources and
+		// destinations and are validated with the store after the configuration is resolved
+		cs.validateRoutes(errors)
+		cs.validateOverrides(errors)
+	}
+	cs.validateFlowControls(errors)
 	cs.Selector.validate(errors)
 }
 
@@ -356,6 +658,70 @@ func (cs *ConfigurationSpec) validateSpecFields(errors validation.Errors) {
 		if len(cs.Destinations) > 0 || len(cs.Sources) > 0 {
 			errors.Add(fmt.Errorf("configuration must specify raw or sources and destinations"))
 		}
+		if len(cs.Processors) > 0 {
+			errors.Add(fmt.Errorf("configuration with raw cannot specify processors"))
+		}
+		if cs.Fragment != "" {
+			errors.Add(fmt.Errorf("configuration with raw cannot specify a fragment"))
+		}
+	}
+}
+
+func (cs *ConfigurationSpec) validateRoutes(errors validation.Errors) {
+	// sources and destinations are identified by their route names in the pipelines, so each may only be included once
+	sourceNames := map[string]bool{}
+	for i, source := range cs.Sources {
+		name := source.routeName(fmt.Sprintf("source%d", i))
+		if sourceNames[name] {
+			errors.Add(fmt.Errorf("source %s is included more than once", name))
+		}
+		sourceNames[name] = true
+	}
+	destinationNames := map[string]bool{}
+	for i, destination := range cs.Destinations {
+		name := destination.routeName(fmt.Sprintf("destination%d", i))
+		if destinationNames[name] {
+			errors.Add(fmt.Errorf("destination %s is included more than once", name))
+		}
+		destinationNames[name] = true
+	}
+
+	if len(cs.Routes) == 0 {
+		return
+	}
+	if cs.Raw != "" {
+		errors.Add(fmt.Errorf("configuration with raw cannot specify routes"))
+		return
+	}
+
+	for i, route := range cs.Routes {
+		if len(route.Sources) == 0 || len(route.Destinations) == 0 {
+			errors.Add(fmt.Errorf("route %d must specify at least one source and one destination", i))
+		}
+		for _, name := range route.Sources {
+			if !sourceNames[name] {
+				errors.Add(fmt.Errorf("route %d references unknown source: %s", i, name))
+			}
+		}
+		for _, name := range route.Destinations {
+			if !destinationNames[name] {
+				errors.Add(fmt.Errorf("route %d references unknown destination: %s", i, name))
+			}
+		}
+		for _, telemetryType := range route.TelemetryTypes {
+			if !slices.Contains(allPipelineTypes, telemetryType) {
+				errors.Add(fmt.Errorf("route %d has invalid telemetry type %s, must be one of %v", i, telemetryType, allPipelineTypes))
+			}
+		}
+	}
+}
+
+func (cs *ConfigurationSpec) validateFlowControls(errors validation.Errors) {
+	for i, source := range cs.Sources {
+		source.FlowControl.validate(KindSource, source.routeName(fmt.Sprintf("source%d", i)), errors)
+	}
+	for i, destination := range cs.Destinations {
+		destination.FlowControl.validate(KindDestination, destination.routeName(fmt.Sprintf("destination%d", i)), errors)
 	}
 }
 
@@ -370,6 +736,15 @@ func (cs *ConfigurationSpec) validateRaw(errors validation.Errors) {
 	}
 }
 
+func (cs *ConfigurationSpec) validateFragment(errors validation.Errors) {
+	if cs.Fragment == "" {
+		return
+	}
+	if _, err := otel.ParseConfiguration(cs.Fragment); err != nil {
+		errors.Add(fmt.Errorf("unable to parse spec.fragment as yaml: %w", err))
+	}
+}
+
 func (cs *ConfigurationSpec) validateSourcesAndDestinations(errors validation.Errors, store ResourceStore) {
 	for _, source := range cs.Sources {
 		source.validate(KindSource, errors, store)
@@ -377,6 +752,18 @@ func (cs *ConfigurationSpec) validateSourcesAndDestinations(errors validation.Er
 	for _, destination := range cs.Destinations {
 		destination.validate(KindDestination, errors, store)
 	}
+	for _, processor := range cs.Processors {
+		processor.validate(KindProcessor, errors, store)
+	}
+}
+
+// routeName returns the name used to reference this source or destination in a Route. This is the name of the
+// resource or the default name if the resource is inline.
+func (rc *ResourceConfiguration) routeName(defaultName string) string {
+	if rc.Name != "" {
+		return rc.Name
+	}
+	return defaultName
 }
 
 func (rc *ResourceConfiguration) validate(resourceKind Kind, errors validation.Errors, store ResourceStore) {
@@ -407,6 +794,10 @@ func (rc *ResourceConfiguration) validateParameters(resourceKind Kind, errors va
 		errors.Add(err)
 		return
 	}
+	values := map[string]any{}
+	for _, parameter := range rc.Parameters {
+		values[parameter.Name] = parameter.Value
+	}
 	// ensure parameters are valid
 	for _, parameter := range rc.Parameters {
 		if parameter.Name == "" {
@@ -417,7 +808,27 @@ func (rc *ResourceConfiguration) validateParameters(resourceKind Kind, errors va
 			errors.Add(fmt.Errorf("parameter %s not defined in type %s", parameter.Name, resourceType.Name()))
 			continue
 		}
-		err := def.validateValue(parameter.Value)
+		if referencesAgent(parameter.Value) {
+			// the value is only known once it is rendered for each agent
+			if err := validateAgentReferences(parameter); err != nil {
+				errors.Add(err)
+			}
+			continue
+		}
+		if def.Type == secretType && HasSecretReferences(parameter.Value) {
+			// references are resolved by the server when the configuration is rendered
+			if err := storeSecrets(store).validateReferences(parameter); err != nil {
+				errors.Add(err)
+			}
+			continue
+		}
+		// constraints only apply to relevant parameters because other parameters are not used
+		var err error
+		if resourceType.Spec.isRelevant(def, values) {
+			err = def.validateValue(parameter.Value)
+		} else {
+			err = def.validateValueType(parameterFieldValue, parameter.Value)
+		}
 		if err != nil {
 			errors.Add(err)
 		}
@@ -428,6 +839,9 @@ func (rc *ResourceConfiguration) validateProcessors(resourceKind Kind, errors va
 	for _, processor := range rc.Processors {
 		processor.validate(KindProcessor, errors, store)
 	}
+	if resourceKind == KindProcessor && rc.FlowControl != nil {
+		errors.Add(fmt.Errorf("flowControl is only supported for sources and destinations"))
+	}
 }
 
 // ----------------------------------------------------------------------
@@ -463,11 +877,23 @@ func (c *Configuration) IndexFields(index search.Indexer) {
 	// add source, sourceType fields
 	for _, source := range c.Spec.Sources {
 		source.indexFields("source", "sourceType", index)
+		source.indexProcessorFields(index)
 	}
 
 	// add destination, destinationType fields
 	for _, destination := range c.Spec.Destinations {
 		destination.indexFields("destination", "destinationType", index)
+		destination.indexProcessorFields(index)
+	}
+
+	// add processor, processorType fields
+	for _, processor := range c.Spec.Processors {
+		processor.indexFields("processor", "processorType", index)
+	}
+
+	// add extends fields for the base configurations
+	for _, base := range c.Spec.Extends {
+		index("extends", base)
 	}
 
 	// add pipeline fields
@@ -484,6 +910,12 @@ func (rc *ResourceConfiguration) indexFields(resourceName string, resourceTypeNa
 	index(resourceTypeName, rc.Type)
 }
 
+func (rc *ResourceConfiguration) indexProcessorFields(index search.Indexer) {
+	for _, processor := range rc.Processors {
+		processor.indexFields("processor", "processorType", index)
+	}
+}
+
 // Duplicate copies the value of the current configuration and returns
 // a duplicate with the new name.  It should be identical except for the
 // Metadata.Name, Metadata.ID, and Spec.Selector fields.
@@ -0,0 +1,350 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/observiq/bindplane-op/model/validation"
+	"golang.org/x/exp/slices"
+)
+
+// ConfigurationRemovals lists the sources, destinations, and processors inherited from base configurations that are
+// removed from a configuration that extends them. Inherited sources and destinations are referenced by name or by their
+// position in the inherited list, e.g. source0 or destination1. Inherited configuration processors are referenced by
+// name or by position, e.g. processor0.
+type ConfigurationRemovals struct {
+	Sources      []string `json:"sources,omitempty" yaml:"sources,omitempty" mapstructure:"sources"`
+	Destinations []string `json:"destinations,omitempty" yaml:"destinations,omitempty" mapstructure:"destinations"`
+	Processors   []string `json:"processors,omitempty" yaml:"processors,omitempty" mapstructure:"processors"`
+}
+
+// InheritedParameters replaces the values of parameters of a source or destination inherited from a base
+// configuration. The source or destination is referenced by name or by its position in the inherited list, as in
+// ConfigurationRemovals.
+type InheritedParameters struct {
+	Source      string      `json:"source,omitempty" yaml:"source,omitempty" mapstructure:"source"`
+	Destination string      `json:"destination,omitempty" yaml:"destination,omitempty" mapstructure:"destination"`
+	Parameters  []Parameter `json:"parameters" yaml:"parameters" mapstructure:"parameters"`
+}
+
+// Resolve returns the configuration with the sources, destinations, processors, routes, and overrides inherited from
+// the configurations it extends. Inherited components come first, in the order of Extends, followed by the components
+// of the configuration itself. Inline sources and destinations are referenced in Routes and Overrides by their
+// position in the resolved configuration. A component inherited through more than one base configuration is only
+// included once. If the configuration does not extend any other configurations, it is returned unchanged.
+func (c *Configuration) Resolve(store ResourceStore) (*Configuration, error) {
+	return c.resolve(store, nil)
+}
+
+func (c *Configuration) resolve(store ResourceStore, path []string) (*Configuration, error) {
+	if len(c.Spec.Extends) == 0 {
+		return c, nil
+	}
+	path = append(slices.Clone(path), c.Name())
+
+	inherited := newInheritedComponents()
+	for _, name := range c.Spec.Extends {
+		if slices.Contains(path, name) {
+			return nil, fmt.Errorf("configuration inheritance cycle: %s -> %s", strings.Join(path, " -> "), name)
+		}
+		base, err := store.Configuration(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find base configuration %s: %w", name, err)
+		}
+		if base == nil {
+			return nil, fmt.Errorf("base configuration %s not found", name)
+		}
+		if base.Spec.Raw != "" {
+			return nil, fmt.Errorf("cannot extend raw configuration %s", name)
+		}
+		resolvedBase, err := base.resolve(store, path)
+		if err != nil {
+			return nil, err
+		}
+		inherited.add(&resolvedBase.Spec)
+	}
+
+	if err := inherited.remove(c.Spec.Remove); err != nil {
+		return nil, err
+	}
+	if err := inherited.replace(c.Spec.Replace); err != nil {
+		return nil, err
+	}
+
+	resolved := *c
+	resolved.Spec = inherited.spec(&c.Spec)
+	return &resolved, nil
+}
+
+// inheritedComponents collects the components of the base configurations. Routes and overrides of the base
+// configurations reference components by their index so that they can be renamed once the position of each component
+// in the resolved configuration is known.
+type inheritedComponents struct {
+	sources      inheritedList
+	destinations inheritedList
+	processors   inheritedList
+	routes       []inheritedRoute
+	overrides    []inheritedOverride
+}
+
+type inheritedList struct {
+	items   []ResourceConfiguration
+	removed []bool
+	prefix  string
+}
+
+type inheritedRoute struct {
+	sources        []int
+	destinations   []int
+	telemetryTypes []otel.PipelineType
+}
+
+type inheritedOverride struct {
+	override ParameterOverride
+	kind     Kind
+	index    int
+}
+
+func newInheritedComponents() *inheritedComponents {
+	return &inheritedComponents{
+		sources:      inheritedList{prefix: "source"},
+		destinations: inheritedList{prefix: "destination"},
+		processors:   inheritedList{prefix: "processor"},
+	}
+}
+
+// add includes the components of a resolved base configuration
+func (ic *inheritedComponents) add(base *ConfigurationSpec) {
+	sources := ic.sources.add(base.Sources)
+	destinations := ic.destinations.add(base.Destinations)
+	ic.processors.add(base.Processors)
+
+	for _, route := range base.Routes {
+		ic.routes = append(ic.routes, inheritedRoute{
+			sources:        routeIndexes(route.Sources, sources),
+			destinations:   routeIndexes(route.Destinations, destinations),
+			telemetryTypes: route.TelemetryTypes,
+		})
+	}
+	for _, override := range base.Overrides {
+		kind, name := override.target()
+		index, ok := sources[name]
+		if kind == KindDestination {
+			index, ok = destinations[name]
+		}
+		if ok {
+			ic.overrides = append(ic.overrides, inheritedOverride{override: override, kind: kind, index: index})
+		}
+	}
+}
+
+// add appends the components that are not already included and returns the index of each component by its name in the
+// base configuration
+func (l *inheritedList) add(components []ResourceConfiguration) map[string]int {
+	names := map[string]int{}
+	for i, component := range components {
+		index := indexEqual(l.items, component)
+		if index < 0 {
+			index = len(l.items)
+			l.items = append(l.items, component)
+			l.removed = append(l.removed, false)
+		}
+		names[component.routeName(fmt.Sprintf("%s%d", l.prefix, i))] = index
+	}
+	return names
+}
+
+// index returns the index of the inherited component with the name or -1 if there is none
+func (l *inheritedList) index(name string) int {
+	for i := range l.items {
+		if l.items[i].routeName(fmt.Sprintf("%s%d", l.prefix, i)) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// names returns the name of each inherited component in the resolved configuration, which has the remaining inherited
+// components followed by the components of the configuration itself
+func (l *inheritedList) names() []string {
+	names := make([]string, len(l.items))
+	position := 0
+	for i := range l.items {
+		if l.removed[i] {
+			continue
+		}
+		names[i] = l.items[i].routeName(fmt.Sprintf("%s%d", l.prefix, position))
+		position++
+	}
+	return names
+}
+
+func (l *inheritedList) remaining(own []ResourceConfiguration) []ResourceConfiguration {
+	var result []ResourceConfiguration
+	for i, item := range l.items {
+		if !l.removed[i] {
+			result = append(result, item)
+		}
+	}
+	return append(result, own...)
+}
+
+func (ic *inheritedComponents) remove(removals *ConfigurationRemovals) error {
+	if removals == nil {
+		return nil
+	}
+	lists := []struct {
+		names []string
+		list  *inheritedList
+	}{
+		{removals.Sources, &ic.sources},
+		{removals.Destinations, &ic.destinations},
+		{removals.Processors, &ic.processors},
+	}
+	for _, l := range lists {
+		for _, name := range l.names {
+			index := l.list.index(name)
+			if index < 0 {
+				return fmt.Errorf("remove references unknown inherited %s: %s", l.list.prefix, name)
+			}
+			l.list.removed[index] = true
+		}
+	}
+	return nil
+}
+
+func (ic *inheritedComponents) replace(replacements []InheritedParameters) error {
+	for _, replacement := range replacements {
+		list, name := &ic.sources, replacement.Source
+		if name == "" {
+			list, name = &ic.destinations, replacement.Destination
+		}
+		index := list.index(name)
+		if index < 0 {
+			return fmt.Errorf("replace references unknown inherited %s: %s", list.prefix, name)
+		}
+		list.items[index].Parameters = mergeParameters(list.items[index].Parameters, replacement.Parameters)
+	}
+	return nil
+}
+
+// spec returns the resolved spec with the inherited components followed by the components of the configuration
+func (ic *inheritedComponents) spec(own *ConfigurationSpec) ConfigurationSpec {
+	sourceNames := ic.sources.names()
+	destinationNames := ic.destinations.names()
+
+	var routes []Route
+	for _, route := range ic.routes {
+		resolved := Route{
+			Sources:        routeNames(route.sources, sourceNames),
+			Destinations:   routeNames(route.destinations, destinationNames),
+			TelemetryTypes: route.telemetryTypes,
+		}
+		// routes to removed sources and destinations are removed and routes inherited more than once are only included once
+		if len(resolved.Sources) > 0 && len(resolved.Destinations) > 0 && !containsEqual(routes, resolved) {
+			routes = append(routes, resolved)
+		}
+	}
+
+	var overrides []ParameterOverride
+	for _, inherited := range ic.overrides {
+		override := inherited.override
+		if inherited.kind == KindSource {
+			override.Source = sourceNames[inherited.index]
+		} else {
+			override.Destination = destinationNames[inherited.index]
+		}
+		if (override.Source != "" || override.Destination != "") && !containsEqual(overrides, override) {
+			overrides = append(overrides, override)
+		}
+	}
+
+	return ConfigurationSpec{
+		ContentType:  own.ContentType,
+		Sources:      ic.sources.remaining(own.Sources),
+		Destinations: ic.destinations.remaining(own.Destinations),
+		Processors:   ic.processors.remaining(own.Processors),
+		Routes:       append(routes, own.Routes...),
+		Selector:     own.Selector,
+		Overrides:    append(overrides, own.Overrides...),
+		Fragment:     own.Fragment,
+	}
+}
+
+// indexEqual returns the index of the first item that is deeply equal to the value or -1 if there is none
+func indexEqual[T any](items []T, value T) int {
+	return slices.IndexFunc(items, func(item T) bool {
+		return reflect.DeepEqual(item, value)
+	})
+}
+
+func containsEqual[T any](items []T, value T) bool {
+	return indexEqual(items, value) >= 0
+}
+
+// routeIndexes returns the index of each name that is in the map
+func routeIndexes(names []string, index map[string]int) []int {
+	var result []int
+	for _, name := range names {
+		if i, ok := index[name]; ok {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// routeNames returns the name of each index, omitting removed components which have no name
+func routeNames(indexes []int, names []string) []string {
+	var result []string
+	for _, i := range indexes {
+		if names[i] != "" {
+			result = append(result, names[i])
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------
+// validation
+
+func (cs *ConfigurationSpec) validateExtends(errors validation.Errors) {
+	if len(cs.Extends) == 0 {
+		if cs.Remove != nil || len(cs.Replace) > 0 {
+			errors.Add(fmt.Errorf("configuration must extend another configuration to specify remove or replace"))
+		}
+		return
+	}
+	if cs.Raw != "" {
+		errors.Add(fmt.Errorf("configuration with raw cannot extend other configurations"))
+		return
+	}
+	for i, name := range cs.Extends {
+		if name == "" {
+			errors.Add(fmt.Errorf("extends %d must specify the name of a configuration", i))
+		}
+	}
+	for i, replacement := range cs.Replace {
+		if (replacement.Source == "") == (replacement.Destination == "") {
+			errors.Add(fmt.Errorf("replace %d must specify either source or destination", i))
+		}
+		if len(replacement.Parameters) == 0 {
+			errors.Add(fmt.Errorf("replace %d must specify at least one parameter", i))
+		}
+	}
+}
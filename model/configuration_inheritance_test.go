@@ -0,0 +1,301 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func testInheritanceStore(t *testing.T) *testResourceStore {
+	store := testAgentTemplateStore(t)
+	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
+	store.sourceTypes[macos.Name()] = macos
+
+	store.configurations["base"] = NewConfigurationWithSpec("base", ConfigurationSpec{
+		Sources: []ResourceConfiguration{{Type: "otlp"}, {Type: "MacOS"}},
+		Destinations: []ResourceConfiguration{
+			{Type: "otlp-agent", Parameters: []Parameter{{Name: "endpoint", Value: "base:4317"}}},
+		},
+		Routes: []Route{
+			{Sources: []string{"source1"}, Destinations: []string{"destination0"}, TelemetryTypes: []otel.PipelineType{otel.Logs}},
+		},
+		Overrides: []ParameterOverride{
+			{AgentID: "1", Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: "one"}}},
+		},
+	})
+	store.configurations["middle"] = NewConfigurationWithSpec("middle", ConfigurationSpec{
+		Extends:      []string{"base"},
+		Destinations: []ResourceConfiguration{{Type: "otlp"}},
+	})
+	return store
+}
+
+func testExtendedConfiguration(spec ConfigurationSpec) *Configuration {
+	return NewConfigurationWithSpec("child", spec)
+}
+
+func TestResolveConfiguration(t *testing.T) {
+	store := testInheritanceStore(t)
+
+	tests := []struct {
+		name        string
+		spec        ConfigurationSpec
+		expect      ConfigurationSpec
+		expectError string
+	}{
+		{
+			name: "not extended",
+			spec: ConfigurationSpec{Sources: []ResourceConfiguration{{Type: "otlp"}}},
+			expect: ConfigurationSpec{
+				Sources: []ResourceConfiguration{{Type: "otlp"}},
+			},
+		},
+		{
+			name: "inherits everything",
+			spec: ConfigurationSpec{
+				Extends:  []string{"base"},
+				Selector: AgentSelector{MatchLabels: MatchLabels{"configuration": "child"}},
+			},
+			expect: ConfigurationSpec{
+				Sources: []ResourceConfiguration{{Type: "otlp"}, {Type: "MacOS"}},
+				Destinations: []ResourceConfiguration{
+					{Type: "otlp-agent", Parameters: []Parameter{{Name: "endpoint", Value: "base:4317"}}},
+				},
+				Routes: []Route{
+					{Sources: []string{"source1"}, Destinations: []string{"destination0"}, TelemetryTypes: []otel.PipelineType{otel.Logs}},
+				},
+				Overrides: []ParameterOverride{
+					{AgentID: "1", Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: "one"}}},
+				},
+				Selector: AgentSelector{MatchLabels: MatchLabels{"configuration": "child"}},
+			},
+		},
+		{
+			name: "additions, removals, and replacements",
+			spec: ConfigurationSpec{
+				Extends: []string{"base"},
+				Sources: []ResourceConfiguration{{Type: "otlp"}},
+				Remove:  &ConfigurationRemovals{Sources: []string{"source0"}},
+				Replace: []InheritedParameters{{Destination: "destination0", Parameters: []Parameter{{Name: "endpoint", Value: "child:4317"}}}},
+				Routes:  []Route{{Sources: []string{"source1"}, Destinations: []string{"destination0"}}},
+			},
+			expect: ConfigurationSpec{
+				// the inherited MacOS source is now source0
+				Sources: []ResourceConfiguration{{Type: "MacOS"}, {Type: "otlp"}},
+				Destinations: []ResourceConfiguration{
+					{Type: "otlp-agent", Parameters: []Parameter{{Name: "endpoint", Value: "child:4317"}}},
+				},
+				Routes: []Route{
+					{Sources: []string{"source0"}, Destinations: []string{"destination0"}, TelemetryTypes: []otel.PipelineType{otel.Logs}},
+					{Sources: []string{"source1"}, Destinations: []string{"destination0"}},
+				},
+				Overrides: []ParameterOverride{
+					{AgentID: "1", Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: "one"}}},
+				},
+			},
+		},
+		{
+			name: "removed destination removes routes and overrides",
+			spec: ConfigurationSpec{
+				Extends:      []string{"base"},
+				Remove:       &ConfigurationRemovals{Destinations: []string{"destination0"}},
+				Destinations: []ResourceConfiguration{{Type: "otlp"}},
+			},
+			expect: ConfigurationSpec{
+				Sources:      []ResourceConfiguration{{Type: "otlp"}, {Type: "MacOS"}},
+				Destinations: []ResourceConfiguration{{Type: "otlp"}},
+			},
+		},
+		{
+			name: "transitive and shared bases",
+			spec: ConfigurationSpec{
+				Extends: []string{"middle", "base"},
+			},
+			expect: ConfigurationSpec{
+				Sources: []ResourceConfiguration{{Type: "otlp"}, {Type: "MacOS"}},
+				Destinations: []ResourceConfiguration{
+					{Type: "otlp-agent", Parameters: []Parameter{{Name: "endpoint", Value: "base:4317"}}},
+					{Type: "otlp"},
+				},
+				Routes: []Route{
+					{Sources: []string{"source1"}, Destinations: []string{"destination0"}, TelemetryTypes: []otel.PipelineType{otel.Logs}},
+				},
+				Overrides: []ParameterOverride{
+					{AgentID: "1", Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: "one"}}},
+				},
+			},
+		},
+		{
+			name:        "missing base",
+			spec:        ConfigurationSpec{Extends: []string{"missing"}},
+			expectError: "base configuration missing not found",
+		},
+		{
+			name:        "unknown removal",
+			spec:        ConfigurationSpec{Extends: []string{"base"}, Remove: &ConfigurationRemovals{Sources: []string{"source5"}}},
+			expectError: "remove references unknown inherited source: source5",
+		},
+		{
+			name:        "unknown replacement",
+			spec:        ConfigurationSpec{Extends: []string{"base"}, Replace: []InheritedParameters{{Destination: "nginx", Parameters: []Parameter{{Name: "a", Value: 1}}}}},
+			expectError: "replace references unknown inherited destination: nginx",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved, err := testExtendedConfiguration(test.spec).Resolve(store)
+			if test.expectError != "" {
+				require.ErrorContains(t, err, test.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expect, resolved.Spec)
+		})
+	}
+
+	// the base configuration is not modified
+	require.Equal(t, "base:4317", store.configurations["base"].Spec.Destinations[0].Parameters[0].Value)
+}
+
+func TestResolveConfigurationCycle(t *testing.T) {
+	store := testInheritanceStore(t)
+	store.configurations["a"] = NewConfigurationWithSpec("a", ConfigurationSpec{Extends: []string{"b"}})
+	store.configurations["b"] = NewConfigurationWithSpec("b", ConfigurationSpec{Extends: []string{"base", "a"}})
+
+	_, err := store.configurations["a"].Resolve(store)
+	require.EqualError(t, err, "configuration inheritance cycle: a -> b -> a")
+
+	self := NewConfigurationWithSpec("self", ConfigurationSpec{Extends: []string{"self"}})
+	store.configurations["self"] = self
+	_, err = self.Resolve(store)
+	require.EqualError(t, err, "configuration inheritance cycle: self -> self")
+
+	require.ErrorContains(t, self.ValidateWithStore(store), "configuration inheritance cycle: self -> self")
+
+	store.configurations["raw"] = NewRawConfiguration("raw", "receivers: {}")
+	_, err = testExtendedConfiguration(ConfigurationSpec{Extends: []string{"raw"}}).Resolve(store)
+	require.EqualError(t, err, "cannot extend raw configuration raw")
+}
+
+func TestRenderExtendedConfiguration(t *testing.T) {
+	store := testInheritanceStore(t)
+	configuration := testExtendedConfiguration(ConfigurationSpec{
+		Extends: []string{"base"},
+		Replace: []InheritedParameters{{Destination: "destination0", Parameters: []Parameter{{Name: "endpoint", Value: "child:4317"}}}},
+	})
+	require.NoError(t, configuration.ValidateWithStore(store))
+	require.True(t, configuration.ReferencesAgent(store))
+
+	result, err := configuration.Render(context.TODO(), &Agent{ID: "1"}, store)
+	require.NoError(t, err)
+	var parsed map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(result), &parsed))
+
+	exporter := parsed["exporters"].(map[string]any)["otlp/otlp-agent__destination0"].(map[string]any)
+	require.Equal(t, "child:4317", exporter["endpoint"])
+	require.Equal(t, map[string]any{"X-Tenant": "one"}, exporter["headers"])
+
+	// only the inherited route is rendered
+	pipelines := parsed["service"].(map[string]any)["pipelines"].(map[string]any)
+	require.Len(t, pipelines, 1)
+	require.Contains(t, pipelines, "logs/MacOS__source1__destination0")
+}
+
+func TestValidateExtendedConfiguration(t *testing.T) {
+	store := testInheritanceStore(t)
+
+	tests := []struct {
+		name        string
+		spec        ConfigurationSpec
+		expectError string
+	}{
+		{
+			name: "valid",
+			spec: ConfigurationSpec{
+				Extends: []string{"base"},
+				Routes:  []Route{{Sources: []string{"source0"}, Destinations: []string{"destination0"}}},
+			},
+		},
+		{
+			name:        "unknown route source",
+			spec:        ConfigurationSpec{Extends: []string{"base"}, Routes: []Route{{Sources: []string{"source2"}, Destinations: []string{"destination0"}}}},
+			expectError: "route 0 references unknown source: source2",
+		},
+		{
+			name:        "invalid replacement value",
+			spec:        ConfigurationSpec{Extends: []string{"base"}, Replace: []InheritedParameters{{Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: 5}}}}},
+			expectError: "parameter value for 'tenant' must be a string",
+		},
+		{
+			name:        "raw",
+			spec:        ConfigurationSpec{Raw: "receivers: {}", Extends: []string{"base"}},
+			expectError: "configuration with raw cannot extend other configurations",
+		},
+		{
+			name:        "remove without extends",
+			spec:        ConfigurationSpec{Remove: &ConfigurationRemovals{Sources: []string{"source0"}}},
+			expectError: "configuration must extend another configuration to specify remove or replace",
+		},
+		{
+			name:        "replace without target",
+			spec:        ConfigurationSpec{Extends: []string{"base"}, Replace: []InheritedParameters{{Parameters: []Parameter{{Name: "a", Value: 1}}}}},
+			expectError: "replace 0 must specify either source or destination",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := testExtendedConfiguration(test.spec).ValidateWithStore(store)
+			if test.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.expectError)
+		})
+	}
+}
+
+func TestReplacementSecrets(t *testing.T) {
+	store := testSecretStore(t)
+	keyring := testSecretKeyring(t, "key")
+	store.configurations["base"] = NewConfigurationWithSpec("base", ConfigurationSpec{
+		Destinations: []ResourceConfiguration{
+			{Type: "observiq-cloud-secret", Parameters: []Parameter{{Name: "secret_key", Value: "shared"}}},
+		},
+	})
+
+	configuration := testExtendedConfiguration(ConfigurationSpec{
+		Extends: []string{"base"},
+		Replace: []InheritedParameters{
+			{Destination: "destination0", Parameters: []Parameter{{Name: "secret_key", Value: "child"}}},
+		},
+	})
+	changed, err := EncryptSecrets(configuration, nil, store, keyring)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	encrypted := configuration.Spec.Replace[0].Parameters[0].Value
+	decrypted, err := keyring.Decrypt(encrypted.(string))
+	require.NoError(t, err)
+	require.Equal(t, "child", decrypted)
+
+	require.Equal(t, RedactedSecret, RedactSecrets(configuration).Spec.Replace[0].Parameters[0].Value)
+}
@@ -0,0 +1,437 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/observiq/bindplane-op/internal/store/search"
+	"github.com/observiq/bindplane-op/model/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigurationTemplateLabel is the label added to Configurations generated by a ConfigurationInstance. Its value is the
+// name of the ConfigurationTemplate used to generate the Configuration.
+const ConfigurationTemplateLabel = "configurationTemplate"
+
+// configurationTemplateDelims are used for the parameters of a ConfigurationTemplate so that {{ }} can still be used in
+// the generated Configuration, e.g. to reference the agent with {{ .agent.HostName }}.
+var configurationTemplateDelims = []string{"[[", "]]"}
+
+// configurationTemplateValueRegexp matches a string that consists of a single parameter reference, e.g. [[ .port ]],
+// which is replaced with the value of the parameter instead of its string representation
+var configurationTemplateValueRegexp = regexp.MustCompile(`^\[\[\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*\]\]$`)
+
+// ConfigurationTemplate is a resource with parameters that is used to generate many similar Configurations, e.g. one
+// for each region or team. The string values in the Configuration of the template, including parameter values, labels
+// of the selector, and raw configurations, can reference the parameters of the template as [[ .name ]].
+type ConfigurationTemplate struct {
+	ResourceMeta `yaml:",inline" json:",inline" mapstructure:",squash"`
+	Spec         ConfigurationTemplateSpec `json:"spec" yaml:"spec" mapstructure:"spec"`
+}
+
+// ConfigurationTemplateSpec is the spec for a ConfigurationTemplate
+type ConfigurationTemplateSpec struct {
+	// Parameters are the parameters that are specified by each ConfigurationInstance
+	Parameters []ParameterDefinition `json:"parameters,omitempty" yaml:"parameters,omitempty" mapstructure:"parameters"`
+
+	// Configuration is the spec of the Configurations generated by the template
+	Configuration ConfigurationSpec `json:"configuration" yaml:"configuration" mapstructure:"configuration"`
+}
+
+// ConfigurationInstance is a resource that generates a Configuration with the same name from a ConfigurationTemplate
+// and the parameter values of the instance. The Configuration is generated again when the instance or the template
+// changes and it is deleted when the instance is deleted.
+type ConfigurationInstance struct {
+	ResourceMeta `yaml:",inline" json:",inline" mapstructure:",squash"`
+	Spec         ConfigurationInstanceSpec `json:"spec" yaml:"spec" mapstructure:"spec"`
+}
+
+// ConfigurationInstanceSpec is the spec for a ConfigurationInstance
+type ConfigurationInstanceSpec struct {
+	// Template is the name of the ConfigurationTemplate
+	Template string `json:"template" yaml:"template" mapstructure:"template"`
+
+	// Parameters are the values of the parameters of the template
+	Parameters []Parameter `json:"parameters,omitempty" yaml:"parameters,omitempty" mapstructure:"parameters"`
+}
+
+var _ Resource = (*ConfigurationTemplate)(nil)
+var _ Resource = (*ConfigurationInstance)(nil)
+
+// NewConfigurationTemplate creates a new ConfigurationTemplate with the specified name and spec
+func NewConfigurationTemplate(name string, spec ConfigurationTemplateSpec) *ConfigurationTemplate {
+	return &ConfigurationTemplate{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindConfigurationTemplate,
+			Metadata: Metadata{
+				Name:   name,
+				Labels: MakeLabels(),
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// NewConfigurationInstance creates a new ConfigurationInstance with the specified name, template, and parameters
+func NewConfigurationInstance(name string, templateName string, parameters []Parameter) *ConfigurationInstance {
+	return &ConfigurationInstance{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindConfigurationInstance,
+			Metadata: Metadata{
+				Name:   name,
+				Labels: MakeLabels(),
+			},
+		},
+		Spec: ConfigurationInstanceSpec{
+			Template:   templateName,
+			Parameters: parameters,
+		},
+	}
+}
+
+// GetKind returns "ConfigurationTemplate"
+func (t *ConfigurationTemplate) GetKind() Kind { return KindConfigurationTemplate }
+
+// GetKind returns "ConfigurationInstance"
+func (i *ConfigurationInstance) GetKind() Kind { return KindConfigurationInstance }
+
+// ParameterDefinition returns the definition of the parameter with the name or nil if there is none
+func (t *ConfigurationTemplate) ParameterDefinition(name string) *ParameterDefinition {
+	for i, p := range t.Spec.Parameters {
+		if p.Name == name {
+			return &t.Spec.Parameters[i]
+		}
+	}
+	return nil
+}
+
+// Generate returns the Configuration generated from the template and the parameter values of the instance. The
+// Configuration has the name and labels of the instance and the ConfigurationTemplateLabel. It has the description of
+// the instance or, if the instance has no description, the description of the template.
+func (i *ConfigurationInstance) Generate(t *ConfigurationTemplate) (*Configuration, error) {
+	values := map[string]any{}
+	for _, p := range t.Spec.Parameters {
+		values[p.Name] = p.Default
+	}
+	for _, p := range i.Spec.Parameters {
+		values[p.Name] = p.Value
+	}
+
+	spec, err := t.Spec.generate(values)
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := NewConfigurationWithSpec(i.Name(), spec)
+	configuration.Metadata.Description = i.Metadata.Description
+	if configuration.Metadata.Description == "" {
+		configuration.Metadata.Description = t.Metadata.Description
+	}
+	labels := map[string]string{}
+	for name, value := range i.Metadata.Labels.Set {
+		labels[name] = value
+	}
+	labels[ConfigurationTemplateLabel] = t.Name()
+	configuration.Metadata.Labels = LabelsFromValidatedMap(labels)
+	return configuration, nil
+}
+
+// generate renders the parameter references in the configuration spec. The spec is converted to YAML values so that
+// each string can be rendered separately and the result never depends on the YAML syntax of the parameter values.
+func (s *ConfigurationTemplateSpec) generate(values map[string]any) (ConfigurationSpec, error) {
+	var result ConfigurationSpec
+
+	bytes, err := yaml.Marshal(s.Configuration)
+	if err != nil {
+		return result, err
+	}
+	var spec any
+	if err := yaml.Unmarshal(bytes, &spec); err != nil {
+		return result, err
+	}
+	spec, err = generateValue(spec, values)
+	if err != nil {
+		return result, err
+	}
+	bytes, err = yaml.Marshal(spec)
+	if err != nil {
+		return result, err
+	}
+	if err := yaml.Unmarshal(bytes, &result); err != nil {
+		return result, fmt.Errorf("unable to generate configuration: %w", err)
+	}
+	return result, nil
+}
+
+func generateValue(value any, values map[string]any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return generateString(v, values)
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			generated, err := generateValue(item, values)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = generated
+		}
+		return result, nil
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			generated, err := generateValue(item, values)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = generated
+		}
+		return result, nil
+	}
+	return value, nil
+}
+
+func generateString(value string, values map[string]any) (any, error) {
+	// a string with only a parameter reference is replaced with the value to keep its type, e.g. [[ .port ]] => 8080
+	if match := configurationTemplateValueRegexp.FindStringSubmatch(value); match != nil {
+		if v, ok := values[match[1]]; ok {
+			return v, nil
+		}
+	}
+	if !strings.Contains(value, configurationTemplateDelims[0]) {
+		return value, nil
+	}
+	t, err := template.New("configuration").
+		Delims(configurationTemplateDelims[0], configurationTemplateDelims[1]).
+		Option("missingkey=error").
+		Funcs(template.FuncMap(parameterTemplateFuncs)).
+		Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	var writer bytes.Buffer
+	if err := t.Execute(&writer, values); err != nil {
+		return nil, err
+	}
+	return writer.String(), nil
+}
+
+// ----------------------------------------------------------------------
+// validation
+
+// Validate checks that the template is valid, returning an error if it is not
+func (t *ConfigurationTemplate) Validate() error {
+	errors := validation.NewErrors()
+	t.validate(errors)
+	return errors.Result()
+}
+
+// ValidateWithStore checks that the template is valid, returning an error if it is not. The existing instances of the
+// template are validated with the template so that an update to the template cannot invalidate their configurations.
+func (t *ConfigurationTemplate) ValidateWithStore(store ResourceStore) error {
+	errors := validation.NewErrors()
+	t.validate(errors)
+	if errors.Result() != nil {
+		return errors.Result()
+	}
+
+	instances, err := store.ConfigurationInstances()
+	if err != nil {
+		errors.Add(err)
+		return errors.Result()
+	}
+	for _, instance := range instances {
+		if instance.Spec.Template != t.Name() {
+			continue
+		}
+		instanceErrors := validation.NewErrors()
+		instance.validateWithTemplate(instanceErrors, store, t)
+		if err := instanceErrors.Result(); err != nil {
+			errors.Add(fmt.Errorf("configuration instance %s is invalid with the template: %w", instance.Name(), err))
+		}
+	}
+	return errors.Result()
+}
+
+func (t *ConfigurationTemplate) validate(errors validation.Errors) {
+	t.ResourceMeta.validate(errors)
+
+	names := map[string]bool{}
+	for _, parameter := range t.Spec.Parameters {
+		if names[parameter.Name] {
+			errors.Add(fmt.Errorf("parameter '%s' is defined more than once", parameter.Name))
+		}
+		names[parameter.Name] = true
+		parameter.validateDefinition(errors)
+	}
+
+	// generating with the default values ensures that the references are valid
+	values := map[string]any{}
+	for _, parameter := range t.Spec.Parameters {
+		values[parameter.Name] = parameter.Default
+	}
+	if _, err := t.Spec.generate(values); err != nil {
+		errors.Add(fmt.Errorf("configuration: %w", err))
+	}
+}
+
+// Validate checks that the instance is valid, returning an error if it is not. ValidateWithStore must be used to
+// validate the parameters and the generated configuration.
+func (i *ConfigurationInstance) Validate() error {
+	errors := validation.NewErrors()
+	i.validate(errors)
+	return errors.Result()
+}
+
+func (i *ConfigurationInstance) validate(errors validation.Errors) {
+	i.ResourceMeta.validate(errors)
+	if i.Spec.Template == "" {
+		errors.Add(fmt.Errorf("configuration instance must specify a template"))
+	}
+	for _, parameter := range i.Spec.Parameters {
+		if parameter.Name == "" {
+			errors.Add(fmt.Errorf("all configuration instance parameters must have a name"))
+		}
+	}
+}
+
+// ValidateWithStore checks that the instance is valid, returning an error if it is not. It uses the store to retrieve
+// the template so that the parameter values and the generated configuration can be validated.
+func (i *ConfigurationInstance) ValidateWithStore(store ResourceStore) error {
+	errors := validation.NewErrors()
+
+	i.validate(errors)
+	if i.Spec.Template == "" {
+		return errors.Result()
+	}
+
+	t, err := store.ConfigurationTemplate(i.Spec.Template)
+	if err != nil {
+		errors.Add(err)
+		return errors.Result()
+	}
+	if t == nil {
+		errors.Add(fmt.Errorf("unknown %s: %s", KindConfigurationTemplate, i.Spec.Template))
+		return errors.Result()
+	}
+
+	i.validateWithTemplate(errors, store, t)
+	return errors.Result()
+}
+
+// validateWithTemplate validates the parameter values of the instance and the configuration it generates from the
+// template
+func (i *ConfigurationInstance) validateWithTemplate(errors validation.Errors, store ResourceStore, t *ConfigurationTemplate) {
+	i.validateParameters(errors, t)
+	i.validateConfigurationName(errors, store)
+	if errors.Result() != nil {
+		return
+	}
+
+	configuration, err := i.Generate(t)
+	if err != nil {
+		errors.Add(err)
+		return
+	}
+	if err := configuration.ValidateWithStore(store); err != nil {
+		errors.Add(fmt.Errorf("generated configuration is invalid: %w", err))
+	}
+}
+
+func (i *ConfigurationInstance) validateParameters(errors validation.Errors, t *ConfigurationTemplate) {
+	values := map[string]bool{}
+	for _, parameter := range i.Spec.Parameters {
+		values[parameter.Name] = true
+		def := t.ParameterDefinition(parameter.Name)
+		if def == nil {
+			errors.Add(fmt.Errorf("parameter %s not defined in template %s", parameter.Name, t.Name()))
+			continue
+		}
+		if err := def.validateValue(parameter.Value); err != nil {
+			errors.Add(err)
+		}
+	}
+	for _, def := range t.Spec.Parameters {
+		if def.Required && !values[def.Name] {
+			errors.Add(fmt.Errorf("parameter %s is required by template %s", def.Name, t.Name()))
+		}
+	}
+}
+
+// validateConfigurationName ensures that the instance does not replace a Configuration that was not generated from a
+// template
+func (i *ConfigurationInstance) validateConfigurationName(errors validation.Errors, store ResourceStore) {
+	existing, err := store.Configuration(i.Name())
+	if err != nil {
+		errors.Add(err)
+		return
+	}
+	if existing != nil && existing.Metadata.Labels.Get(ConfigurationTemplateLabel) == "" {
+		errors.Add(fmt.Errorf("configuration %s already exists and was not generated from a template", i.Name()))
+	}
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (t *ConfigurationTemplate) PrintableFieldTitles() []string {
+	return []string{"Name", "Parameters", "Description"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (t *ConfigurationTemplate) PrintableFieldValue(title string) string {
+	switch title {
+	case "Parameters":
+		return fmt.Sprintf("%d", len(t.Spec.Parameters))
+	case "Description":
+		return t.Metadata.Description
+	default:
+		return t.ResourceMeta.PrintableFieldValue(title)
+	}
+}
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (i *ConfigurationInstance) PrintableFieldTitles() []string {
+	return []string{"Name", "Template", "Description"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (i *ConfigurationInstance) PrintableFieldValue(title string) string {
+	switch title {
+	case "Template":
+		return i.Spec.Template
+	case "Description":
+		return i.Metadata.Description
+	default:
+		return i.ResourceMeta.PrintableFieldValue(title)
+	}
+}
+
+// ----------------------------------------------------------------------
+// Indexed
+
+// IndexFields returns a map of field name to field value to be stored in the index
+func (i *ConfigurationInstance) IndexFields(index search.Indexer) {
+	i.ResourceMeta.IndexFields(index)
+	index("template", i.Spec.Template)
+}
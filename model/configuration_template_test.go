@@ -0,0 +1,173 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testConfigurationTemplateStore(t *testing.T) (*testResourceStore, *ConfigurationTemplate) {
+	store := testAgentTemplateStore(t)
+	template := testResource[*ConfigurationTemplate](t, "configurationtemplate-region.yaml")
+	store.configurationTemplates[template.Name()] = template
+	return store, template
+}
+
+func TestGenerateConfiguration(t *testing.T) {
+	store, template := testConfigurationTemplateStore(t)
+	instance := testResource[*ConfigurationInstance](t, "configurationinstance-us-east.yaml")
+	require.NoError(t, template.Validate())
+	require.NoError(t, instance.ValidateWithStore(store))
+
+	configuration, err := instance.Generate(template)
+	require.NoError(t, err)
+
+	require.Equal(t, "us-east", configuration.Name())
+	require.Equal(t, "Configuration for each region", configuration.Metadata.Description)
+	require.Equal(t, map[string]string{"team": "platform", ConfigurationTemplateLabel: "region"}, configuration.Metadata.Labels.AsMap())
+	require.Equal(t, MatchLabels{"region": "us-east"}, configuration.Spec.Selector.MatchLabels)
+	require.Equal(t, []Parameter{
+		{Name: "endpoint", Value: "collector.us-east.example.com:4317"},
+		{Name: "tenant", Value: "us-east-{{ .agent.HostName }}"},
+	}, configuration.Spec.Destinations[0].Parameters)
+	require.True(t, configuration.ReferencesAgent(store))
+
+	// generating does not modify the template
+	require.Equal(t, "collector.[[ .region ]].example.com:[[ .port ]]", template.Spec.Configuration.Destinations[0].Parameters[0].Value)
+}
+
+func TestGenerateValue(t *testing.T) {
+	values := map[string]any{"port": 4317, "hosts": []any{"a", "b"}, "name": "east"}
+	tests := []struct {
+		value  any
+		expect any
+	}{
+		{"[[ .port ]]", 4317},
+		{"[[.hosts]]", []any{"a", "b"}},
+		{"port-[[ .port ]]", "port-4317"},
+		{"[[ .name | upper ]]", "EAST"},
+		{"{{ .agent.Name }}", "{{ .agent.Name }}"},
+		{[]any{"[[ .name ]]", 5}, []any{"east", 5}},
+		{map[string]any{"key": "[[ .name ]]"}, map[string]any{"key": "east"}},
+	}
+	for _, test := range tests {
+		result, err := generateValue(test.value, values)
+		require.NoError(t, err)
+		require.Equal(t, test.expect, result, "%v", test.value)
+	}
+
+	_, err := generateValue("[[ .missing ]]", values)
+	require.Error(t, err)
+}
+
+func TestValidateConfigurationTemplate(t *testing.T) {
+	_, template := testConfigurationTemplateStore(t)
+	require.NoError(t, template.Validate())
+
+	duplicate := NewConfigurationTemplate("duplicate", ConfigurationTemplateSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "region", Type: "string"},
+			{Name: "region", Type: "string"},
+		},
+	})
+	require.ErrorContains(t, duplicate.Validate(), "parameter 'region' is defined more than once")
+
+	unknown := NewConfigurationTemplate("unknown", ConfigurationTemplateSpec{
+		Configuration: ConfigurationSpec{
+			Selector: AgentSelector{MatchLabels: MatchLabels{"region": "[[ .region ]]"}},
+		},
+	})
+	require.ErrorContains(t, unknown.Validate(), "configuration: ")
+}
+
+func TestValidateConfigurationTemplateWithInstances(t *testing.T) {
+	store, template := testConfigurationTemplateStore(t)
+	store.configurationInstances["west"] = NewConfigurationInstance("west", "region", []Parameter{{Name: "region", Value: "us-west"}})
+	store.configurationInstances["other"] = NewConfigurationInstance("other", "other", nil)
+	require.NoError(t, template.ValidateWithStore(store))
+
+	required := testResource[*ConfigurationTemplate](t, "configurationtemplate-region.yaml")
+	required.Spec.Parameters = append(required.Spec.Parameters, ParameterDefinition{Name: "zone", Type: "string", Required: true})
+	require.ErrorContains(t, required.ValidateWithStore(store), "configuration instance west is invalid with the template")
+
+	removed := testResource[*ConfigurationTemplate](t, "configurationtemplate-region.yaml")
+	removed.Spec.Parameters = removed.Spec.Parameters[1:]
+	removed.Spec.Configuration.Selector.MatchLabels = MatchLabels{"region": "west"}
+	removed.Spec.Configuration.Destinations[0].Parameters = nil
+	require.ErrorContains(t, removed.ValidateWithStore(store), "parameter region not defined in template region")
+}
+
+func TestValidateConfigurationInstance(t *testing.T) {
+	store, _ := testConfigurationTemplateStore(t)
+	store.configurations["existing"] = NewConfiguration("existing")
+
+	tests := []struct {
+		name        string
+		instance    *ConfigurationInstance
+		expectError string
+	}{
+		{
+			name:     "valid",
+			instance: NewConfigurationInstance("west", "region", []Parameter{{Name: "region", Value: "us-west"}, {Name: "port", Value: 4318}}),
+		},
+		{
+			name:        "missing template",
+			instance:    NewConfigurationInstance("west", "", nil),
+			expectError: "configuration instance must specify a template",
+		},
+		{
+			name:        "unknown template",
+			instance:    NewConfigurationInstance("west", "unknown", nil),
+			expectError: "unknown ConfigurationTemplate: unknown",
+		},
+		{
+			name:        "missing required parameter",
+			instance:    NewConfigurationInstance("west", "region", nil),
+			expectError: "parameter region is required by template region",
+		},
+		{
+			name:        "unknown parameter",
+			instance:    NewConfigurationInstance("west", "region", []Parameter{{Name: "region", Value: "us-west"}, {Name: "zone", Value: "a"}}),
+			expectError: "parameter zone not defined in template region",
+		},
+		{
+			name:        "invalid parameter value",
+			instance:    NewConfigurationInstance("west", "region", []Parameter{{Name: "region", Value: "us-west"}, {Name: "port", Value: "port"}}),
+			expectError: "parameter value for 'port' must be an integer",
+		},
+		{
+			name:        "invalid generated configuration",
+			instance:    NewConfigurationInstance("west", "region", []Parameter{{Name: "region", Value: "us west"}}),
+			expectError: "generated configuration is invalid",
+		},
+		{
+			name:        "existing configuration",
+			instance:    NewConfigurationInstance("existing", "region", []Parameter{{Name: "region", Value: "us-west"}}),
+			expectError: "configuration existing already exists and was not generated from a template",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.instance.ValidateWithStore(store)
+			if test.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.expectError)
+		})
+	}
+}
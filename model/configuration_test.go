@@ -16,6 +16,7 @@ package model
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -45,27 +46,38 @@ func fileResource[T Resource](t *testing.T, path string) T {
 }
 
 type testResourceStore struct {
+	configurations   map[string]*Configuration
 	sources          map[string]*Source
 	sourceTypes      map[string]*SourceType
 	processors       map[string]*Processor
 	processorTypes   map[string]*ProcessorType
 	destinations     map[string]*Destination
 	destinationTypes map[string]*DestinationType
+
+	configurationTemplates map[string]*ConfigurationTemplate
+	configurationInstances map[string]*ConfigurationInstance
 }
 
 func newTestResourceStore() *testResourceStore {
 	return &testResourceStore{
+		configurations:   map[string]*Configuration{},
 		sources:          map[string]*Source{},
 		sourceTypes:      map[string]*SourceType{},
 		processors:       map[string]*Processor{},
 		processorTypes:   map[string]*ProcessorType{},
 		destinations:     map[string]*Destination{},
 		destinationTypes: map[string]*DestinationType{},
+
+		configurationTemplates: map[string]*ConfigurationTemplate{},
+		configurationInstances: map[string]*ConfigurationInstance{},
 	}
 }
 
 var _ ResourceStore = (*testResourceStore)(nil)
 
+func (s *testResourceStore) Configuration(name string) (*Configuration, error) {
+	return s.configurations[name], nil
+}
 func (s *testResourceStore) Source(name string) (*Source, error) {
 	return s.sources[name], nil
 }
@@ -84,6 +96,16 @@ func (s *testResourceStore) Destination(name string) (*Destination, error) {
 func (s *testResourceStore) DestinationType(name string) (*DestinationType, error) {
 	return s.destinationTypes[name], nil
 }
+func (s *testResourceStore) ConfigurationTemplate(name string) (*ConfigurationTemplate, error) {
+	return s.configurationTemplates[name], nil
+}
+func (s *testResourceStore) ConfigurationInstances() ([]*ConfigurationInstance, error) {
+	instances := []*ConfigurationInstance{}
+	for _, instance := range s.configurationInstances {
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
 
 func TestParseConfiguration(t *testing.T) {
 	path := filepath.Join("testfiles", "configuration-raw.yaml")
@@ -109,7 +131,7 @@ func TestEvalConfiguration(t *testing.T) {
 	store.destinationTypes[cabinType.Name()] = cabinType
 
 	configuration := testResource[*Configuration](t, "configuration-macos-sources.yaml")
-	result, err := configuration.Render(context.TODO(), store)
+	result, err := configuration.Render(context.TODO(), nil, store)
 	require.NoError(t, err)
 
 	expect := strings.TrimLeft(`
@@ -188,7 +210,7 @@ func TestEvalConfiguration2(t *testing.T) {
 	store.destinationTypes[googleCloudType.Name()] = googleCloudType
 
 	configuration := testResource[*Configuration](t, "configuration-macos-googlecloud.yaml")
-	result, err := configuration.Render(context.TODO(), store)
+	result, err := configuration.Render(context.TODO(), nil, store)
 	require.NoError(t, err)
 
 	expect := strings.TrimLeft(`
@@ -289,7 +311,7 @@ func TestEvalConfiguration3(t *testing.T) {
 	store.destinationTypes[googleCloudType.Name()] = googleCloudType
 
 	configuration := testResource[*Configuration](t, "configuration-otlp.yaml")
-	result, err := configuration.Render(context.TODO(), store)
+	result, err := configuration.Render(context.TODO(), nil, store)
 	require.NoError(t, err)
 
 	expect := strings.TrimLeft(`
@@ -341,7 +363,7 @@ func TestEvalConfiguration4(t *testing.T) {
 	store.destinationTypes[googleCloudType.Name()] = googleCloudType
 
 	configuration := testResource[*Configuration](t, "configuration-postgresql-googlecloud.yaml")
-	result, err := configuration.Render(context.TODO(), store)
+	result, err := configuration.Render(context.TODO(), nil, store)
 	require.NoError(t, err)
 
 	expect := strings.TrimLeft(`
@@ -399,7 +421,7 @@ func TestEvalConfiguration5(t *testing.T) {
 	store.processorTypes[resourceAttributeTransposerType.Name()] = resourceAttributeTransposerType
 
 	configuration := testResource[*Configuration](t, "configuration-macos-processors.yaml")
-	result, err := configuration.Render(context.TODO(), store)
+	result, err := configuration.Render(context.TODO(), nil, store)
 	require.NoError(t, err)
 
 	expect := strings.TrimLeft(`
@@ -465,6 +487,251 @@ service:
 	require.Equal(t, expect, result)
 }
 
+func TestEvalConfigurationRoutes(t *testing.T) {
+	store := newTestResourceStore()
+
+	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
+	store.sourceTypes[macos.Name()] = macos
+
+	googleCloudType := testResource[*DestinationType](t, "destinationtype-googlecloud.yaml")
+	store.destinationTypes[googleCloudType.Name()] = googleCloudType
+
+	configuration := testResource[*Configuration](t, "configuration-macos-routes.yaml")
+	require.NoError(t, configuration.ValidateWithStore(store))
+
+	result, err := configuration.Render(context.TODO(), nil, store)
+	require.NoError(t, err)
+
+	expect := strings.TrimLeft(`
+receivers:
+    hostmetrics/MacOS__source0:
+        collection_interval: 1m
+        scrapers:
+            load: null
+    hostmetrics/MacOS__source1:
+        collection_interval: 1m
+        scrapers:
+            load: null
+    plugin/MacOS__source1__journald:
+        plugin:
+            name: journald
+    plugin/MacOS__source1__macos:
+        parameters:
+            - name: enable_system_log
+              value: true
+            - name: system_log_path
+              value: /var/log/system.log
+            - name: enable_install_log
+              value: true
+            - name: install_log_path
+              value: /var/log/install.log
+            - name: start_at
+              value: end
+        plugin:
+            name: macos
+processors:
+    batch/googlecloud__destination0: null
+    normalizesums/googlecloud__destination0: null
+exporters:
+    googlecloud/googlecloud__destination0: null
+service:
+    pipelines:
+        logs/MacOS__source1__destination0:
+            receivers:
+                - plugin/MacOS__source1__macos
+                - plugin/MacOS__source1__journald
+            processors:
+                - batch/googlecloud__destination0
+            exporters:
+                - googlecloud/googlecloud__destination0
+        metrics/MacOS__source0__destination0:
+            receivers:
+                - hostmetrics/MacOS__source0
+            processors:
+                - normalizesums/googlecloud__destination0
+                - batch/googlecloud__destination0
+            exporters:
+                - googlecloud/googlecloud__destination0
+        metrics/MacOS__source1__destination0:
+            receivers:
+                - hostmetrics/MacOS__source1
+            processors:
+                - normalizesums/googlecloud__destination0
+                - batch/googlecloud__destination0
+            exporters:
+                - googlecloud/googlecloud__destination0
+`, "\n")
+
+	require.Equal(t, expect, result)
+}
+
+func TestEvalConfigurationDestinationProcessors(t *testing.T) {
+	store := newTestResourceStore()
+
+	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
+	store.sourceTypes[macos.Name()] = macos
+
+	googleCloudType := testResource[*DestinationType](t, "destinationtype-googlecloud.yaml")
+	store.destinationTypes[googleCloudType.Name()] = googleCloudType
+
+	googleCloud := testResource[*Destination](t, "destination-googlecloud.yaml")
+	store.destinations[googleCloud.Name()] = googleCloud
+
+	resourceAttributeTransposerType := testResource[*ProcessorType](t, "processortype-resourceattributetransposer.yaml")
+	store.processorTypes[resourceAttributeTransposerType.Name()] = resourceAttributeTransposerType
+
+	configuration := testResource[*Configuration](t, "configuration-macos-destination-processors.yaml")
+	require.NoError(t, configuration.ValidateWithStore(store))
+
+	result, err := configuration.Render(context.TODO(), nil, store)
+	require.NoError(t, err)
+
+	expect := strings.TrimLeft(`
+receivers:
+    hostmetrics/MacOS__source0:
+        collection_interval: 1m
+        scrapers:
+            load: null
+    plugin/MacOS__source0__journald:
+        plugin:
+            name: journald
+    plugin/MacOS__source0__macos:
+        parameters:
+            - name: enable_system_log
+              value: false
+            - name: system_log_path
+              value: /var/log/system.log
+            - name: enable_install_log
+              value: true
+            - name: install_log_path
+              value: /var/log/install.log
+            - name: start_at
+              value: end
+        plugin:
+            name: macos
+processors:
+    batch/googlecloud__googlecloud: null
+    normalizesums/googlecloud__googlecloud: null
+    resourceattributetransposer/resource-attribute-transposer__MacOS__source0__processor0:
+        operations:
+            - from: from.source
+              to: to.source
+    resourceattributetransposer/resource-attribute-transposer__configuration:
+        operations:
+            - from: from.configuration
+              to: to.configuration
+    resourceattributetransposer/resource-attribute-transposer__googlecloud__googlecloud:
+        operations:
+            - from: from.destination
+              to: to.destination
+exporters:
+    googlecloud/googlecloud__googlecloud: null
+service:
+    pipelines:
+        logs/MacOS__source0__googlecloud:
+            receivers:
+                - plugin/MacOS__source0__macos
+                - plugin/MacOS__source0__journald
+            processors:
+                - resourceattributetransposer/resource-attribute-transposer__MacOS__source0__processor0
+                - resourceattributetransposer/resource-attribute-transposer__configuration
+                - resourceattributetransposer/resource-attribute-transposer__googlecloud__googlecloud
+                - batch/googlecloud__googlecloud
+            exporters:
+                - googlecloud/googlecloud__googlecloud
+        metrics/MacOS__source0__googlecloud:
+            receivers:
+                - hostmetrics/MacOS__source0
+            processors:
+                - resourceattributetransposer/resource-attribute-transposer__MacOS__source0__processor0
+                - resourceattributetransposer/resource-attribute-transposer__configuration
+                - resourceattributetransposer/resource-attribute-transposer__googlecloud__googlecloud
+                - normalizesums/googlecloud__googlecloud
+                - batch/googlecloud__googlecloud
+            exporters:
+                - googlecloud/googlecloud__googlecloud
+`, "\n")
+
+	require.Equal(t, expect, result)
+}
+
+func TestEvalConfigurationFlowControl(t *testing.T) {
+	store := newTestResourceStore()
+
+	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
+	store.sourceTypes[macos.Name()] = macos
+
+	googleCloudType := testResource[*DestinationType](t, "destinationtype-googlecloud.yaml")
+	store.destinationTypes[googleCloudType.Name()] = googleCloudType
+
+	googleCloud := testResource[*Destination](t, "destination-googlecloud.yaml")
+	store.destinations[googleCloud.Name()] = googleCloud
+
+	configuration := testResource[*Configuration](t, "configuration-macos-flow-control.yaml")
+	require.NoError(t, configuration.ValidateWithStore(store))
+
+	result, err := configuration.Render(context.TODO(), nil, store)
+	require.NoError(t, err)
+
+	expect := strings.TrimLeft(`
+receivers:
+    hostmetrics/MacOS__source0:
+        collection_interval: 1m
+        scrapers:
+            load: null
+    plugin/MacOS__source0__journald:
+        plugin:
+            name: journald
+    plugin/MacOS__source0__macos:
+        parameters:
+            - name: enable_system_log
+              value: false
+            - name: system_log_path
+              value: /var/log/system.log
+            - name: enable_install_log
+              value: true
+            - name: install_log_path
+              value: /var/log/install.log
+            - name: start_at
+              value: end
+        plugin:
+            name: macos
+processors:
+    batch/googlecloud__googlecloud: null
+    normalizesums/googlecloud__googlecloud: null
+    sampling/MacOS__source0__flow_control:
+        drop_ratio: 0.75
+    sampling/googlecloud__googlecloud__flow_control:
+        drop_ratio: 1
+exporters:
+    googlecloud/googlecloud__googlecloud: null
+service:
+    pipelines:
+        logs/MacOS__source0__googlecloud:
+            receivers:
+                - plugin/MacOS__source0__macos
+                - plugin/MacOS__source0__journald
+            processors:
+                - sampling/MacOS__source0__flow_control
+                - sampling/googlecloud__googlecloud__flow_control
+                - batch/googlecloud__googlecloud
+            exporters:
+                - googlecloud/googlecloud__googlecloud
+        metrics/MacOS__source0__googlecloud:
+            receivers:
+                - hostmetrics/MacOS__source0
+            processors:
+                - sampling/MacOS__source0__flow_control
+                - sampling/googlecloud__googlecloud__flow_control
+                - normalizesums/googlecloud__googlecloud
+                - batch/googlecloud__googlecloud
+            exporters:
+                - googlecloud/googlecloud__googlecloud
+`, "\n")
+
+	require.Equal(t, expect, result)
+}
+
 func TestEvalConfigurationFailsMissingResource(t *testing.T) {
 	store := newTestResourceStore()
 
@@ -515,7 +782,7 @@ func TestEvalConfigurationFailsMissingResource(t *testing.T) {
 			// before rendering, delete resources that we reference
 			test.deleteResources()
 
-			_, err := configuration.Render(context.TODO(), store)
+			_, err := configuration.Render(context.TODO(), nil, store)
 			require.Error(t, err)
 			require.Equal(t, test.expectError, err.Error())
 
@@ -528,6 +795,110 @@ func TestEvalConfigurationFailsMissingResource(t *testing.T) {
 	}
 }
 
+func TestValidateConfigurationPlatforms(t *testing.T) {
+	store := newTestResourceStore()
+
+	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
+	store.sourceTypes[macos.Name()] = macos
+
+	googleCloudType := testResource[*DestinationType](t, "destinationtype-googlecloud.yaml")
+	store.destinationTypes[googleCloudType.Name()] = googleCloudType
+
+	googleCloud := testResource[*Destination](t, "destination-googlecloud.yaml")
+	store.destinations[googleCloud.Name()] = googleCloud
+
+	configuration := testResource[*Configuration](t, "configuration-macos-flow-control.yaml")
+
+	var selected Selector
+	withPlatforms := func(platforms ...string) ResourceStore {
+		return WithAgentPlatforms(store, func(selector Selector) ([]string, error) {
+			selected = selector
+			return platforms, nil
+		})
+	}
+
+	// without agent platforms, the platforms are not checked
+	require.NoError(t, configuration.ValidateWithStore(store))
+
+	require.NoError(t, configuration.ValidateWithStore(withPlatforms()))
+	require.Equal(t, configuration.AgentSelector().String(), selected.String())
+
+	require.NoError(t, configuration.ValidateWithStore(withPlatforms("darwin")))
+
+	err := configuration.ValidateWithStore(withPlatforms("darwin", "linux"))
+	require.EqualError(t, err, "1 error occurred:\n\t* SourceType MacOS does not support platform linux of the selected agents\n\n")
+
+	failed := WithAgentPlatforms(store, func(selector Selector) ([]string, error) {
+		return nil, errors.New("store unavailable")
+	})
+	err = configuration.ValidateWithStore(failed)
+	require.EqualError(t, err, "1 error occurred:\n\t* unable to find the platforms of the selected agents: store unavailable\n\n")
+}
+
+func TestEvalConfigurationFragment(t *testing.T) {
+	store := testAgentTemplateStore(t)
+	configuration := testAgentTemplateConfiguration(ResourceConfiguration{Type: "otlp"})
+	configuration.Spec.Fragment = `
+receivers:
+  hostmetrics:
+    collection_interval: 1m
+service:
+  pipelines:
+    metrics/hostmetrics:
+      receivers: [hostmetrics]
+      exporters: [otlp/otlp__destination0]
+`
+	require.NoError(t, configuration.ValidateWithStore(store))
+
+	result, err := configuration.Render(context.TODO(), nil, store)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(result), &parsed))
+	require.Contains(t, parsed["receivers"], "hostmetrics")
+	require.Contains(t, parsed["receivers"], "otlp/otlp__source0")
+	pipelines := parsed["service"].(map[string]any)["pipelines"].(map[string]any)
+	require.Contains(t, pipelines, "metrics/hostmetrics")
+	require.Contains(t, pipelines, "metrics/otlp__source0__destination0")
+}
+
+func TestValidateConfigurationFragment(t *testing.T) {
+	store := testAgentTemplateStore(t)
+
+	tests := []struct {
+		name        string
+		fragment    string
+		expectError string
+	}{
+		{
+			name:        "invalid yaml",
+			fragment:    "receivers: [",
+			expectError: "unable to parse spec.fragment as yaml",
+		},
+		{
+			name:        "conflict",
+			fragment:    "receivers:\n  otlp/otlp__source0: {}\n",
+			expectError: "receiver otlp/otlp__source0 conflicts with a generated receiver",
+		},
+		{
+			name:        "undefined component",
+			fragment:    "service:\n  pipelines:\n    logs/fragment:\n      receivers: [filelog]\n      exporters: [otlp/otlp__destination0]\n",
+			expectError: "pipeline logs/fragment references undefined receiver filelog",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			configuration := testAgentTemplateConfiguration(ResourceConfiguration{Type: "otlp"})
+			configuration.Spec.Fragment = test.fragment
+			require.ErrorContains(t, configuration.ValidateWithStore(store), test.expectError)
+		})
+	}
+
+	raw := NewRawConfiguration("raw", "receivers: {}")
+	raw.Spec.Fragment = "receivers: {}"
+	require.ErrorContains(t, raw.Validate(), "configuration with raw cannot specify a fragment")
+}
+
 func TestDuplicate(t *testing.T) {
 	duplicateName := "duplicate-config"
 
@@ -554,3 +925,16 @@ func TestDuplicate(t *testing.T) {
 		require.Equal(t, new.Spec.Selector.MatchLabels["configuration"], duplicateName)
 	})
 }
+
+func TestInlineProcessorNames(t *testing.T) {
+	processors := []ResourceConfiguration{
+		{Type: "batch"},
+		{Type: "resource-attribute-transposer"},
+		{Type: "batch"},
+	}
+	require.Equal(t, []string{"configuration", "configuration", "configuration1"}, inlineProcessorNames("configuration", processors))
+
+	// reordering processors of different types does not change their names
+	reordered := []ResourceConfiguration{processors[1], processors[0], processors[2]}
+	require.Equal(t, []string{"configuration", "configuration", "configuration1"}, inlineProcessorNames("configuration", reordered))
+}
@@ -106,7 +106,7 @@ func FindDestination(destination *ResourceConfiguration, defaultName string, sto
 
 // PrintableFieldTitles returns the list of field titles, used for printing a table of resources
 func (d *Destination) PrintableFieldTitles() []string {
-	return []string{"Name", "Type", "Description"}
+	return []string{"Name", "Type", "Description", "References"}
 }
 
 // PrintableFieldValue returns the field value for a title, used for printing a table of resources
@@ -120,6 +120,8 @@ func (d *Destination) PrintableFieldValue(title string) string {
 		return d.ResourceTypeName()
 	case "Description":
 		return d.Metadata.Description
+	case "References":
+		return parameterReferences(d.Spec.Parameters)
 	default:
 		return "-"
 	}
@@ -0,0 +1,158 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// FlowControl controls the flow of telemetry through a source or destination of a Configuration. Flow controls are
+// rendered as processors at the boundary between sources and destinations: after the processors of a source and
+// before the processors of a destination.
+type FlowControl struct {
+	// Paused drops all telemetry flowing through the source or destination
+	Paused bool `json:"paused,omitempty" yaml:"paused,omitempty" mapstructure:"paused"`
+
+	// SamplingPercentage is the percentage of telemetry to keep, between 0 and 100. If not specified, all telemetry is
+	// kept.
+	SamplingPercentage *float64 `json:"samplingPercentage,omitempty" yaml:"samplingPercentage,omitempty" mapstructure:"samplingPercentage"`
+}
+
+const (
+	// flowControlSamplingProcessor is the processor used to pause and sample telemetry. It drops the specified ratio of
+	// logs, metrics, and traces.
+	flowControlSamplingProcessor = "sampling"
+
+	// flowControlComponentName is the name used for flow control processors before they are made unique
+	flowControlComponentName = "flow_control"
+)
+
+// IsEmpty returns true if the flow control does not restrict the flow of telemetry
+func (fc *FlowControl) IsEmpty() bool {
+	return fc == nil || (!fc.Paused && fc.SamplingPercentage == nil)
+}
+
+// dropRatio returns the ratio of telemetry to drop, between 0 and 1
+func (fc *FlowControl) dropRatio() float64 {
+	if fc.Paused {
+		return 1
+	}
+	if fc.SamplingPercentage == nil {
+		return 0
+	}
+	return 1 - *fc.SamplingPercentage/100
+}
+
+// partials returns the processor used to implement the flow control for a resource with the specified type and name.
+// The same processor is used for logs, metrics, and traces.
+func (fc *FlowControl) partials(typeName, resourceName string) otel.Partials {
+	partials := otel.NewPartials()
+	if fc.IsEmpty() {
+		return partials
+	}
+
+	processors := otel.ComponentList{}
+	if dropRatio := fc.dropRatio(); dropRatio > 0 {
+		id := otel.UniqueComponentID(fmt.Sprintf("%s/%s", flowControlSamplingProcessor, flowControlComponentName), typeName, resourceName)
+		processors = append(processors, map[otel.ComponentID]any{
+			id: map[string]any{
+				"drop_ratio": dropRatio,
+			},
+		})
+	}
+
+	for _, partial := range partials {
+		partial.Processors = processors
+	}
+	return partials
+}
+
+func (fc *FlowControl) validate(resourceKind Kind, name string, errors validation.Errors) {
+	if fc == nil {
+		return
+	}
+	if fc.SamplingPercentage != nil && (*fc.SamplingPercentage < 0 || *fc.SamplingPercentage > 100) {
+		errors.Add(fmt.Errorf("%s %s flowControl samplingPercentage must be between 0 and 100", resourceKind, name))
+	}
+}
+
+// ----------------------------------------------------------------------
+
+// FlowControl returns the flow control of the source or destination with the specified name. Inline sources and
+// destinations are referenced by their position in the configuration, e.g. source0 or destination1, just as they are
+// in Routes. If the source or destination has no flow control, an empty FlowControl is returned.
+func (c *Configuration) FlowControl(resourceKind Kind, name string) (*FlowControl, error) {
+	_, rc, err := c.Spec.findComponent(resourceKind, name)
+	if err != nil {
+		return nil, err
+	}
+	if rc.FlowControl == nil {
+		return &FlowControl{}, nil
+	}
+	flowControl := *rc.FlowControl
+	return &flowControl, nil
+}
+
+// WithFlowControl returns a copy of the configuration with the flow control of the specified source or destination
+// replaced. The original configuration is not modified.
+func (c *Configuration) WithFlowControl(resourceKind Kind, name string, flowControl *FlowControl) (*Configuration, error) {
+	components, _, err := c.Spec.findComponent(resourceKind, name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *c
+	copied := make([]ResourceConfiguration, len(components))
+	copy(copied, components)
+	switch resourceKind {
+	case KindSource:
+		result.Spec.Sources = copied
+	case KindDestination:
+		result.Spec.Destinations = copied
+	}
+
+	// find the component again in the copy to replace the flow control
+	_, rc, _ := result.Spec.findComponent(resourceKind, name)
+	if flowControl.IsEmpty() {
+		rc.FlowControl = nil
+	} else {
+		fc := *flowControl
+		rc.FlowControl = &fc
+	}
+	return &result, nil
+}
+
+// findComponent returns the list of sources or destinations and the source or destination referenced by the name
+func (cs *ConfigurationSpec) findComponent(resourceKind Kind, name string) ([]ResourceConfiguration, *ResourceConfiguration, error) {
+	var components []ResourceConfiguration
+	var prefix string
+	switch resourceKind {
+	case KindSource:
+		components, prefix = cs.Sources, "source"
+	case KindDestination:
+		components, prefix = cs.Destinations, "destination"
+	default:
+		return nil, nil, fmt.Errorf("flow control is only supported for sources and destinations, not %s", resourceKind)
+	}
+	for i := range components {
+		if components[i].routeName(fmt.Sprintf("%s%d", prefix, i)) == name {
+			return components, &components[i], nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%s %s not found in configuration", resourceKind, name)
+}
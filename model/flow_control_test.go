@@ -0,0 +1,103 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigurationWithFlowControl(t *testing.T) {
+	samplingPercentage := 50.0
+	configuration := NewConfigurationWithSpec("flow-control", ConfigurationSpec{
+		Sources: []ResourceConfiguration{
+			{Type: "MacOS"},
+		},
+		Destinations: []ResourceConfiguration{
+			{Name: "googlecloud"},
+			{Name: "cabin", FlowControl: &FlowControl{SamplingPercentage: &samplingPercentage}},
+		},
+	})
+
+	tests := []struct {
+		name          string
+		kind          Kind
+		component     string
+		flowControl   *FlowControl
+		expectCurrent *FlowControl
+		expectErr     string
+	}{
+		{
+			name:          "inline source by position",
+			kind:          KindSource,
+			component:     "source0",
+			flowControl:   &FlowControl{Paused: true},
+			expectCurrent: &FlowControl{},
+		},
+		{
+			name:          "destination by name",
+			kind:          KindDestination,
+			component:     "cabin",
+			flowControl:   &FlowControl{Paused: true, SamplingPercentage: &samplingPercentage},
+			expectCurrent: &FlowControl{SamplingPercentage: &samplingPercentage},
+		},
+		{
+			name:          "empty flow control is removed",
+			kind:          KindDestination,
+			component:     "cabin",
+			flowControl:   &FlowControl{},
+			expectCurrent: &FlowControl{SamplingPercentage: &samplingPercentage},
+		},
+		{
+			name:      "unknown destination",
+			kind:      KindDestination,
+			component: "missing",
+			expectErr: "Destination missing not found in configuration",
+		},
+		{
+			name:      "processors are not supported",
+			kind:      KindProcessor,
+			component: "processor0",
+			expectErr: "flow control is only supported for sources and destinations, not Processor",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			current, err := configuration.FlowControl(test.kind, test.component)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				_, err = configuration.WithFlowControl(test.kind, test.component, test.flowControl)
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectCurrent, current)
+
+			updated, err := configuration.WithFlowControl(test.kind, test.component, test.flowControl)
+			require.NoError(t, err)
+
+			result, err := updated.FlowControl(test.kind, test.component)
+			require.NoError(t, err)
+			require.Equal(t, test.flowControl, result)
+
+			// the original configuration is unchanged
+			current, err = configuration.FlowControl(test.kind, test.component)
+			require.NoError(t, err)
+			require.Equal(t, test.expectCurrent, current)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/observiq/bindplane-op/internal/store/search"
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// LabelRule is a resource that sets labels on agents that match it, e.g. to label all agents in a network or on a
+// platform so that they receive the right configuration without labeling each agent. Rules are evaluated when an agent
+// connects and when the rule is applied. Labels set by a rule are not removed when the rule is changed or deleted.
+type LabelRule struct {
+	ResourceMeta `yaml:",inline" json:",inline" mapstructure:",squash"`
+	Spec         LabelRuleSpec `json:"spec" yaml:"spec" mapstructure:"spec"`
+}
+
+// LabelRuleSpec is the spec for a LabelRule
+type LabelRuleSpec struct {
+	// Match determines which agents are labeled. All of the specified conditions must match.
+	Match LabelRuleMatch `json:"match" yaml:"match" mapstructure:"match"`
+
+	// Labels are set on matching agents, replacing any existing values
+	Labels map[string]string `json:"labels" yaml:"labels" mapstructure:"labels"`
+}
+
+// LabelRuleMatch contains the conditions of a LabelRule. Empty conditions are ignored.
+type LabelRuleMatch struct {
+	// HostName is a regular expression matched against the hostname of the agent, e.g. ^web-\d+$
+	HostName string `json:"hostname,omitempty" yaml:"hostname,omitempty" mapstructure:"hostname"`
+
+	// Platform is the platform of the agent, e.g. linux, windows, or macos
+	Platform string `json:"platform,omitempty" yaml:"platform,omitempty" mapstructure:"platform"`
+
+	// Version is a range of agent versions, e.g. ">= 1.8.0, < 2.0.0"
+	Version string `json:"version,omitempty" yaml:"version,omitempty" mapstructure:"version"`
+
+	// RemoteAddress is a CIDR matched against the address of the agent connection, e.g. 10.10.0.0/16
+	RemoteAddress string `json:"remoteAddress,omitempty" yaml:"remoteAddress,omitempty" mapstructure:"remoteAddress"`
+}
+
+var _ Resource = (*LabelRule)(nil)
+
+// NewLabelRule creates a new LabelRule with the specified name and spec
+func NewLabelRule(name string, spec LabelRuleSpec) *LabelRule {
+	return &LabelRule{
+		ResourceMeta: ResourceMeta{
+			APIVersion: V1Alpha,
+			Kind:       KindLabelRule,
+			Metadata: Metadata{
+				Name:   name,
+				Labels: MakeLabels(),
+			},
+		},
+		Spec: spec,
+	}
+}
+
+// GetKind returns "LabelRule"
+func (r *LabelRule) GetKind() Kind { return KindLabelRule }
+
+// Matches returns true if the agent matches all of the conditions of the rule. Conditions that are invalid do not match
+// any agents.
+func (r *LabelRule) Matches(agent *Agent) bool {
+	m := r.Spec.Match
+	if m.HostName != "" {
+		re, err := regexp.Compile(m.HostName)
+		if err != nil || !re.MatchString(agent.HostName) {
+			return false
+		}
+	}
+	if m.Platform != "" && normalizePlatform(m.Platform) != normalizePlatform(agent.Platform) {
+		return false
+	}
+	if m.Version != "" {
+		constraints, err := semver.NewConstraint(m.Version)
+		if err != nil {
+			return false
+		}
+		version, err := semver.NewVersion(agent.Version)
+		if err != nil || !constraints.Check(version) {
+			return false
+		}
+	}
+	if m.RemoteAddress != "" {
+		_, network, err := net.ParseCIDR(m.RemoteAddress)
+		if err != nil {
+			return false
+		}
+		ip := remoteAddressIP(agent.RemoteAddress)
+		if ip == nil || !network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyLabelRules sets the labels of the matching rules on the agent and returns true if the labels changed. Rules are
+// applied in order of their names so that later rules replace the labels set by earlier rules regardless of the order
+// of the rules.
+func ApplyLabelRules(agent *Agent, rules []*LabelRule) bool {
+	sorted := make([]*LabelRule, len(rules))
+	copy(sorted, rules)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name() < sorted[j].Name() })
+
+	labels := map[string]string{}
+	for _, rule := range sorted {
+		if !rule.Matches(agent) {
+			continue
+		}
+		for name, value := range rule.Spec.Labels {
+			labels[name] = value
+		}
+	}
+
+	changed := false
+	for name, value := range labels {
+		if agent.Labels.Get(name) != value {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		agent.Labels = LabelsFromMerge(agent.Labels, LabelsFromValidatedMap(labels))
+	}
+	return changed
+}
+
+// remoteAddressIP returns the IP of the remote address of an agent, which usually includes the port
+func remoteAddressIP(remoteAddress string) net.IP {
+	host, _, err := net.SplitHostPort(remoteAddress)
+	if err != nil {
+		host = remoteAddress
+	}
+	return net.ParseIP(host)
+}
+
+// ----------------------------------------------------------------------
+// Validation
+
+// Validate checks that the rule is valid, returning an error if it is not
+func (r *LabelRule) Validate() error {
+	errors := validation.NewErrors()
+	r.validate(errors)
+	return errors.Result()
+}
+
+// ValidateWithStore checks that the rule is valid, returning an error if it is not
+func (r *LabelRule) ValidateWithStore(store ResourceStore) error {
+	return r.Validate()
+}
+
+func (r *LabelRule) validate(errors validation.Errors) {
+	r.ResourceMeta.validate(errors)
+
+	m := r.Spec.Match
+	if m == (LabelRuleMatch{}) {
+		errors.Add(fmt.Errorf("label rule must specify at least one match condition"))
+	}
+	if m.HostName != "" {
+		if _, err := regexp.Compile(m.HostName); err != nil {
+			errors.Add(fmt.Errorf("invalid hostname expression %s: %w", m.HostName, err))
+		}
+	}
+	if m.Version != "" {
+		if _, err := semver.NewConstraint(m.Version); err != nil {
+			errors.Add(fmt.Errorf("invalid version range %s: %w", m.Version, err))
+		}
+	}
+	if m.RemoteAddress != "" {
+		if _, _, err := net.ParseCIDR(m.RemoteAddress); err != nil {
+			errors.Add(fmt.Errorf("invalid remoteAddress %s: %w", m.RemoteAddress, err))
+		}
+	}
+
+	if len(r.Spec.Labels) == 0 {
+		errors.Add(fmt.Errorf("label rule must specify at least one label"))
+	}
+	if _, err := LabelsFromMap(r.Spec.Labels); err != nil {
+		errors.Add(err)
+	}
+	for name := range r.Spec.Labels {
+		if strings.HasPrefix(name, "bindplane/") {
+			errors.Add(fmt.Errorf("label rule cannot set the %s label because bindplane/ labels are reserved", name))
+		}
+	}
+}
+
+// ----------------------------------------------------------------------
+// Printable
+
+// PrintableFieldTitles returns the list of field titles, used for printing a table of resources
+func (r *LabelRule) PrintableFieldTitles() []string {
+	return []string{"Name", "Match", "Labels"}
+}
+
+// PrintableFieldValue returns the field value for a title, used for printing a table of resources
+func (r *LabelRule) PrintableFieldValue(title string) string {
+	switch title {
+	case "Match":
+		return r.Spec.Match.String()
+	case "Labels":
+		return LabelsFromValidatedMap(r.Spec.Labels).String()
+	default:
+		return r.ResourceMeta.PrintableFieldValue(title)
+	}
+}
+
+// String returns the conditions as a comma separated list, e.g. hostname=^web,platform=linux
+func (m LabelRuleMatch) String() string {
+	var conditions []string
+	for _, c := range []struct{ name, value string }{
+		{"hostname", m.HostName},
+		{"platform", m.Platform},
+		{"version", m.Version},
+		{"remoteAddress", m.RemoteAddress},
+	} {
+		if c.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s=%s", c.name, c.value))
+		}
+	}
+	return strings.Join(conditions, ",")
+}
+
+// ----------------------------------------------------------------------
+// Indexed
+
+// IndexFields returns a map of field name to field value to be stored in the index
+func (r *LabelRule) IndexFields(index search.Indexer) {
+	r.ResourceMeta.IndexFields(index)
+	index("platform", r.Spec.Match.Platform)
+}
@@ -0,0 +1,168 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testLabelRuleAgent() *Agent {
+	return &Agent{
+		ID:            "1",
+		HostName:      "web-1",
+		Platform:      "linux",
+		Version:       "v1.9.2",
+		RemoteAddress: "10.10.4.2:51234",
+		Labels:        LabelsFromValidatedMap(map[string]string{"env": "dev", "team": "ops"}),
+	}
+}
+
+func TestLabelRuleMatches(t *testing.T) {
+	rule := testResource[*LabelRule](t, "labelrule-web.yaml")
+	require.NoError(t, rule.Validate())
+	require.True(t, rule.Matches(testLabelRuleAgent()))
+
+	tests := []struct {
+		name   string
+		update func(agent *Agent)
+	}{
+		{"hostname", func(agent *Agent) { agent.HostName = "db-1" }},
+		{"platform", func(agent *Agent) { agent.Platform = "windows" }},
+		{"version too new", func(agent *Agent) { agent.Version = "v2.0.0" }},
+		{"version too old", func(agent *Agent) { agent.Version = "v1.7.9" }},
+		{"invalid version", func(agent *Agent) { agent.Version = "latest" }},
+		{"remote address", func(agent *Agent) { agent.RemoteAddress = "10.11.0.1:51234" }},
+		{"missing remote address", func(agent *Agent) { agent.RemoteAddress = "" }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			agent := testLabelRuleAgent()
+			test.update(agent)
+			require.False(t, rule.Matches(agent))
+		})
+	}
+
+	// conditions that are not specified match any agent
+	platform := NewLabelRule("windows", LabelRuleSpec{
+		Match:  LabelRuleMatch{Platform: "Windows"},
+		Labels: map[string]string{"os": "windows"},
+	})
+	agent := testLabelRuleAgent()
+	require.False(t, platform.Matches(agent))
+	agent.Platform = "windows"
+	agent.RemoteAddress = "[::1]:51234"
+	require.True(t, platform.Matches(agent))
+
+	// darwin and macos are the same platform
+	macos := NewLabelRule("macos", LabelRuleSpec{
+		Match:  LabelRuleMatch{Platform: "macos"},
+		Labels: map[string]string{"os": "macos"},
+	})
+	agent.Platform = "darwin"
+	require.True(t, macos.Matches(agent))
+	macos.Spec.Match.Platform = "Darwin"
+	require.True(t, macos.Matches(agent))
+	agent.Platform = "macos"
+	require.True(t, macos.Matches(agent))
+}
+
+func TestApplyLabelRules(t *testing.T) {
+	web := testResource[*LabelRule](t, "labelrule-web.yaml")
+	linux := NewLabelRule("a-linux", LabelRuleSpec{
+		Match:  LabelRuleMatch{Platform: "linux"},
+		Labels: map[string]string{"os": "linux", "env": "staging"},
+	})
+	windows := NewLabelRule("windows", LabelRuleSpec{
+		Match:  LabelRuleMatch{Platform: "windows"},
+		Labels: map[string]string{"os": "windows"},
+	})
+
+	agent := testLabelRuleAgent()
+	require.True(t, ApplyLabelRules(agent, []*LabelRule{windows, web, linux}))
+	// web is applied after a-linux so its env label is used
+	require.Equal(t, map[string]string{"app": "web", "env": "production", "os": "linux", "team": "ops"}, agent.Labels.AsMap())
+
+	require.False(t, ApplyLabelRules(agent, []*LabelRule{windows, web, linux}), "labels should not change again")
+	require.False(t, ApplyLabelRules(agent, nil))
+}
+
+func TestValidateLabelRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   LabelRuleSpec
+		expect string
+	}{
+		{
+			name:   "valid",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{Platform: "linux"}, Labels: map[string]string{"os": "linux"}},
+			expect: "",
+		},
+		{
+			name:   "no conditions",
+			spec:   LabelRuleSpec{Labels: map[string]string{"os": "linux"}},
+			expect: "label rule must specify at least one match condition",
+		},
+		{
+			name:   "no labels",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{Platform: "linux"}},
+			expect: "label rule must specify at least one label",
+		},
+		{
+			name:   "invalid hostname",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{HostName: "web-("}, Labels: map[string]string{"app": "web"}},
+			expect: "invalid hostname expression web-(",
+		},
+		{
+			name:   "invalid version",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{Version: "newer than 1.0"}, Labels: map[string]string{"app": "web"}},
+			expect: "invalid version range newer than 1.0",
+		},
+		{
+			name:   "invalid remote address",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{RemoteAddress: "10.10.0.1"}, Labels: map[string]string{"app": "web"}},
+			expect: "invalid remoteAddress 10.10.0.1",
+		},
+		{
+			name:   "invalid label",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{Platform: "linux"}, Labels: map[string]string{"app": "web app"}},
+			expect: "web app is not a valid label value",
+		},
+		{
+			name:   "bindplane label",
+			spec:   LabelRuleSpec{Match: LabelRuleMatch{Platform: "linux"}, Labels: map[string]string{LabelBindPlaneAgentName: "web"}},
+			expect: "label rule cannot set the bindplane/agent-name label",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := NewLabelRule("rule", test.spec).Validate()
+			if test.expect == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), test.expect)
+		})
+	}
+}
+
+func TestLabelRulePrintableFieldValue(t *testing.T) {
+	rule := testResource[*LabelRule](t, "labelrule-web.yaml")
+	require.Equal(t, "web", rule.PrintableFieldValue("Name"))
+	require.Equal(t, `hostname=^web-\d+$,platform=linux,version=>= 1.8.0, < 2.0.0,remoteAddress=10.10.0.0/16`, rule.PrintableFieldValue("Match"))
+	require.Equal(t, "app=web,env=production", rule.PrintableFieldValue("Labels"))
+}
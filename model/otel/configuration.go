@@ -16,8 +16,11 @@ package otel
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/go-multierror"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"gopkg.in/yaml.v3"
 )
@@ -148,6 +151,15 @@ func (p *Partial) Add(o *Partial) {
 // Partials represents a fragments of configuration for each type of telemetry.
 type Partials map[PipelineType]*Partial
 
+// NewPartials returns Partials with an empty Partial for each type of telemetry
+func NewPartials() Partials {
+	return Partials{
+		Logs:    &Partial{},
+		Metrics: &Partial{},
+		Traces:  &Partial{},
+	}
+}
+
 // Add combines the individual Logs, Metrics, and Traces Partial configurations
 func (p Partials) Add(o Partials) {
 	p[Logs].Add(o[Logs])
@@ -162,7 +174,9 @@ type ComponentIDProvider interface {
 
 // UniqueComponentID ensures that each ComponentID is unique by including the type and resource name. To make them easy
 // to find in a completed configuration, we preserve the part before the / and then insert the type and resource name
-// separated by 2 underscores.
+// separated by 2 underscores. The resource name must not depend on the position of the resource in the configuration,
+// otherwise the IDs of all components would change when resources are reordered. Inline resources without a name are
+// named after the scope they are used in, e.g. batch/batch__configuration.
 func UniqueComponentID(original, typeName, resourceName string) ComponentID {
 	// replace type/name with type/resourceType__resourceName__name
 	pipelineType, name := ParseComponentID(ComponentID(original))
@@ -255,3 +269,89 @@ func (p *Pipeline) AddProcessors(id []ComponentID) {
 func (p *Pipeline) AddExporters(id []ComponentID) {
 	p.Exporters = append(p.Exporters, id...)
 }
+
+// ParseConfiguration parses a fragment of an OpenTelemetry configuration. Any of the sections may be omitted.
+func ParseConfiguration(fragment string) (*Configuration, error) {
+	configuration := NewConfiguration()
+	if err := yaml.Unmarshal([]byte(fragment), configuration); err != nil {
+		return nil, err
+	}
+	return configuration, nil
+}
+
+// Merge adds the components, service extensions, and pipelines of the fragment to the configuration. A component or
+// pipeline of the fragment with the same id as one already in the configuration is a conflict and is not added. An
+// error is returned listing all of the conflicts.
+func (c *Configuration) Merge(fragment *Configuration) error {
+	var errs error
+	errs = c.Receivers.merge("receiver", fragment.Receivers, errs)
+	errs = c.Processors.merge("processor", fragment.Processors, errs)
+	errs = c.Exporters.merge("exporter", fragment.Exporters, errs)
+	errs = c.Extensions.merge("extension", fragment.Extensions, errs)
+
+	for _, id := range fragment.Service.Extensions {
+		if !slices.Contains(c.Service.Extensions, id) {
+			c.Service.Extensions = append(c.Service.Extensions, id)
+		}
+	}
+
+	for _, name := range sortedKeys(fragment.Service.Pipelines) {
+		if _, ok := c.Service.Pipelines[name]; ok {
+			errs = multierror.Append(errs, fmt.Errorf("pipeline %s conflicts with a generated pipeline", name))
+			continue
+		}
+		c.Service.Pipelines[name] = fragment.Service.Pipelines[name]
+	}
+	return errs
+}
+
+func (c ComponentMap) merge(kind string, fragment ComponentMap, errs error) error {
+	for _, id := range sortedKeys(fragment) {
+		if _, ok := c[id]; ok {
+			errs = multierror.Append(errs, fmt.Errorf("%s %s conflicts with a generated %s", kind, id, kind))
+			continue
+		}
+		c[id] = fragment[id]
+	}
+	return errs
+}
+
+// Validate checks that the pipelines and service extensions only reference components in the configuration and that
+// each pipeline has a supported type and at least one receiver and exporter.
+func (c *Configuration) Validate() error {
+	var errs error
+	for _, id := range c.Service.Extensions {
+		if _, ok := c.Extensions[id]; !ok {
+			errs = multierror.Append(errs, fmt.Errorf("service references undefined extension %s", id))
+		}
+	}
+	for _, name := range sortedKeys(c.Service.Pipelines) {
+		pipeline := c.Service.Pipelines[name]
+		pipelineType, _ := ParseComponentID(ComponentID(name))
+		if !slices.Contains([]PipelineType{Logs, Metrics, Traces}, PipelineType(pipelineType)) {
+			errs = multierror.Append(errs, fmt.Errorf("pipeline %s must be a logs, metrics, or traces pipeline", name))
+		}
+		if pipeline.Incomplete() {
+			errs = multierror.Append(errs, fmt.Errorf("pipeline %s must have at least one receiver and one exporter", name))
+		}
+		errs = c.Receivers.validateReferences(name, "receiver", pipeline.Receivers, errs)
+		errs = c.Processors.validateReferences(name, "processor", pipeline.Processors, errs)
+		errs = c.Exporters.validateReferences(name, "exporter", pipeline.Exporters, errs)
+	}
+	return errs
+}
+
+func (c ComponentMap) validateReferences(pipeline, kind string, ids []ComponentID, errs error) error {
+	for _, id := range ids {
+		if _, ok := c[id]; !ok {
+			errs = multierror.Append(errs, fmt.Errorf("pipeline %s references undefined %s %s", pipeline, kind, id))
+		}
+	}
+	return errs
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := maps.Keys(m)
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -83,3 +83,75 @@ func TestNilConfiguration(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, NoopConfig, yaml)
 }
+
+func TestMergeConfiguration(t *testing.T) {
+	configuration := NewConfiguration()
+	configuration.AddPipeline("source__destination", Logs,
+		Partials{Logs: &Partial{Receivers: ComponentList{{"otlp/source": map[string]any{}}}}},
+		Partials{Logs: &Partial{Exporters: ComponentList{{"logging/destination": map[string]any{}}}}},
+	)
+
+	fragment, err := ParseConfiguration(`
+receivers:
+  filelog: {}
+exporters:
+  logging/destination: {}
+extensions:
+  health_check: {}
+service:
+  extensions: [health_check]
+  pipelines:
+    logs/fragment:
+      receivers: [filelog]
+      exporters: [logging/destination]
+`)
+	require.NoError(t, err)
+
+	err = configuration.Merge(fragment)
+	require.EqualError(t, err, "1 error occurred:\n\t* exporter logging/destination conflicts with a generated exporter\n\n")
+
+	// the remaining components are merged
+	require.Contains(t, configuration.Receivers, ComponentID("filelog"))
+	require.Contains(t, configuration.Extensions, ComponentID("health_check"))
+	require.Equal(t, []ComponentID{"health_check"}, configuration.Service.Extensions)
+	require.Equal(t, []ComponentID{"filelog"}, configuration.Service.Pipelines["logs/fragment"].Receivers)
+	require.NoError(t, configuration.Validate())
+
+	// pipelines with the same name also conflict
+	err = configuration.Merge(&Configuration{Service: Service{Pipelines: Pipelines{"logs/fragment": {}}}})
+	require.EqualError(t, err, "1 error occurred:\n\t* pipeline logs/fragment conflicts with a generated pipeline\n\n")
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	configuration, err := ParseConfiguration(`
+receivers:
+  filelog: {}
+exporters:
+  logging: {}
+service:
+  extensions: [health_check]
+  pipelines:
+    logs:
+      receivers: [filelog]
+      processors: [batch]
+      exporters: [logging]
+    profiles:
+      receivers: [filelog]
+      exporters: [otlp]
+    metrics:
+      receivers: [filelog]
+`)
+	require.NoError(t, err)
+
+	err = configuration.Validate()
+	require.Error(t, err)
+	for _, expect := range []string{
+		"service references undefined extension health_check",
+		"pipeline logs references undefined processor batch",
+		"pipeline metrics must have at least one receiver and one exporter",
+		"pipeline profiles must be a logs, metrics, or traces pipeline",
+		"pipeline profiles references undefined exporter otlp",
+	} {
+		require.ErrorContains(t, err, expect)
+	}
+}
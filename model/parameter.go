@@ -17,8 +17,15 @@ package model
 import (
 	"fmt"
 	"go/token"
+	"net"
+	"net/url"
 	"reflect"
+	"regexp"
 	"strconv"
+	"time"
+
+	// embed the timezone database so that timezone parameters are validated the same way on every platform
+	_ "time/tzdata"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/observiq/bindplane-op/model/validation"
@@ -27,14 +34,38 @@ import (
 )
 
 const (
-	stringType  = "string"
-	boolType    = "bool"
-	intType     = "int"
-	stringsType = "strings"
-	enumType    = "enum"
-	enumsType   = "enums"
-	yamlType    = "yaml"
-	mapType     = "map"
+	stringType   = "string"
+	boolType     = "bool"
+	intType      = "int"
+	stringsType  = "strings"
+	enumType     = "enum"
+	enumsType    = "enums"
+	yamlType     = "yaml"
+	mapType      = "map"
+	secretType   = "secret"
+	floatType    = "float"
+	durationType = "duration"
+	timezoneType = "timezone"
+	textType     = "text"
+	objectsType  = "objects"
+)
+
+const (
+	relevantIfOperatorEquals      = "equals"
+	relevantIfOperatorNotEquals   = "notEquals"
+	relevantIfOperatorIn          = "in"
+	relevantIfOperatorNotIn       = "notIn"
+	relevantIfOperatorContainsAny = "containsAny"
+	relevantIfOperatorGreaterThan = "greaterThan"
+)
+
+const (
+	// parameterFormatHostPort is a host and port, e.g. localhost:4317
+	parameterFormatHostPort = "host:port"
+	// parameterFormatDuration is a duration parsed by time.ParseDuration, e.g. 1m30s
+	parameterFormatDuration = "duration"
+	// parameterFormatURL is an absolute URL with a scheme and host, e.g. https://example.com/api
+	parameterFormatURL = "url"
 )
 
 // ParameterDefinition is a basic description of a definition's parameter. This implementation comes directly from
@@ -45,13 +76,20 @@ type ParameterDefinition struct {
 	Description string `json:"description" yaml:"description"`
 	Required    bool   `json:"required" yaml:"required"`
 
-	// "string", "int", "bool", "strings", or "enum"
+	// "string", "int", "bool", "strings", "enum", "enums", "map", "yaml", "secret", "float", "duration", "timezone",
+	// "text", or "objects"
 	Type string `json:"type" yaml:"type"`
 
 	// only useable if Type == "enum"
 	ValidValues []string `json:"validValues,omitempty" yaml:"validValues,omitempty" mapstructure:"validValues"`
 
-	// Must be valid according to Type & ValidValues
+	// Properties describe the fields of each object of an "objects" parameter
+	Properties []ParameterDefinition `json:"properties,omitempty" yaml:"properties,omitempty" mapstructure:"properties"`
+
+	// Constraints restrict the values of the parameter beyond its Type
+	Constraints *ParameterConstraints `json:"constraints,omitempty" yaml:"constraints,omitempty" mapstructure:"constraints"`
+
+	// Must be valid according to Type, ValidValues, & Constraints
 	Default        interface{}           `json:"default,omitempty" yaml:"default,omitempty"`
 	RelevantIf     []RelevantIfCondition `json:"relevantIf,omitempty" yaml:"relevantIf,omitempty" mapstructure:"relevantIf"`
 	Hidden         bool                  `json:"hidden" yaml:"hidden"`
@@ -60,13 +98,87 @@ type ParameterDefinition struct {
 
 // RelevantIfCondition specifies a condition under which a parameter is deemed relevant.
 type RelevantIfCondition struct {
-	Name     string `json:"name" yaml:"name" mapstructure:"name"`
+	Name string `json:"name" yaml:"name" mapstructure:"name"`
+
+	// "equals", "notEquals", "in", "notIn", "containsAny", or "greaterThan". The value of "in", "notIn", and
+	// "containsAny" is a list of values.
 	Operator string `json:"operator" yaml:"operator" mapstructure:"operator"`
 	Value    any    `json:"value" yaml:"value" mapstructure:"value"`
 }
 
+// matches returns true if the condition is satisfied by the value of the parameter it refers to. A nil value is the
+// value of a parameter that is not specified and has no default.
+func (c RelevantIfCondition) matches(value any) bool {
+	switch c.Operator {
+	case relevantIfOperatorEquals:
+		return relevantIfEquals(value, c.Value)
+	case relevantIfOperatorNotEquals:
+		return !relevantIfEquals(value, c.Value)
+	case relevantIfOperatorIn:
+		return relevantIfIn(value, c.Value)
+	case relevantIfOperatorNotIn:
+		return !relevantIfIn(value, c.Value)
+	case relevantIfOperatorContainsAny:
+		values, _ := stringValues(value)
+		for _, v := range values {
+			if relevantIfIn(v, c.Value) {
+				return true
+			}
+		}
+		return false
+	case relevantIfOperatorGreaterThan:
+		number, ok := numericValue(value)
+		threshold, thresholdOk := numericValue(c.Value)
+		return ok && thresholdOk && number > threshold
+	}
+	return false
+}
+
+func relevantIfEquals(value any, expected any) bool {
+	if value == nil || expected == nil {
+		return value == expected
+	}
+	// compare the printed values so that 4 and 4.0 or true and "true" are equal
+	return fmt.Sprint(value) == fmt.Sprint(expected)
+}
+
+func relevantIfIn(value any, list any) bool {
+	values, ok := list.([]any)
+	if !ok {
+		return false
+	}
+	for _, v := range values {
+		if relevantIfEquals(value, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParameterConstraints are declarative constraints on the value of a parameter
+type ParameterConstraints struct {
+	// Min is the minimum value of an "int" or "float" parameter
+	Min *float64 `json:"min,omitempty" yaml:"min,omitempty" mapstructure:"min"`
+
+	// Max is the maximum value of an "int" or "float" parameter
+	Max *float64 `json:"max,omitempty" yaml:"max,omitempty" mapstructure:"max"`
+
+	// Regex must match the value of a "string" parameter or each value of a "strings" parameter
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty" mapstructure:"regex"`
+
+	// MinItems is the minimum number of values of a "strings" or "enums" parameter
+	MinItems int `json:"minItems,omitempty" yaml:"minItems,omitempty" mapstructure:"minItems"`
+
+	// Format is the format of a "string" parameter or each value of a "strings" parameter. One of "host:port",
+	// "duration", or "url".
+	Format string `json:"format,omitempty" yaml:"format,omitempty" mapstructure:"format"`
+}
+
 func (p ParameterDefinition) validateValue(value interface{}) error {
-	return p.validateValueType(parameterFieldValue, value)
+	if err := p.validateValueType(parameterFieldValue, value); err != nil {
+		return err
+	}
+	return p.validateConstraints(parameterFieldValue, value)
 }
 
 func (p ParameterDefinition) validateDefinition(errs validation.Errors) {
@@ -82,6 +194,12 @@ func (p ParameterDefinition) validateDefinition(errs validation.Errors) {
 		errs.Add(err)
 	}
 
+	if err := p.validateConstraintsDefinition(); err != nil {
+		errs.Add(err)
+	}
+
+	p.validateProperties(errs)
+
 	if err := p.validateDefault(); err != nil {
 		errs.Add(err)
 	}
@@ -111,7 +229,8 @@ func (p ParameterDefinition) validateType() error {
 		)
 	}
 	switch p.Type {
-	case stringType, intType, boolType, stringsType, enumType, enumsType, mapType, yamlType: // ok
+	case stringType, intType, boolType, stringsType, enumType, enumsType, mapType, yamlType, secretType,
+		floatType, durationType, timezoneType, textType, objectsType: // ok
 	default:
 		return errors.NewError(
 			fmt.Sprintf("invalid type '%s' for '%s'", p.Type, p.Name),
@@ -123,7 +242,8 @@ func (p ParameterDefinition) validateType() error {
 
 func (p ParameterDefinition) validateValidValues() error {
 	switch p.Type {
-	case stringType, intType, boolType, stringsType, yamlType, mapType:
+	case stringType, intType, boolType, stringsType, yamlType, mapType, secretType,
+		floatType, durationType, timezoneType, textType, objectsType:
 		if len(p.ValidValues) > 0 {
 			return errors.NewError(
 				fmt.Sprintf("validValues is undefined for parameter of type '%s'", p.Type),
@@ -147,7 +267,10 @@ func (p ParameterDefinition) validateDefault() error {
 	}
 
 	// Validate that Default corresponds to Type
-	return p.validateValueType(parameterFieldDefault, p.Default)
+	if err := p.validateValueType(parameterFieldDefault, p.Default); err != nil {
+		return err
+	}
+	return p.validateConstraints(parameterFieldDefault, p.Default)
 }
 
 type parameterFieldType string
@@ -161,10 +284,18 @@ const (
 // validateValueType determines if the specified value is of the right type.
 func (p ParameterDefinition) validateValueType(fieldType parameterFieldType, value any) error {
 	switch p.Type {
-	case stringType:
+	case stringType, secretType, textType:
 		return p.validateStringValue(fieldType, value)
 	case intType:
 		return p.validateIntValue(fieldType, value)
+	case floatType:
+		return p.validateFloatValue(fieldType, value)
+	case durationType:
+		return p.validateDurationValue(fieldType, value)
+	case timezoneType:
+		return p.validateTimezoneValue(fieldType, value)
+	case objectsType:
+		return p.validateObjectsValue(fieldType, value)
 	case boolType:
 		return p.validateBoolValue(fieldType, value)
 	case stringsType:
@@ -220,6 +351,47 @@ func (p ParameterDefinition) validateIntValue(fieldType parameterFieldType, valu
 	return nil
 }
 
+func (p ParameterDefinition) validateFloatValue(fieldType parameterFieldType, value any) error {
+	if _, ok := numericValue(value); !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a number", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a number", fieldType),
+		)
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateDurationValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	if ok {
+		_, err := time.ParseDuration(str)
+		ok = err == nil
+	}
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a duration", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a duration like 30s, 5m, or 1h30m", fieldType),
+		)
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateTimezoneValue(fieldType parameterFieldType, value any) error {
+	str, ok := value.(string)
+	if ok {
+		// LoadLocation accepts "" and "Local" which depend on the server
+		_, err := time.LoadLocation(str)
+		ok = err == nil && str != "" && str != "Local"
+	}
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a timezone", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a timezone name like UTC or America/New_York", fieldType),
+		)
+	}
+	return nil
+}
+
 func (p ParameterDefinition) validateBoolValue(fieldType parameterFieldType, value any) error {
 	isBoolValue := false
 
@@ -326,6 +498,92 @@ func (p ParameterDefinition) validateYamlValue(fieldType parameterFieldType, val
 	return yaml.Unmarshal([]byte(str), &into)
 }
 
+func (p ParameterDefinition) validateObjectsValue(fieldType parameterFieldType, value any) error {
+	list, ok := value.([]any)
+	if !ok {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must be a list of objects", fieldType, p.Name),
+			fmt.Sprintf("ensure that the %s value is a list of objects", fieldType),
+		)
+	}
+	var errs error
+	for i, item := range list {
+		object, ok := item.(map[string]any)
+		if !ok {
+			errs = multierror.Append(errs, errors.NewError(
+				fmt.Sprintf("%s value for '%s' item %d must be an object", fieldType, p.Name, i),
+				fmt.Sprintf("ensure that the %s value is a list of objects", fieldType),
+			))
+			continue
+		}
+		for _, property := range p.Properties {
+			if _, ok := object[property.Name]; !ok && property.Required {
+				errs = multierror.Append(errs, errors.NewError(
+					fmt.Sprintf("%s value for '%s' item %d is missing required property '%s'", fieldType, p.Name, i, property.Name),
+					fmt.Sprintf("specify '%s' for each item", property.Name),
+				))
+			}
+		}
+		for name, propertyValue := range object {
+			property := p.property(name)
+			if property == nil {
+				errs = multierror.Append(errs, errors.NewError(
+					fmt.Sprintf("%s value for '%s' item %d has unknown property '%s'", fieldType, p.Name, i, name),
+					fmt.Sprintf("remove '%s' from the item", name),
+				))
+				continue
+			}
+			if err := property.validateValueType(fieldType, propertyValue); err != nil {
+				errs = multierror.Append(errs, err)
+				continue
+			}
+			if err := property.validateConstraints(fieldType, propertyValue); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// property returns the definition of the property of an "objects" parameter with the specified name or nil if it does
+// not exist
+func (p ParameterDefinition) property(name string) *ParameterDefinition {
+	for i := range p.Properties {
+		if p.Properties[i].Name == name {
+			return &p.Properties[i]
+		}
+	}
+	return nil
+}
+
+func (p ParameterDefinition) validateProperties(errs validation.Errors) {
+	if p.Type != objectsType {
+		if len(p.Properties) > 0 {
+			errs.Add(errors.NewError(
+				fmt.Sprintf("properties is undefined for parameter of type '%s'", p.Type),
+				"remove 'properties' field or change type to 'objects'",
+			))
+		}
+		return
+	}
+	if len(p.Properties) == 0 {
+		errs.Add(errors.NewError(
+			fmt.Sprintf("parameter '%s' of type 'objects' must have 'properties' specified", p.Name),
+			"specify the properties of each object",
+		))
+	}
+	for _, property := range p.Properties {
+		if property.Type == objectsType {
+			errs.Add(errors.NewError(
+				fmt.Sprintf("property '%s' of '%s' cannot be of type 'objects'", property.Name, p.Name),
+				"use a flat list of objects",
+			))
+			continue
+		}
+		property.validateDefinition(errs)
+	}
+}
+
 func (p ParameterDefinition) validateMapValue(fieldType parameterFieldType, value any) error {
 	reflectValue := reflect.ValueOf(value)
 	kind := reflectValue.Kind()
@@ -348,3 +606,185 @@ func (p ParameterDefinition) validateMapValue(fieldType parameterFieldType, valu
 	}
 	return nil
 }
+
+// ----------------------------------------------------------------------
+// constraints
+
+func (p ParameterDefinition) validateConstraintsDefinition() error {
+	c := p.Constraints
+	if c == nil {
+		return nil
+	}
+	var errs error
+	invalid := func(constraint string) {
+		errs = multierror.Append(errs, errors.NewError(
+			fmt.Sprintf("%s constraint is undefined for parameter of type '%s'", constraint, p.Type),
+			fmt.Sprintf("remove '%s' from the constraints of '%s'", constraint, p.Name),
+		))
+	}
+	if (c.Min != nil || c.Max != nil) && p.Type != intType && p.Type != floatType {
+		invalid("min and max")
+	}
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		errs = multierror.Append(errs, errors.NewError(
+			fmt.Sprintf("min constraint for '%s' is greater than max", p.Name),
+			"ensure that min is less than or equal to max",
+		))
+	}
+	if c.Regex != "" {
+		if p.Type != stringType && p.Type != stringsType {
+			invalid("regex")
+		} else if _, err := regexp.Compile(c.Regex); err != nil {
+			errs = multierror.Append(errs, errors.NewError(
+				fmt.Sprintf("regex constraint for '%s' is invalid: %s", p.Name, err),
+				"ensure that the regex is a valid regular expression",
+			))
+		}
+	}
+	if c.MinItems != 0 && p.Type != stringsType && p.Type != enumsType && p.Type != objectsType {
+		invalid("minItems")
+	}
+	if c.Format != "" {
+		switch {
+		case p.Type != stringType && p.Type != stringsType:
+			invalid("format")
+		case c.Format != parameterFormatHostPort && c.Format != parameterFormatDuration && c.Format != parameterFormatURL:
+			errs = multierror.Append(errs, errors.NewError(
+				fmt.Sprintf("invalid format '%s' for '%s'", c.Format, p.Name),
+				"ensure that the format is one of 'host:port', 'duration', or 'url'",
+			))
+		}
+	}
+	return errs
+}
+
+// validateConstraints determines if the specified value satisfies the constraints. The value must already be of the
+// right type.
+func (p ParameterDefinition) validateConstraints(fieldType parameterFieldType, value any) error {
+	c := p.Constraints
+	if c == nil {
+		return nil
+	}
+
+	if number, ok := numericValue(value); ok && (p.Type == intType || p.Type == floatType) {
+		if c.Min != nil && number < *c.Min {
+			return errors.NewError(
+				fmt.Sprintf("%s value for '%s' must be at least %v", fieldType, p.Name, *c.Min),
+				fmt.Sprintf("ensure that the %s value is at least %v", fieldType, *c.Min),
+			)
+		}
+		if c.Max != nil && number > *c.Max {
+			return errors.NewError(
+				fmt.Sprintf("%s value for '%s' must be at most %v", fieldType, p.Name, *c.Max),
+				fmt.Sprintf("ensure that the %s value is at most %v", fieldType, *c.Max),
+			)
+		}
+	}
+
+	if items, isList := listLength(value); isList && items < c.MinItems {
+		return errors.NewError(
+			fmt.Sprintf("%s value for '%s' must have at least %d items", fieldType, p.Name, c.MinItems),
+			fmt.Sprintf("ensure that the %s value has at least %d items", fieldType, c.MinItems),
+		)
+	}
+	if p.Type != stringType && p.Type != stringsType {
+		return nil
+	}
+
+	values, _ := stringValues(value)
+	for _, s := range values {
+		if c.Regex != "" {
+			// the regex is validated with the definition
+			if re, err := regexp.Compile(c.Regex); err == nil && !re.MatchString(s) {
+				return errors.NewError(
+					fmt.Sprintf("%s value '%s' for '%s' must match %s", fieldType, s, p.Name, c.Regex),
+					fmt.Sprintf("ensure that the %s value matches the regex", fieldType),
+				)
+			}
+		}
+		if c.Format != "" {
+			if err := validateFormat(c.Format, s); err != nil {
+				return errors.NewError(
+					fmt.Sprintf("%s value '%s' for '%s' must be a valid %s: %s", fieldType, s, p.Name, c.Format, err),
+					fmt.Sprintf("ensure that the %s value is a valid %s", fieldType, c.Format),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// stringValues returns the value as a list of strings and true if the value is a list or returns a list containing
+// the value and false if the value is a single string
+func stringValues(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, false
+	case []string:
+		return v, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+// listLength returns the number of items in the value and true if the value is a list
+func listLength(value any) (int, bool) {
+	switch v := value.(type) {
+	case []string:
+		return len(v), true
+	case []any:
+		return len(v), true
+	}
+	return 0, false
+}
+
+func validateFormat(format string, value string) error {
+	switch format {
+	case parameterFormatHostPort:
+		host, port, err := net.SplitHostPort(value)
+		if err != nil {
+			return err
+		}
+		if host == "" {
+			return fmt.Errorf("missing host")
+		}
+		portNumber, err := strconv.Atoi(port)
+		if err != nil || portNumber < 1 || portNumber > 65535 {
+			return fmt.Errorf("port must be between 1 and 65535")
+		}
+	case parameterFormatDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return err
+		}
+	case parameterFormatURL:
+		u, err := url.Parse(value)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("missing scheme or host")
+		}
+	}
+	return nil
+}
+
+// numericValue returns the value as a float64 if it is a number or a string containing a number
+func numericValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,183 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/observiq/bindplane-op/model/validation"
+)
+
+// ParameterOverride replaces the values of parameters of a source or destination of a Configuration for specific
+// agents, e.g. to use a different log path on one host without creating a separate Configuration. The agents are
+// specified by either AgentID or Selector and the source or destination is referenced by name or, for inline sources
+// and destinations without a name, by their position in the configuration, e.g. source0 or destination1, as in Routes.
+// If more than one override applies to the same parameter, the last override is used.
+type ParameterOverride struct {
+	// AgentID is the ID of the agent that receives the override
+	AgentID string `json:"agentId,omitempty" yaml:"agentId,omitempty" mapstructure:"agentId"`
+
+	// Selector matches the labels of the agents that receive the override
+	Selector *AgentSelector `json:"selector,omitempty" yaml:"selector,omitempty" mapstructure:"selector"`
+
+	// Source is the name of the source with the parameters to replace
+	Source string `json:"source,omitempty" yaml:"source,omitempty" mapstructure:"source"`
+
+	// Destination is the name of the destination with the parameters to replace
+	Destination string `json:"destination,omitempty" yaml:"destination,omitempty" mapstructure:"destination"`
+
+	// Parameters are the parameter values used instead of the values in the Configuration
+	Parameters []Parameter `json:"parameters" yaml:"parameters" mapstructure:"parameters"`
+}
+
+// Matches returns true if the override applies to the agent
+func (o *ParameterOverride) Matches(agent *Agent) bool {
+	switch {
+	case agent == nil:
+		return false
+	case o.AgentID != "":
+		return o.AgentID == agent.ID
+	case o.Selector != nil:
+		return o.Selector.Selector().Matches(agent.Labels)
+	}
+	return false
+}
+
+// target returns the kind and name of the source or destination with the parameters to replace
+func (o *ParameterOverride) target() (Kind, string) {
+	if o.Source != "" {
+		return KindSource, o.Source
+	}
+	return KindDestination, o.Destination
+}
+
+// AgentOverrides returns the overrides that apply to the agent
+func (cs *ConfigurationSpec) AgentOverrides(agent *Agent) []ParameterOverride {
+	var overrides []ParameterOverride
+	for _, override := range cs.Overrides {
+		if override.Matches(agent) {
+			overrides = append(overrides, override)
+		}
+	}
+	return overrides
+}
+
+// overrideParameters returns the parameters of the source or destination with the route name with the values replaced
+// by the overrides that apply to the agent
+func (cs *ConfigurationSpec) overrideParameters(kind Kind, routeName string, parameters []Parameter, agent *Agent) []Parameter {
+	for _, override := range cs.AgentOverrides(agent) {
+		if targetKind, name := override.target(); targetKind == kind && name == routeName {
+			parameters = mergeParameters(parameters, override.Parameters)
+		}
+	}
+	return parameters
+}
+
+// resourceConfiguration returns the source or destination with the route name or nil if it does not exist
+func (cs *ConfigurationSpec) resourceConfiguration(kind Kind, routeName string) *ResourceConfiguration {
+	resources, prefix := cs.Sources, "source"
+	if kind == KindDestination {
+		resources, prefix = cs.Destinations, "destination"
+	}
+	for i := range resources {
+		if resources[i].routeName(fmt.Sprintf("%s%d", prefix, i)) == routeName {
+			return &resources[i]
+		}
+	}
+	return nil
+}
+
+// mergeParameters returns a new list of parameters with the values of the overrides replacing the values of parameters
+// with the same name. Overrides for parameters that are not in the list are appended.
+func mergeParameters(parameters []Parameter, overrides []Parameter) []Parameter {
+	result := make([]Parameter, len(parameters), len(parameters)+len(overrides))
+	nameIndex := map[string]int{}
+	for i, p := range parameters {
+		result[i] = p
+		nameIndex[p.Name] = i
+	}
+	for _, p := range overrides {
+		if index, ok := nameIndex[p.Name]; ok {
+			result[index] = p
+		} else {
+			nameIndex[p.Name] = len(result)
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// ----------------------------------------------------------------------
+// validation
+
+func (cs *ConfigurationSpec) validateOverrides(errors validation.Errors) {
+	if len(cs.Overrides) == 0 {
+		return
+	}
+	if cs.Raw != "" {
+		errors.Add(fmt.Errorf("configuration with raw cannot specify overrides"))
+		return
+	}
+	for i, override := range cs.Overrides {
+		if (override.AgentID == "") == (override.Selector == nil) {
+			errors.Add(fmt.Errorf("override %d must specify either agentId or selector", i))
+		}
+		if override.Selector != nil {
+			override.Selector.validate(overrideErrors{errors, i})
+		}
+		if (override.Source == "") == (override.Destination == "") {
+			errors.Add(fmt.Errorf("override %d must specify either source or destination", i))
+			continue
+		}
+		if len(override.Parameters) == 0 {
+			errors.Add(fmt.Errorf("override %d must specify at least one parameter", i))
+		}
+		kind, name := override.target()
+		if cs.resourceConfiguration(kind, name) == nil {
+			errors.Add(fmt.Errorf("override %d references unknown %s: %s", i, kind, name))
+		}
+	}
+}
+
+// validateOverrideParameters ensures that the parameters of the overrides are valid for the type of the source or
+// destination they replace
+func (cs *ConfigurationSpec) validateOverrideParameters(errors validation.Errors, store ResourceStore) {
+	for i, override := range cs.Overrides {
+		kind, name := override.target()
+		target := cs.resourceConfiguration(kind, name)
+		if target == nil || len(override.Parameters) == 0 {
+			// reported by validateOverrides
+			continue
+		}
+		rc := &ResourceConfiguration{
+			Name:       target.Name,
+			Type:       target.Type,
+			Parameters: override.Parameters,
+		}
+		rc.validateParameters(kind, overrideErrors{errors, i}, store)
+	}
+}
+
+// overrideErrors adds the index of the override to validation errors
+type overrideErrors struct {
+	validation.Errors
+	index int
+}
+
+func (e overrideErrors) Add(err error) {
+	if err != nil {
+		e.Errors.Add(fmt.Errorf("override %d: %w", e.index, err))
+	}
+}
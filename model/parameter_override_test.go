@@ -0,0 +1,256 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func testOverrideConfiguration(overrides ...ParameterOverride) *Configuration {
+	return NewConfigurationWithSpec("overrides", ConfigurationSpec{
+		Sources: []ResourceConfiguration{{Type: "otlp"}},
+		Destinations: []ResourceConfiguration{
+			{
+				Type: "otlp-agent",
+				Parameters: []Parameter{
+					{Name: "endpoint", Value: "collector:4317"},
+				},
+			},
+		},
+		Overrides: overrides,
+	})
+}
+
+func testOverrideAgent(id string, labels map[string]string) *Agent {
+	return &Agent{ID: id, Labels: LabelsFromValidatedMap(labels)}
+}
+
+func TestParameterOverrideMatches(t *testing.T) {
+	byID := ParameterOverride{AgentID: "1"}
+	bySelector := ParameterOverride{Selector: &AgentSelector{MatchLabels: MatchLabels{"env": "dev"}}}
+
+	require.False(t, byID.Matches(nil))
+	require.True(t, byID.Matches(testOverrideAgent("1", nil)))
+	require.False(t, byID.Matches(testOverrideAgent("2", nil)))
+
+	require.False(t, bySelector.Matches(nil))
+	require.True(t, bySelector.Matches(testOverrideAgent("1", map[string]string{"env": "dev"})))
+	require.False(t, bySelector.Matches(testOverrideAgent("1", map[string]string{"env": "prod"})))
+}
+
+func TestMergeParameters(t *testing.T) {
+	parameters := []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 2}}
+	merged := mergeParameters(parameters, []Parameter{{Name: "b", Value: 3}, {Name: "c", Value: 4}})
+	require.Equal(t, []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 3}, {Name: "c", Value: 4}}, merged)
+	// the original parameters are unchanged
+	require.Equal(t, []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 2}}, parameters)
+}
+
+func TestFindSourceOverridesParameters(t *testing.T) {
+	store := newTestResourceStore()
+	store.sources["otlp"] = NewSource("otlp", "otlp", []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 2}})
+
+	source, err := FindSource(&ResourceConfiguration{
+		Name:       "otlp",
+		Parameters: []Parameter{{Name: "b", Value: 3}, {Name: "c", Value: 4}},
+	}, "source0", store)
+	require.NoError(t, err)
+	require.Equal(t, []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 3}, {Name: "c", Value: 4}}, source.Spec.Parameters)
+
+	// the stored source is unchanged
+	require.Equal(t, []Parameter{{Name: "a", Value: 1}, {Name: "b", Value: 2}}, store.sources["otlp"].Spec.Parameters)
+}
+
+func TestRenderOverrides(t *testing.T) {
+	store := testAgentTemplateStore(t)
+	configuration := testOverrideConfiguration(
+		ParameterOverride{
+			Selector:    &AgentSelector{MatchLabels: MatchLabels{"env": "dev"}},
+			Destination: "destination0",
+			Parameters:  []Parameter{{Name: "endpoint", Value: "dev-collector:4317"}, {Name: "tenant", Value: "dev"}},
+		},
+		ParameterOverride{
+			AgentID:     "snowflake",
+			Destination: "destination0",
+			Parameters:  []Parameter{{Name: "endpoint", Value: "snowflake:4317"}},
+		},
+	)
+	require.NoError(t, configuration.ValidateWithStore(store))
+	require.True(t, configuration.ReferencesAgent(store))
+
+	exporter := func(agent *Agent) map[string]any {
+		result, err := configuration.Render(context.TODO(), agent, store)
+		require.NoError(t, err)
+		var parsed map[string]any
+		require.NoError(t, yaml.Unmarshal([]byte(result), &parsed))
+		return parsed["exporters"].(map[string]any)["otlp/otlp-agent__destination0"].(map[string]any)
+	}
+
+	tests := []struct {
+		name           string
+		agent          *Agent
+		expectEndpoint string
+		expectTenant   string
+	}{
+		{
+			name:           "no agent",
+			expectEndpoint: "collector:4317",
+			expectTenant:   "default",
+		},
+		{
+			name:           "no matching overrides",
+			agent:          testOverrideAgent("1", map[string]string{"env": "prod"}),
+			expectEndpoint: "collector:4317",
+			expectTenant:   "default",
+		},
+		{
+			name:           "selector",
+			agent:          testOverrideAgent("1", map[string]string{"env": "dev"}),
+			expectEndpoint: "dev-collector:4317",
+			expectTenant:   "dev",
+		},
+		{
+			name:           "agent id",
+			agent:          testOverrideAgent("snowflake", map[string]string{"env": "prod"}),
+			expectEndpoint: "snowflake:4317",
+			expectTenant:   "default",
+		},
+		{
+			name:           "last override wins",
+			agent:          testOverrideAgent("snowflake", map[string]string{"env": "dev"}),
+			expectEndpoint: "snowflake:4317",
+			expectTenant:   "dev",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			exporter := exporter(test.agent)
+			require.Equal(t, test.expectEndpoint, exporter["endpoint"])
+			require.Equal(t, map[string]any{"X-Tenant": test.expectTenant}, exporter["headers"])
+		})
+	}
+
+	// rendering does not modify the configuration
+	require.Equal(t, []Parameter{{Name: "endpoint", Value: "collector:4317"}}, configuration.Spec.Destinations[0].Parameters)
+}
+
+func TestValidateOverrides(t *testing.T) {
+	store := testAgentTemplateStore(t)
+	selector := &AgentSelector{MatchLabels: MatchLabels{"env": "dev"}}
+	endpoint := []Parameter{{Name: "endpoint", Value: "dev:4317"}}
+
+	tests := []struct {
+		name        string
+		override    ParameterOverride
+		expectError string
+	}{
+		{
+			name:        "unknown parameter",
+			override:    ParameterOverride{AgentID: "1", Source: "source0", Parameters: []Parameter{{Name: "unused", Value: 1}}},
+			expectError: "override 0: parameter unused not defined in type otlp",
+		},
+		{
+			name:     "valid destination",
+			override: ParameterOverride{Selector: selector, Destination: "destination0", Parameters: endpoint},
+		},
+		{
+			name:        "missing agent",
+			override:    ParameterOverride{Destination: "destination0", Parameters: endpoint},
+			expectError: "override 0 must specify either agentId or selector",
+		},
+		{
+			name:        "agent and selector",
+			override:    ParameterOverride{AgentID: "1", Selector: selector, Destination: "destination0", Parameters: endpoint},
+			expectError: "override 0 must specify either agentId or selector",
+		},
+		{
+			name:        "invalid selector",
+			override:    ParameterOverride{Selector: &AgentSelector{MatchLabels: MatchLabels{"bad key": "dev"}}, Destination: "destination0", Parameters: endpoint},
+			expectError: "override 0: selector is invalid",
+		},
+		{
+			name:        "missing target",
+			override:    ParameterOverride{AgentID: "1", Parameters: endpoint},
+			expectError: "override 0 must specify either source or destination",
+		},
+		{
+			name:        "unknown destination",
+			override:    ParameterOverride{AgentID: "1", Destination: "destination1", Parameters: endpoint},
+			expectError: "override 0 references unknown Destination: destination1",
+		},
+		{
+			name:        "missing parameters",
+			override:    ParameterOverride{AgentID: "1", Destination: "destination0"},
+			expectError: "override 0 must specify at least one parameter",
+		},
+		{
+			name:        "invalid parameter value",
+			override:    ParameterOverride{AgentID: "1", Destination: "destination0", Parameters: []Parameter{{Name: "tenant", Value: 5}}},
+			expectError: "override 0: parameter value for 'tenant' must be a string",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := testOverrideConfiguration(test.override).ValidateWithStore(store)
+			if test.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.expectError)
+		})
+	}
+
+	raw := NewRawConfiguration("raw", "receivers: {}")
+	raw.Spec.Overrides = []ParameterOverride{{AgentID: "1", Source: "source0", Parameters: endpoint}}
+	require.ErrorContains(t, raw.Validate(), "configuration with raw cannot specify overrides")
+}
+
+func TestOverrideSecrets(t *testing.T) {
+	store := testSecretStore(t)
+	keyring := testSecretKeyring(t, "key")
+
+	configuration := NewConfigurationWithSpec("secrets", ConfigurationSpec{
+		Destinations: []ResourceConfiguration{
+			{
+				Type:       "observiq-cloud-secret",
+				Parameters: []Parameter{{Name: "secret_key", Value: "shared"}},
+			},
+		},
+		Overrides: []ParameterOverride{
+			{
+				AgentID:     "1",
+				Destination: "destination0",
+				Parameters:  []Parameter{{Name: "secret_key", Value: "snowflake"}},
+			},
+		},
+	})
+	changed, err := EncryptSecrets(configuration, nil, store, keyring)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	encrypted := configuration.Spec.Overrides[0].Parameters[0].Value
+	require.True(t, IsEncryptedSecret(encrypted))
+	decrypted, err := keyring.Decrypt(encrypted.(string))
+	require.NoError(t, err)
+	require.Equal(t, "snowflake", decrypted)
+
+	redacted := RedactSecrets(configuration)
+	require.Equal(t, RedactedSecret, redacted.Spec.Overrides[0].Parameters[0].Value)
+	require.Equal(t, encrypted, configuration.Spec.Overrides[0].Parameters[0].Value)
+}
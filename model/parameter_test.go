@@ -17,6 +17,7 @@ package model
 import (
 	"testing"
 
+	"github.com/observiq/bindplane-op/model/validation"
 	"github.com/stretchr/testify/require"
 )
 
@@ -139,7 +140,7 @@ func TestValidateDefault(t *testing.T) {
 			"InvalidTypeDefault",
 			true,
 			ParameterDefinition{
-				Type:    "float",
+				Type:    "decimal",
 				Default: 5,
 			},
 		},
@@ -288,7 +289,7 @@ func TestValidateValue(t *testing.T) {
 			"InvalidType",
 			true,
 			ParameterDefinition{
-				Type:    "float",
+				Type:    "decimal",
 				Default: 5,
 			},
 			5,
@@ -393,6 +394,281 @@ eleven:
 				"one", "seven",
 			},
 		},
+		{
+			"ValidPortInRange",
+			false,
+			ParameterDefinition{
+				Type:        "int",
+				Constraints: &ParameterConstraints{Min: testFloat(1), Max: testFloat(65535)},
+			},
+			4317,
+		},
+		{
+			"InvalidPortBelowMin",
+			true,
+			ParameterDefinition{
+				Type:        "int",
+				Constraints: &ParameterConstraints{Min: testFloat(1), Max: testFloat(65535)},
+			},
+			-1,
+		},
+		{
+			"InvalidPortAboveMaxAsString",
+			true,
+			ParameterDefinition{
+				Type:        "int",
+				Constraints: &ParameterConstraints{Min: testFloat(1), Max: testFloat(65535)},
+			},
+			"70000",
+		},
+		{
+			"ValidRegex",
+			false,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Regex: "^[a-z]+$"},
+			},
+			"abc",
+		},
+		{
+			"InvalidRegex",
+			true,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Regex: "^[a-z]+$"},
+			},
+			"ABC",
+		},
+		{
+			"InvalidRegexStrings",
+			true,
+			ParameterDefinition{
+				Type:        "strings",
+				Constraints: &ParameterConstraints{Regex: "^[a-z]+$"},
+			},
+			[]any{"abc", "ABC"},
+		},
+		{
+			"ValidMinItems",
+			false,
+			ParameterDefinition{
+				Type:        "enums",
+				ValidValues: []string{"one", "two"},
+				Constraints: &ParameterConstraints{MinItems: 1},
+			},
+			[]any{"one"},
+		},
+		{
+			"InvalidMinItems",
+			true,
+			ParameterDefinition{
+				Type:        "strings",
+				Constraints: &ParameterConstraints{MinItems: 1},
+			},
+			[]string{},
+		},
+		{
+			"ValidHostPort",
+			false,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "host:port"},
+			},
+			"localhost:4317",
+		},
+		{
+			"InvalidHostPortMissingPort",
+			true,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "host:port"},
+			},
+			"localhost",
+		},
+		{
+			"InvalidHostPortBadPort",
+			true,
+			ParameterDefinition{
+				Type:        "strings",
+				Constraints: &ParameterConstraints{Format: "host:port"},
+			},
+			[]any{"localhost:4317", "localhost:0"},
+		},
+		{
+			"ValidDuration",
+			false,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "duration"},
+			},
+			"1m30s",
+		},
+		{
+			"InvalidDuration",
+			true,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "duration"},
+			},
+			"90",
+		},
+		{
+			"ValidURL",
+			false,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "url"},
+			},
+			"https://nozzle.app.observiq.com",
+		},
+		{
+			"InvalidURL",
+			true,
+			ParameterDefinition{
+				Type:        "string",
+				Constraints: &ParameterConstraints{Format: "url"},
+			},
+			"nozzle.app.observiq.com",
+		},
+		{
+			"ValidFloat",
+			false,
+			ParameterDefinition{
+				Type:        "float",
+				Constraints: &ParameterConstraints{Min: testFloat(0), Max: testFloat(1)},
+			},
+			0.25,
+		},
+		{
+			"ValidFloatInt",
+			false,
+			ParameterDefinition{
+				Type: "float",
+			},
+			1,
+		},
+		{
+			"FloatOverMax",
+			true,
+			ParameterDefinition{
+				Type:        "float",
+				Constraints: &ParameterConstraints{Min: testFloat(0), Max: testFloat(1)},
+			},
+			1.5,
+		},
+		{
+			"InvalidFloat",
+			true,
+			ParameterDefinition{
+				Type: "float",
+			},
+			"half",
+		},
+		{
+			"ValidDuration",
+			false,
+			ParameterDefinition{
+				Type: "duration",
+			},
+			"1m30s",
+		},
+		{
+			"InvalidDuration",
+			true,
+			ParameterDefinition{
+				Type: "duration",
+			},
+			"90",
+		},
+		{
+			"ValidTimezone",
+			false,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"America/New_York",
+		},
+		{
+			"InvalidTimezone",
+			true,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"America/Nowhere",
+		},
+		{
+			"LocalTimezone",
+			true,
+			ParameterDefinition{
+				Type: "timezone",
+			},
+			"Local",
+		},
+		{
+			"ValidText",
+			false,
+			ParameterDefinition{
+				Type: "text",
+			},
+			"batch:\n  timeout: 1s\n",
+		},
+		{
+			"InvalidText",
+			true,
+			ParameterDefinition{
+				Type: "text",
+			},
+			5,
+		},
+		{
+			"ValidObjects",
+			false,
+			testObjectsDefinition(),
+			[]any{
+				map[string]any{"key": "env", "value": "prod"},
+				map[string]any{"key": "region", "value": "us-east1", "priority": 2},
+			},
+		},
+		{
+			"ObjectsMissingRequiredProperty",
+			true,
+			testObjectsDefinition(),
+			[]any{
+				map[string]any{"value": "prod"},
+			},
+		},
+		{
+			"ObjectsUnknownProperty",
+			true,
+			testObjectsDefinition(),
+			[]any{
+				map[string]any{"key": "env", "other": "prod"},
+			},
+		},
+		{
+			"ObjectsInvalidPropertyValue",
+			true,
+			testObjectsDefinition(),
+			[]any{
+				map[string]any{"key": "env", "priority": 100},
+			},
+		},
+		{
+			"ObjectsNotObject",
+			true,
+			testObjectsDefinition(),
+			[]any{"env"},
+		},
+		{
+			"ObjectsMinItems",
+			true,
+			ParameterDefinition{
+				Type:        "objects",
+				Properties:  testObjectsDefinition().Properties,
+				Constraints: &ParameterConstraints{MinItems: 1},
+			},
+			[]any{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -408,3 +684,98 @@ eleven:
 		})
 	}
 }
+
+func testFloat(f float64) *float64 {
+	return &f
+}
+
+func testObjectsDefinition() ParameterDefinition {
+	return ParameterDefinition{
+		Type: "objects",
+		Properties: []ParameterDefinition{
+			{Name: "key", Type: "string", Required: true},
+			{Name: "value", Type: "string"},
+			{Name: "priority", Type: "int", Constraints: &ParameterConstraints{Min: testFloat(1), Max: testFloat(10)}},
+		},
+	}
+}
+
+func TestValidateProperties(t *testing.T) {
+	testCases := []struct {
+		name      string
+		param     ParameterDefinition
+		expectErr string
+	}{
+		{
+			"ValidObjects",
+			testObjectsDefinition(),
+			"",
+		},
+		{
+			"MissingProperties",
+			ParameterDefinition{Type: "objects"},
+			"parameter 'MissingProperties' of type 'objects' must have 'properties' specified",
+		},
+		{
+			"PropertiesOnString",
+			ParameterDefinition{Type: "string", Properties: testObjectsDefinition().Properties},
+			"properties is undefined for parameter of type 'string'",
+		},
+		{
+			"NestedObjects",
+			ParameterDefinition{Type: "objects", Properties: []ParameterDefinition{{Name: "nested", Type: "objects"}}},
+			"property 'nested' of 'NestedObjects' cannot be of type 'objects'",
+		},
+		{
+			"InvalidProperty",
+			ParameterDefinition{Type: "objects", Properties: []ParameterDefinition{{Name: "count", Type: "decimal"}}},
+			"invalid type 'decimal' for 'count'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.param.Name = tc.name
+			errs := validation.NewErrors()
+			tc.param.validateProperties(errs)
+			err := errs.Result()
+			if tc.expectErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func TestRelevantIfConditionMatches(t *testing.T) {
+	testCases := []struct {
+		name      string
+		condition RelevantIfCondition
+		value     any
+		expect    bool
+	}{
+		{"equals", RelevantIfCondition{Operator: "equals", Value: true}, true, true},
+		{"equals different", RelevantIfCondition{Operator: "equals", Value: true}, false, false},
+		{"equals number", RelevantIfCondition{Operator: "equals", Value: 4}, 4.0, true},
+		{"equals missing", RelevantIfCondition{Operator: "equals", Value: "a"}, nil, false},
+		{"notEquals", RelevantIfCondition{Operator: "notEquals", Value: "a"}, "b", true},
+		{"notEquals same", RelevantIfCondition{Operator: "notEquals", Value: "a"}, "a", false},
+		{"in", RelevantIfCondition{Operator: "in", Value: []any{"tcp", "udp"}}, "udp", true},
+		{"in missing", RelevantIfCondition{Operator: "in", Value: []any{"tcp", "udp"}}, "http", false},
+		{"notIn", RelevantIfCondition{Operator: "notIn", Value: []any{"tcp", "udp"}}, "http", true},
+		{"notIn present", RelevantIfCondition{Operator: "notIn", Value: []any{"tcp", "udp"}}, "tcp", false},
+		{"containsAny", RelevantIfCondition{Operator: "containsAny", Value: []any{"logs", "traces"}}, []any{"metrics", "logs"}, true},
+		{"containsAny none", RelevantIfCondition{Operator: "containsAny", Value: []any{"logs", "traces"}}, []any{"metrics"}, false},
+		{"greaterThan", RelevantIfCondition{Operator: "greaterThan", Value: 10}, 11, true},
+		{"greaterThan equal", RelevantIfCondition{Operator: "greaterThan", Value: 10}, "10", false},
+		{"greaterThan missing", RelevantIfCondition{Operator: "greaterThan", Value: 10}, nil, false},
+		{"unknown operator", RelevantIfCondition{Operator: "like", Value: "a"}, "a", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expect, tc.condition.matches(tc.value))
+		})
+	}
+}
@@ -42,23 +42,7 @@ type parameterizedResource interface {
 
 // overrideParameters overrides the parameters in the spec and returns a new spec with the overrides applied
 func (s ParameterizedSpec) overrideParameters(parameters []Parameter) ParameterizedSpec {
-	result := make([]Parameter, len(s.Parameters))
-	nameIndex := map[string]int{}
-	for i, p := range s.Parameters {
-		result[i] = p
-		nameIndex[p.Name] = i
-	}
-	for _, p := range parameters {
-		index, ok := nameIndex[p.Name]
-		if ok {
-			// override
-			s.Parameters[index] = p
-		} else {
-			// append
-			s.Parameters = append(s.Parameters, p)
-		}
-	}
-	return ParameterizedSpec{Type: s.Type, Parameters: result, Processors: s.Processors}
+	return ParameterizedSpec{Type: s.Type, Parameters: mergeParameters(s.Parameters, parameters), Processors: s.Processors}
 }
 
 // validateTypeAndParameters is used by Source and Destination validation and uses methods created for Configuration
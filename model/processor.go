@@ -106,7 +106,7 @@ func FindProcessor(processor *ResourceConfiguration, defaultName string, store R
 
 // PrintableFieldTitles returns the list of field titles, used for printing a table of resources
 func (s *Processor) PrintableFieldTitles() []string {
-	return []string{"Name", "Type", "Description"}
+	return []string{"Name", "Type", "Description", "References"}
 }
 
 // PrintableFieldValue returns the field value for a title, used for printing a table of resources
@@ -120,6 +120,8 @@ func (s *Processor) PrintableFieldValue(title string) string {
 		return s.ResourceTypeName()
 	case "Description":
 		return s.Metadata.Description
+	case "References":
+		return parameterReferences(s.Spec.Parameters)
 	default:
 		return "-"
 	}
@@ -57,6 +57,10 @@ const (
 	KindProcessorType   Kind = "ProcessorType"
 	KindDestinationType Kind = "DestinationType"
 	KindUnknown         Kind = "Unknown"
+
+	KindConfigurationTemplate Kind = "ConfigurationTemplate"
+	KindConfigurationInstance Kind = "ConfigurationInstance"
+	KindLabelRule             Kind = "LabelRule"
 )
 
 // createKindLookup creates a map from lowercase name => Kind, including the plural form by adding an "s" to the end of
@@ -74,6 +78,9 @@ func createKindLookup() map[string]Kind {
 		KindSourceType,
 		KindProcessorType,
 		KindDestinationType,
+		KindConfigurationTemplate,
+		KindConfigurationInstance,
+		KindLabelRule,
 	} {
 		key := strings.ToLower(string(kind))
 		plural := fmt.Sprintf("%ss", key)
@@ -261,6 +268,12 @@ func ParseResource(r *AnyResource) (Resource, error) {
 		return parseResource(r, &Destination{})
 	case KindDestinationType:
 		return parseResource(r, &DestinationType{})
+	case KindConfigurationTemplate:
+		return parseResource(r, &ConfigurationTemplate{})
+	case KindConfigurationInstance:
+		return parseResource(r, &ConfigurationInstance{})
+	case KindLabelRule:
+		return parseResource(r, &LabelRule{})
 	}
 
 	return nil, fmt.Errorf("unknown resource kind: %s", r.Kind)
@@ -412,6 +425,12 @@ func NewEmptyResource(kind Kind) (Resource, error) {
 		return &ProcessorType{}, nil
 	case KindDestinationType:
 		return &DestinationType{}, nil
+	case KindConfigurationTemplate:
+		return &ConfigurationTemplate{}, nil
+	case KindConfigurationInstance:
+		return &ConfigurationInstance{}, nil
+	case KindLabelRule:
+		return &LabelRule{}, nil
 	default:
 		return nil, fmt.Errorf("cannot make empty resource for unexpected kind: %s", kind)
 	}
@@ -18,7 +18,10 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"text/template"
+	"text/template/parse"
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/observiq/bindplane-op/model/otel"
@@ -56,6 +59,26 @@ type ResourceTypeSpec struct {
 
 	// all three (alphabetical order)
 	LogsMetricsTraces ResourceTypeOutput `json:"logs+metrics+traces,omitempty" yaml:"logs+metrics+traces,omitempty" mapstructure:"logs+metrics+traces"`
+
+	// Platforms overrides the outputs for agents on specific platforms, e.g. to read the event log on windows instead
+	// of journald on linux. Each template specified for a platform replaces the corresponding template above.
+	Platforms map[string]ResourceTypePlatformSpec `json:"platforms,omitempty" yaml:"platforms,omitempty" mapstructure:"platforms"`
+}
+
+// ResourceTypePlatformSpec contains the outputs of a resource type that are overridden for a specific platform
+type ResourceTypePlatformSpec struct {
+	// individual
+	Logs    ResourceTypeOutput `json:"logs,omitempty"    yaml:"logs,omitempty"    mapstructure:"logs"`
+	Metrics ResourceTypeOutput `json:"metrics,omitempty" yaml:"metrics,omitempty" mapstructure:"metrics"`
+	Traces  ResourceTypeOutput `json:"traces,omitempty"  yaml:"traces,omitempty"  mapstructure:"traces"`
+
+	// pairs (alphabetical order)
+	LogsMetrics   ResourceTypeOutput `json:"logs+metrics,omitempty"   yaml:"logs+metrics,omitempty"   mapstructure:"logs+metrics"`
+	LogsTraces    ResourceTypeOutput `json:"logs+traces,omitempty"    yaml:"logs+traces,omitempty"    mapstructure:"logs+traces"`
+	MetricsTraces ResourceTypeOutput `json:"metrics+traces,omitempty" yaml:"metrics+traces,omitempty" mapstructure:"metrics+traces"`
+
+	// all three (alphabetical order)
+	LogsMetricsTraces ResourceTypeOutput `json:"logs+metrics+traces,omitempty" yaml:"logs+metrics+traces,omitempty" mapstructure:"logs+metrics+traces"`
 }
 
 // ResourceTypeOutput describes the output of the resource type
@@ -71,6 +94,23 @@ func (s ResourceTypeOutput) Empty() bool {
 	return s.Receivers == "" && s.Processors == "" && s.Exporters == "" && s.Extensions == ""
 }
 
+// override returns a copy of the output with the non-empty templates of the specified output replacing its templates
+func (s ResourceTypeOutput) override(output ResourceTypeOutput) ResourceTypeOutput {
+	if output.Receivers != "" {
+		s.Receivers = output.Receivers
+	}
+	if output.Processors != "" {
+		s.Processors = output.Processors
+	}
+	if output.Exporters != "" {
+		s.Exporters = output.Exporters
+	}
+	if output.Extensions != "" {
+		s.Extensions = output.Extensions
+	}
+	return s
+}
+
 // ResourceTypeTemplate is a go-template that evaluates to an array of OpenTelemetry resources
 type ResourceTypeTemplate string
 
@@ -78,6 +118,55 @@ type ResourceTypeTemplate string
 // accumulated and reported to the user.
 type TemplateErrorHandler func(error)
 
+// SupportsPlatform returns true if the resource type can be used on agents with the specified platform. Resource types
+// without SupportedPlatforms support all platforms and agents that have not reported a platform are always supported.
+func (s *ResourceTypeSpec) SupportsPlatform(platform string) bool {
+	if len(s.SupportedPlatforms) == 0 || platform == "" {
+		return true
+	}
+	platform = normalizePlatform(platform)
+	for _, supported := range s.SupportedPlatforms {
+		if normalizePlatform(supported) == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// forPlatform returns the spec with the outputs overridden for the specified platform. If there are no overrides for
+// the platform, the spec itself is returned.
+func (s *ResourceTypeSpec) forPlatform(platform string) *ResourceTypeSpec {
+	if platform == "" {
+		return s
+	}
+	platform = normalizePlatform(platform)
+	for name, overrides := range s.Platforms {
+		if normalizePlatform(name) != platform {
+			continue
+		}
+		spec := *s
+		spec.Logs = spec.Logs.override(overrides.Logs)
+		spec.Metrics = spec.Metrics.override(overrides.Metrics)
+		spec.Traces = spec.Traces.override(overrides.Traces)
+		spec.LogsMetrics = spec.LogsMetrics.override(overrides.LogsMetrics)
+		spec.LogsTraces = spec.LogsTraces.override(overrides.LogsTraces)
+		spec.MetricsTraces = spec.MetricsTraces.override(overrides.MetricsTraces)
+		spec.LogsMetricsTraces = spec.LogsMetricsTraces.override(overrides.LogsMetricsTraces)
+		return &spec
+	}
+	return s
+}
+
+// normalizePlatform returns the name used for a platform in SupportedPlatforms and Platforms. Agents report the
+// operating system family, e.g. darwin, which is known as macos in resource types.
+func normalizePlatform(platform string) string {
+	platform = strings.ToLower(platform)
+	if platform == "darwin" {
+		return "macos"
+	}
+	return platform
+}
+
 // ParameterDefinition returns the ParameterDefinition with the specified name or nil if no such parameter exists
 func (s *ResourceTypeSpec) ParameterDefinition(name string) *ParameterDefinition {
 	for _, p := range s.Parameters {
@@ -90,29 +179,31 @@ func (s *ResourceTypeSpec) ParameterDefinition(name string) *ParameterDefinition
 
 // ----------------------------------------------------------------------
 
-// eval executes all of the templates associated with this resource type, returning a partial configuration for each
-// telemetry type.
-func (rt *ResourceType) eval(resource parameterizedResource, errorHandler TemplateErrorHandler) otel.Partials {
+// eval executes all of the templates associated with this resource type for the agent, returning a partial
+// configuration for each telemetry type. The templates overridden for the platform of the agent are used in place of
+// the default templates. The agent is nil when rendering a configuration that is not for a specific agent.
+func (rt *ResourceType) eval(resource parameterizedResource, agent *Agent, secrets secretResolver, errorHandler TemplateErrorHandler) otel.Partials {
+	spec := rt.Spec.forPlatform(agentPlatform(agent))
 	result := otel.Partials{
-		otel.Logs:    rt.evalOutput(&rt.Spec.Logs, resource, errorHandler),
-		otel.Metrics: rt.evalOutput(&rt.Spec.Metrics, resource, errorHandler),
-		otel.Traces:  rt.evalOutput(&rt.Spec.Traces, resource, errorHandler),
+		otel.Logs:    rt.evalOutput(&spec.Logs, resource, agent, secrets, errorHandler),
+		otel.Metrics: rt.evalOutput(&spec.Metrics, resource, agent, secrets, errorHandler),
+		otel.Traces:  rt.evalOutput(&spec.Traces, resource, agent, secrets, errorHandler),
 	}
 
 	// add multi-pipelines components
-	logsMetrics := rt.evalOutput(&rt.Spec.LogsMetrics, resource, errorHandler)
+	logsMetrics := rt.evalOutput(&spec.LogsMetrics, resource, agent, secrets, errorHandler)
 	result[otel.Logs].Add(logsMetrics)
 	result[otel.Metrics].Add(logsMetrics)
 
-	logsTraces := rt.evalOutput(&rt.Spec.LogsTraces, resource, errorHandler)
+	logsTraces := rt.evalOutput(&spec.LogsTraces, resource, agent, secrets, errorHandler)
 	result[otel.Logs].Add(logsTraces)
 	result[otel.Traces].Add(logsTraces)
 
-	metricsTraces := rt.evalOutput(&rt.Spec.MetricsTraces, resource, errorHandler)
+	metricsTraces := rt.evalOutput(&spec.MetricsTraces, resource, agent, secrets, errorHandler)
 	result[otel.Metrics].Add(metricsTraces)
 	result[otel.Traces].Add(metricsTraces)
 
-	logsMetricsTraces := rt.evalOutput(&rt.Spec.LogsMetricsTraces, resource, errorHandler)
+	logsMetricsTraces := rt.evalOutput(&spec.LogsMetricsTraces, resource, agent, secrets, errorHandler)
 	result[otel.Logs].Add(logsMetricsTraces)
 	result[otel.Metrics].Add(logsMetricsTraces)
 	result[otel.Traces].Add(logsMetricsTraces)
@@ -121,7 +212,10 @@ func (rt *ResourceType) eval(resource parameterizedResource, errorHandler Templa
 }
 
 // evalOutput executes the templates associated with the specified output using the specified resource and errorHandler.
-func (rt *ResourceType) evalOutput(output *ResourceTypeOutput, resource parameterizedResource, errorHandler TemplateErrorHandler) *otel.Partial {
+// Secret parameters are decrypted and secret references are resolved using the secrets. The agent is available to the
+// templates and parameter values as .agent.
+func (rt *ResourceType) evalOutput(output *ResourceTypeOutput, resource parameterizedResource, agent *Agent, secrets secretResolver, errorHandler TemplateErrorHandler) *otel.Partial {
+	agentContext := newAgentTemplateContext(agent)
 	params := map[string]any{}
 	// start with default parameters
 	for _, p := range rt.Spec.Parameters {
@@ -133,6 +227,9 @@ func (rt *ResourceType) evalOutput(output *ResourceTypeOutput, resource paramete
 	for _, p := range resource.ResourceParameters() {
 		params[p.Name] = p.Value
 	}
+	evalAgentParameters(params, agentContext, errorHandler)
+	secrets.resolveParameters(rt, params, errorHandler)
+	params[agentTemplateKey] = agentContext
 	// eval all of the components
 	return &otel.Partial{
 		Receivers:  rt.evalTemplate(output.Receivers, resource, params, errorHandler),
@@ -142,6 +239,63 @@ func (rt *ResourceType) evalOutput(output *ResourceTypeOutput, resource paramete
 	}
 }
 
+// secretResolver decrypts secret parameters and resolves secret references when rendering configurations
+type secretResolver struct {
+	// keyring decrypts secret parameters. If it is nil, secret parameters are redacted.
+	keyring *SecretKeyring
+
+	// providers resolve secret references. If it is nil, secret references are not resolved.
+	providers SecretProviders
+}
+
+// resolveParameters replaces the values of secret parameters with the decrypted values and resolves the secret
+// references in them. References in other parameters are left for the agent.
+func (s secretResolver) resolveParameters(rt *ResourceType, params map[string]any, errorHandler TemplateErrorHandler) {
+	for _, p := range rt.Spec.Parameters {
+		if p.Type != secretType {
+			continue
+		}
+		value, ok := params[p.Name].(string)
+		if !ok {
+			continue
+		}
+		if s.keyring == nil {
+			params[p.Name] = RedactedSecret
+			continue
+		}
+		if IsEncryptedSecret(value) {
+			decrypted, err := s.keyring.Decrypt(value)
+			if err != nil {
+				errorHandler(fmt.Errorf("parameter %s: %w", p.Name, err))
+				continue
+			}
+			value = decrypted
+			params[p.Name] = value
+		}
+		if s.providers == nil || !HasSecretReferences(value) {
+			continue
+		}
+		resolved, err := s.providers.resolve(value)
+		if err != nil {
+			errorHandler(fmt.Errorf("parameter %s: %w", p.Name, err))
+			continue
+		}
+		params[p.Name] = resolved
+	}
+}
+
+// validateReferences returns an error if the secret references in the parameter value cannot be resolved. References
+// are not checked if there are no providers.
+func (s secretResolver) validateReferences(parameter Parameter) error {
+	if s.providers == nil {
+		return nil
+	}
+	if _, err := s.providers.resolve(parameter.Value.(string)); err != nil {
+		return fmt.Errorf("parameter %s: %w", parameter.Name, err)
+	}
+	return nil
+}
+
 // evalTemplate evaluates a single template with the specified paramValues. nameProvider is available to make the name
 // unique and the errorHandler will accumulate errors so that they can be reported once.
 func (rt *ResourceType) evalTemplate(r ResourceTypeTemplate, nameProvider otel.ComponentIDProvider, paramValues map[string]any, errorHandler TemplateErrorHandler) otel.ComponentList {
@@ -154,14 +308,15 @@ func (rt *ResourceType) evalTemplate(r ResourceTypeTemplate, nameProvider otel.C
 		return set
 	}
 
-	// render the template
+	// render the template with placeholders for multi-line text
+	values, texts := rt.textPlaceholders(t, paramValues)
 	var writer bytes.Buffer
-	if err := t.Execute(&writer, paramValues); err != nil {
+	if err := t.Execute(&writer, values); err != nil {
 		errorHandler(err)
 		return set
 	}
 
-	bytes := writer.Bytes()
+	bytes := []byte(indentText(writer.String(), texts))
 
 	// parse as yaml so that we can combine yaml fragments and render
 	var parsed []map[string]any
@@ -183,6 +338,124 @@ func (rt *ResourceType) evalTemplate(r ResourceTypeTemplate, nameProvider otel.C
 	return set
 }
 
+// textPlaceholders replaces multi-line text parameters that are output directly by the template, e.g. {{ .param }},
+// with single-line placeholders so that the text can be indented to match the position of the placeholder in the
+// rendered template. Text used in pipelines or function calls, e.g. {{ .param | quote }}, is left for the template to
+// format. The template is modified to output the placeholders. It returns the parameter values to use for rendering and
+// a map of placeholder to text.
+func (rt *ResourceType) textPlaceholders(t *template.Template, paramValues map[string]any) (map[string]any, map[string]string) {
+	texts := map[string]string{}
+	for _, p := range rt.Spec.Parameters {
+		if p.Type != textType {
+			continue
+		}
+		text, ok := paramValues[p.Name].(string)
+		text = strings.TrimRight(text, "\n")
+		if ok && strings.Contains(text, "\n") {
+			texts[p.Name] = text
+		}
+	}
+	if len(texts) == 0 {
+		return paramValues, nil
+	}
+
+	placeholders := map[string]string{}
+	if t.Tree != nil {
+		outputTextPlaceholders(t.Tree.Root, true, texts, placeholders)
+	}
+	if len(placeholders) == 0 {
+		return paramValues, nil
+	}
+
+	// copy so that the caller's values are not modified
+	values := make(map[string]any, len(paramValues)+len(placeholders))
+	for name, value := range paramValues {
+		values[name] = value
+	}
+	result := map[string]string{}
+	for key, name := range placeholders {
+		placeholder := fmt.Sprintf("%s__", key)
+		values[key] = placeholder
+		result[placeholder] = texts[name]
+	}
+	return values, result
+}
+
+// outputTextPlaceholders replaces the fields of actions that only output one of the texts, e.g. {{ .param }} or
+// {{ $.param }}, with the field of its placeholder, e.g. {{ .__bindplane_text_param }}. The placeholder fields are added
+// to placeholders with the name of the parameter. Fields of dot are only replaced where dot is the parameter values,
+// which is not the case within range and with.
+func outputTextPlaceholders(node parse.Node, dotIsParams bool, texts map[string]string, placeholders map[string]string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			outputTextPlaceholders(child, dotIsParams, texts, placeholders)
+		}
+	case *parse.IfNode:
+		outputTextPlaceholders(n.List, dotIsParams, texts, placeholders)
+		outputTextPlaceholders(n.ElseList, dotIsParams, texts, placeholders)
+	case *parse.RangeNode:
+		outputTextPlaceholders(n.List, false, texts, placeholders)
+		outputTextPlaceholders(n.ElseList, dotIsParams, texts, placeholders)
+	case *parse.WithNode:
+		outputTextPlaceholders(n.List, false, texts, placeholders)
+		outputTextPlaceholders(n.ElseList, dotIsParams, texts, placeholders)
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 || len(n.Pipe.Cmds) != 1 || len(n.Pipe.Cmds[0].Args) != 1 {
+			return
+		}
+		var ident []string
+		switch arg := n.Pipe.Cmds[0].Args[0].(type) {
+		case *parse.FieldNode:
+			if !dotIsParams {
+				return
+			}
+			ident = arg.Ident
+		case *parse.VariableNode:
+			if len(arg.Ident) != 2 || arg.Ident[0] != "$" {
+				return
+			}
+			ident = arg.Ident[1:]
+		default:
+			return
+		}
+		if len(ident) != 1 {
+			return
+		}
+		name := ident[0]
+		if _, ok := texts[name]; !ok {
+			return
+		}
+		key := fmt.Sprintf("__bindplane_text_%s", name)
+		ident[0] = key
+		placeholders[key] = name
+	}
+}
+
+// indentText replaces each placeholder in the rendered template with its text, indenting every line after the first
+// by the column of the placeholder. This allows text containing yaml to be used anywhere in a yaml template.
+func indentText(rendered string, texts map[string]string) string {
+	if len(texts) == 0 {
+		return rendered
+	}
+	lines := strings.Split(rendered, "\n")
+	for i, line := range lines {
+		for placeholder, text := range texts {
+			column := strings.Index(line, placeholder)
+			if column < 0 {
+				continue
+			}
+			indent := "\n" + strings.Repeat(" ", column)
+			line = strings.ReplaceAll(line, placeholder, strings.ReplaceAll(text, "\n", indent))
+		}
+		lines[i] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
 // ----------------------------------------------------------------------
 
 // PrintableFieldTitles returns the list of field titles, used for printing a table of resources
@@ -227,10 +500,16 @@ func (s *ResourceTypeSpec) validate(errs validation.Errors) {
 				params[p.Name] = []string{}
 			case intType:
 				params[p.Name] = 0
+			case floatType:
+				params[p.Name] = 0.0
 			case mapType:
 				params[p.Name] = make(map[string]string)
-			case stringType:
+			case stringType, secretType, durationType, textType:
 				params[p.Name] = ""
+			case timezoneType:
+				params[p.Name] = "UTC"
+			case objectsType:
+				params[p.Name] = []any{}
 			case stringsType:
 				params[p.Name] = []string{}
 			case yamlType:
@@ -239,18 +518,66 @@ func (s *ResourceTypeSpec) validate(errs validation.Errors) {
 		}
 	}
 
+	params[agentTemplateKey] = newAgentTemplateContext(nil)
+
 	s.Logs.validateTemplates(errs, "logs", params)
 	s.Metrics.validateTemplates(errs, "metrics", params)
 	s.Traces.validateTemplates(errs, "traces", params)
+
+	s.validatePlatforms(errs, params)
+}
+
+func (s *ResourceTypeSpec) validatePlatforms(errs validation.Errors, params map[string]any) {
+	// sort the platforms so that errors are reported in a consistent order
+	names := make([]string, 0, len(s.Platforms))
+	for name := range s.Platforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		overrides := s.Platforms[name]
+		if len(s.SupportedPlatforms) > 0 && !s.SupportsPlatform(name) {
+			errs.Add(fmt.Errorf("platforms.%s is not one of the supportedPlatforms", name))
+			continue
+		}
+		overrides.Logs.validateTemplates(errs, fmt.Sprintf("platforms.%s.logs", name), params)
+		overrides.Metrics.validateTemplates(errs, fmt.Sprintf("platforms.%s.metrics", name), params)
+		overrides.Traces.validateTemplates(errs, fmt.Sprintf("platforms.%s.traces", name), params)
+		overrides.LogsMetrics.validateTemplates(errs, fmt.Sprintf("platforms.%s.logs+metrics", name), params)
+		overrides.LogsTraces.validateTemplates(errs, fmt.Sprintf("platforms.%s.logs+traces", name), params)
+		overrides.MetricsTraces.validateTemplates(errs, fmt.Sprintf("platforms.%s.metrics+traces", name), params)
+		overrides.LogsMetricsTraces.validateTemplates(errs, fmt.Sprintf("platforms.%s.logs+metrics+traces", name), params)
+	}
 }
 
 func (s *ResourceTypeSpec) validateParameterDefinitions(errs validation.Errors) {
 	for _, parameter := range s.Parameters {
+		if parameter.Name == agentTemplateKey {
+			errs.Add(fmt.Errorf("parameter name '%s' is reserved for the agent template context", agentTemplateKey))
+		}
 		parameter.validateDefinition(errs)
 		s.validateParameterRelevantIf(parameter, errs)
 	}
 }
 
+// isRelevant returns true if all of the relevantIf conditions of the parameter are satisfied by the values of the
+// parameters. Parameters without a value use the default value.
+func (s *ResourceTypeSpec) isRelevant(parameter *ParameterDefinition, values map[string]any) bool {
+	for _, relevantIf := range parameter.RelevantIf {
+		value, ok := values[relevantIf.Name]
+		if !ok {
+			if ref := s.ParameterDefinition(relevantIf.Name); ref != nil {
+				value = ref.Default
+			}
+		}
+		if !relevantIf.matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
 // validateParameterRelevantIf in ResourceTypeSpec because we need to check against other parameter names
 func (s *ResourceTypeSpec) validateParameterRelevantIf(parameter ParameterDefinition, errs validation.Errors) {
 	for _, relevantIf := range parameter.RelevantIf {
@@ -270,13 +597,48 @@ func (s *ResourceTypeSpec) validateParameterRelevantIf(parameter ParameterDefini
 			errs.Add(fmt.Errorf("relevantIf '%s' for '%s' must have a value", ref.Name, parameter.Name))
 			continue
 		}
-		err := ref.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
-		if err != nil {
+		if err := ref.validateRelevantIfValue(relevantIf); err != nil {
 			errs.Add(fmt.Errorf("relevantIf '%s' for '%s': %w", ref.Name, parameter.Name, err))
 		}
 	}
 }
 
+// validateRelevantIfValue validates the value of a relevantIf condition that refers to this parameter based on the
+// operator of the condition
+func (p ParameterDefinition) validateRelevantIfValue(relevantIf RelevantIfCondition) error {
+	switch relevantIf.Operator {
+	case relevantIfOperatorEquals, relevantIfOperatorNotEquals, "":
+		return p.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
+
+	case relevantIfOperatorIn, relevantIfOperatorNotIn:
+		values, ok := relevantIf.Value.([]any)
+		if !ok {
+			return fmt.Errorf("value of operator %s must be a list", relevantIf.Operator)
+		}
+		for _, value := range values {
+			if err := p.validateValueType(parameterFieldRelevantIf, value); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case relevantIfOperatorContainsAny:
+		if p.Type != stringsType && p.Type != enumsType {
+			return fmt.Errorf("operator %s requires a parameter of type 'strings' or 'enums'", relevantIf.Operator)
+		}
+		return p.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
+
+	case relevantIfOperatorGreaterThan:
+		if p.Type != intType {
+			return fmt.Errorf("operator %s requires a parameter of type 'int'", relevantIf.Operator)
+		}
+		return p.validateValueType(parameterFieldRelevantIf, relevantIf.Value)
+
+	default:
+		return fmt.Errorf("unknown operator '%s'", relevantIf.Operator)
+	}
+}
+
 func (s ResourceTypeOutput) validateTemplates(errs validation.Errors, name string, params map[string]any) {
 	s.Receivers.validate(errs, fmt.Sprintf("%s.receivers", name), params)
 	s.Processors.validate(errs, fmt.Sprintf("%s.processors", name), params)
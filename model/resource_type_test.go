@@ -26,7 +26,7 @@ import (
 func TestEvalCabinDestination(t *testing.T) {
 	dt := fileResource[*DestinationType](t, "testfiles/destinationtype-cabin.yaml")
 	d := fileResource[*Destination](t, "testfiles/destination-cabin.yaml")
-	values := dt.evalOutput(&dt.Spec.Logs, d, func(e error) {
+	values := dt.evalOutput(&dt.Spec.Logs, d, nil, secretResolver{}, func(e error) {
 		require.NoError(t, e)
 	})
 	require.Len(t, values.Receivers, 0)
@@ -54,7 +54,7 @@ func TestEvalCabinDestination(t *testing.T) {
 func TestEvalGoogleCloud(t *testing.T) {
 	dt := fileResource[*DestinationType](t, "testfiles/destinationtype-googlecloud.yaml")
 	d := fileResource[*Destination](t, "testfiles/destination-googlecloud.yaml")
-	values := dt.eval(d, func(e error) {
+	values := dt.eval(d, nil, secretResolver{}, func(e error) {
 		require.NoError(t, e)
 	})
 	require.Len(t, values[otel.Logs].Receivers, 0)
@@ -71,6 +71,144 @@ func TestEvalGoogleCloud(t *testing.T) {
 	require.Len(t, values[otel.Traces].Extensions, 0)
 }
 
+func TestEvalCustomProcessor(t *testing.T) {
+	pt := fileResource[*ProcessorType](t, "testfiles/processortype-custom.yaml")
+	require.NoError(t, pt.Validate())
+
+	p := NewProcessor("custom-batch", "custom", []Parameter{
+		{
+			Name:  "configuration",
+			Value: "batch:\n  send_batch_size: 200\n  timeout: 5s\n",
+		},
+		{
+			Name: "attributes",
+			Value: []any{
+				map[string]any{"key": "env", "value": "prod"},
+			},
+		},
+	})
+	values := pt.evalOutput(&pt.Spec.LogsMetricsTraces, p, nil, secretResolver{}, func(e error) {
+		require.NoError(t, e)
+	})
+
+	processorsYaml, err := yaml.Marshal(values.Processors)
+	require.NoError(t, err)
+
+	// the text is indented to match the position of the parameter in the template
+	expectYaml := strings.TrimLeft(`
+- batch/custom__custom-batch:
+    send_batch_size: 200
+    timeout: 5s
+- attributes/custom__custom-batch:
+    actions:
+        - action: upsert
+          key: env
+          value: prod
+`, "\n")
+
+	require.Equal(t, expectYaml, string(processorsYaml))
+}
+
+func TestEvalTextPipelines(t *testing.T) {
+	pt := NewProcessorTypeWithSpec("text", ResourceTypeSpec{
+		Parameters: []ParameterDefinition{
+			{Name: "body", Type: "text"},
+			{Name: "key", Type: "text"},
+			{
+				Name:       "keys",
+				Type:       "objects",
+				Default:    []any{map[string]any{"key": "one"}},
+				Properties: []ParameterDefinition{{Name: "key", Type: "string"}},
+			},
+		},
+		LogsMetricsTraces: ResourceTypeOutput{
+			Processors: ResourceTypeTemplate(`
+- transform:
+    quoted: {{ .body | quote }}
+    body:
+      {{ $.body }}
+    keys:
+    {{- range .keys }}
+      - {{ .key }}
+    {{- end }}
+`),
+		},
+	})
+	require.NoError(t, pt.Validate())
+
+	p := NewProcessor("text", "text", []Parameter{
+		{Name: "body", Value: "first: 1\nsecond: 2\n"},
+		// the key of the objects is not the text parameter with the same name
+		{Name: "key", Value: "not\nused"},
+	})
+	values := pt.evalOutput(&pt.Spec.LogsMetricsTraces, p, nil, secretResolver{}, func(e error) {
+		require.NoError(t, e)
+	})
+
+	processorsYaml, err := yaml.Marshal(values.Processors)
+	require.NoError(t, err)
+
+	// text in pipelines is formatted by the template and text output directly is indented
+	expectYaml := strings.TrimLeft(`
+- transform/text__text:
+    body:
+        first: 1
+        second: 2
+    keys:
+        - one
+    quoted: |
+        first: 1
+        second: 2
+`, "\n")
+
+	require.Equal(t, expectYaml, string(processorsYaml))
+}
+
+func TestEvalPlatforms(t *testing.T) {
+	st := fileResource[*SourceType](t, "testfiles/sourcetype-system-logs.yaml")
+	require.NoError(t, st.Validate())
+	src := NewSource("system", "system-logs", []Parameter{{Name: "start_at", Value: "beginning"}})
+
+	receivers := func(platform string) []otel.ComponentID {
+		values := st.eval(src, &Agent{Platform: platform}, secretResolver{}, func(e error) {
+			require.NoError(t, e)
+		})
+		ids := []otel.ComponentID{}
+		for _, pipelineType := range []otel.PipelineType{otel.Logs, otel.Metrics} {
+			for _, receiver := range values[pipelineType].Receivers {
+				for id := range receiver {
+					ids = append(ids, id)
+				}
+			}
+		}
+		return ids
+	}
+
+	journald := otel.NewComponentID("journald", "system-logs__system")
+	eventlog := otel.NewComponentID("windowseventlog", "system-logs__system")
+	hostmetrics := otel.NewComponentID("hostmetrics", "system-logs__system")
+
+	// templates that are not overridden for the platform are unchanged
+	require.Equal(t, []otel.ComponentID{journald, hostmetrics}, receivers(""))
+	require.Equal(t, []otel.ComponentID{journald, hostmetrics}, receivers("linux"))
+	require.Equal(t, []otel.ComponentID{eventlog, hostmetrics}, receivers("windows"))
+}
+
+func TestSupportsPlatform(t *testing.T) {
+	st := fileResource[*SourceType](t, "testfiles/sourcetype-system-logs.yaml")
+	require.True(t, st.Spec.SupportsPlatform("linux"))
+	require.True(t, st.Spec.SupportsPlatform("Windows"))
+	require.True(t, st.Spec.SupportsPlatform(""), "agents without a platform are supported")
+	require.False(t, st.Spec.SupportsPlatform("darwin"))
+
+	macos := fileResource[*SourceType](t, "testfiles/sourcetype-macos.yaml")
+	require.True(t, macos.Spec.SupportsPlatform("darwin"), "darwin is known as macos")
+	require.False(t, macos.Spec.SupportsPlatform("linux"))
+
+	otlp := fileResource[*SourceType](t, "testfiles/sourcetype-otlp.yaml")
+	require.True(t, otlp.Spec.SupportsPlatform("linux"), "all platforms are supported without supportedPlatforms")
+}
+
 func TestTelemetryTypes(t *testing.T) {
 	macosSourceType := fileResource[*SourceType](t, "testfiles/sourcetype-macos.yaml")
 	otlpSourceType := fileResource[*SourceType](t, "testfiles/sourcetype-otlp.yaml")
@@ -14,6 +14,8 @@
 
 package model
 
+import "time"
+
 // AgentResponse is the REST API response to GET /v1/agent/:name
 type AgentResponse struct {
 	Agent *Agent `json:"agent"`
@@ -32,6 +34,9 @@ type DeleteAgentsPayload struct {
 // DeleteAgentsResponse is the REST API response to DELETE /v1/agents
 type DeleteAgentsResponse = AgentsResponse
 
+// AgentDuplicatesResponse is the REST API response to GET /v1/agents/duplicates
+type AgentDuplicatesResponse = AgentsResponse
+
 // AgentLabelsResponse is the REST API response to GET /v1/agents/{id}/labels
 type AgentLabelsResponse struct {
 	Errors []string `json:"errors"`
@@ -64,6 +69,10 @@ type ConfigurationsResponse struct {
 type ConfigurationResponse struct {
 	Configuration *Configuration `json:"configuration"`
 	Raw           string         `json:"raw"`
+
+	// Overrides are the parameter overrides of the configuration that apply to the agent for GET
+	// /v1/agents/:id/configuration
+	Overrides []ParameterOverride `json:"overrides,omitempty"`
 }
 
 // SourcesResponse is the REST API response to GET /v1/sources
@@ -126,6 +135,36 @@ type DestinationTypeResponse struct {
 	DestinationType *DestinationType `json:"destinationType"`
 }
 
+// ConfigurationTemplatesResponse is the REST API response to GET /v1/configuration-templates
+type ConfigurationTemplatesResponse struct {
+	ConfigurationTemplates []*ConfigurationTemplate `json:"configurationTemplates"`
+}
+
+// ConfigurationTemplateResponse is the REST API response to GET /v1/configuration-templates/:name
+type ConfigurationTemplateResponse struct {
+	ConfigurationTemplate *ConfigurationTemplate `json:"configurationTemplate"`
+}
+
+// ConfigurationInstancesResponse is the REST API response to GET /v1/configuration-instances
+type ConfigurationInstancesResponse struct {
+	ConfigurationInstances []*ConfigurationInstance `json:"configurationInstances"`
+}
+
+// ConfigurationInstanceResponse is the REST API response to GET /v1/configuration-instances/:name
+type ConfigurationInstanceResponse struct {
+	ConfigurationInstance *ConfigurationInstance `json:"configurationInstance"`
+}
+
+// LabelRulesResponse is the REST API response to GET /v1/label-rules
+type LabelRulesResponse struct {
+	LabelRules []*LabelRule `json:"labelRules"`
+}
+
+// LabelRuleResponse is the REST API response to GET /v1/label-rules/:name
+type LabelRuleResponse struct {
+	LabelRule *LabelRule `json:"labelRule"`
+}
+
 // ApplyResponse is the REST API response to POST /v1/apply.  This is used on
 // the server side to return updates consisting of generic ResourceStatuses.
 type ApplyResponse struct {
@@ -180,3 +219,66 @@ type PostDuplicateConfigRequest struct {
 
 // PostDuplicateConfigResponse is the REST API response to PUT /v1/configurations/{name}/duplicate
 type PostDuplicateConfigResponse = PostDuplicateConfigRequest
+
+// FlowControlResponse is the REST API response to PUT /v1/configurations/{name}/sources/{component}/flow-control and
+// PUT /v1/configurations/{name}/destinations/{component}/flow-control
+type FlowControlResponse struct {
+	FlowControl *FlowControl `json:"flowControl"`
+	Status      UpdateStatus `json:"status"`
+}
+
+// AgentAdmissionResponse is the REST API response to GET /v1/opamp/admission, POST /v1/opamp/drain, and DELETE
+// /v1/opamp/drain. It describes the admission control of agent connections on the server handling the request.
+type AgentAdmissionResponse struct {
+	// Pending is the number of agent connections being established
+	Pending int `json:"pending"`
+	// MaxPending is the maximum number of pending connections, 0 is unlimited
+	MaxPending int `json:"maxPending"`
+	// Rate is the maximum number of new connections accepted per second, 0 is unlimited
+	Rate float64 `json:"rate"`
+	// Admitted is the number of connections admitted since the server started
+	Admitted int64 `json:"admitted"`
+	// Rejected is the number of connections rejected since the server started by reason: rate, pending, or draining
+	Rejected map[string]int64 `json:"rejected"`
+	// Draining is true if agents are being asked to reconnect to another server
+	Draining bool `json:"draining"`
+	// Asked is the number of connected agents that have been asked to reconnect by the current drain
+	Asked int `json:"asked"`
+	// DrainEnds is the time when all connected agents will have been asked to reconnect
+	DrainEnds *time.Time `json:"drainEnds,omitempty"`
+}
+
+// PostDrainRequest is the REST API body for POST /v1/opamp/drain
+type PostDrainRequest struct {
+	// Duration is the time over which connected agents are asked to reconnect, e.g. 10m
+	Duration string `json:"duration"`
+}
+
+// PostMigrateRequest is the REST API body for POST /v1/opamp/migrate. The connection settings are offered to the
+// connected agents matching the selector or to all connected agents if All is set.
+type PostMigrateRequest struct {
+	// Selector selects the agents, e.g. env=production. It is required unless All is set.
+	Selector string `json:"selector"`
+	// All selects all connected agents and cannot be used with Selector
+	All bool `json:"all,omitempty"`
+	// Endpoint is the OpAMP endpoint, e.g. wss://bindplane.example.com/v1/opamp. It defaults to the endpoint of the
+	// server handling the request.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Headers are sent by the agent when it connects, e.g. Authorization
+	Headers map[string]string `json:"headers,omitempty"`
+	// SecretKey is sent by the agent in the Authorization header when it connects
+	SecretKey string `json:"secretKey,omitempty"`
+	// Certificate, PrivateKey, and CertificateAuthority are the PEM-encoded client certificate used by the agent to
+	// connect and the certificate authority that signed it
+	Certificate          string `json:"certificate,omitempty"`
+	PrivateKey           string `json:"privateKey,omitempty"`
+	CertificateAuthority string `json:"certificateAuthority,omitempty"`
+}
+
+// MigrateResponse is the REST API response to POST /v1/opamp/migrate
+type MigrateResponse struct {
+	// Offered are the IDs of the agents sent the connection settings
+	Offered []string `json:"offered"`
+	// Skipped is the reason that each selected agent was not sent the connection settings by agent ID
+	Skipped map[string]string `json:"skipped,omitempty"`
+}
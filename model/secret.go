@@ -0,0 +1,526 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// RedactedSecret replaces the value of secret parameters in API responses and in configurations rendered without
+	// access to the keyring. Applying a resource with a secret parameter value of RedactedSecret keeps the secret that
+	// is already stored. It is a plain yaml scalar so that rendered configurations remain valid yaml.
+	RedactedSecret = "REDACTED"
+
+	// encryptedSecretPrefix identifies encrypted secret values, which have the form prefix:keyID:ciphertext
+	encryptedSecretPrefix = "bpsecret:v1:"
+)
+
+// ErrSecretKeyMissing is returned when a secret was encrypted with a key that is not part of the SecretKeyring
+var ErrSecretKeyMissing = errors.New("secret was encrypted with an unknown key")
+
+// SecretKeyring encrypts and decrypts the values of secret parameters. Secrets are always encrypted with the current
+// key and can be decrypted with the current key or any of the previous keys. To rotate the key, add the current key to
+// the previous keys and specify a new current key. Secrets encrypted with a previous key are re-encrypted with the
+// current key when the resource is applied.
+type SecretKeyring struct {
+	current *secretKey
+	keys    map[string]*secretKey
+}
+
+type secretKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewSecretKeyring creates a new SecretKeyring with the current key used for encryption and previous keys that can
+// still be used for decryption.
+func NewSecretKeyring(current string, previous ...string) (*SecretKeyring, error) {
+	if current == "" {
+		return nil, errors.New("secret encryption key must be specified")
+	}
+	keyring := &SecretKeyring{
+		keys: map[string]*secretKey{},
+	}
+	for i, passphrase := range append([]string{current}, previous...) {
+		key, err := newSecretKey(passphrase)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			keyring.current = key
+		}
+		if _, ok := keyring.keys[key.id]; !ok {
+			keyring.keys[key.id] = key
+		}
+	}
+	return keyring, nil
+}
+
+func newSecretKey(passphrase string) (*secretKey, error) {
+	// the passphrase is hashed to produce a 256 bit AES key and hashed again to produce an id for the key that does
+	// not reveal the key itself
+	key := sha256.Sum256([]byte(passphrase))
+	id := sha256.Sum256(key[:])
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &secretKey{
+		id:   hex.EncodeToString(id[:4]),
+		aead: aead,
+	}, nil
+}
+
+// Encrypt encrypts the plaintext with the current key
+func (k *SecretKeyring) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, k.current.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("unable to encrypt secret: %w", err)
+	}
+	sealed := k.current.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return fmt.Sprintf("%s%s:%s", encryptedSecretPrefix, k.current.id, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+// Decrypt decrypts a value encrypted by Encrypt with any key in the keyring
+func (k *SecretKeyring) Decrypt(value string) (string, error) {
+	key, sealed, err := k.parse(value)
+	if err != nil {
+		return "", err
+	}
+	nonceSize := key.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", errors.New("unable to decrypt secret: invalid ciphertext")
+	}
+	plaintext, err := key.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt secret: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation returns true if the value was encrypted with a key other than the current key
+func (k *SecretKeyring) NeedsRotation(value string) bool {
+	keyID, _, _ := splitEncryptedSecret(value)
+	return keyID != k.current.id
+}
+
+func (k *SecretKeyring) parse(value string) (*secretKey, []byte, error) {
+	keyID, encoded, ok := splitEncryptedSecret(value)
+	if !ok {
+		return nil, nil, errors.New("unable to decrypt secret: value is not encrypted")
+	}
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, nil, ErrSecretKeyMissing
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decrypt secret: %w", err)
+	}
+	return key, sealed, nil
+}
+
+func splitEncryptedSecret(value string) (keyID string, encoded string, ok bool) {
+	if !strings.HasPrefix(value, encryptedSecretPrefix) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(value, encryptedSecretPrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// IsEncryptedSecret returns true if the value is a secret encrypted by a SecretKeyring
+func IsEncryptedSecret(value any) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	_, _, ok = splitEncryptedSecret(s)
+	return ok
+}
+
+// RedactedValue returns RedactedSecret if the value is an encrypted secret and returns the value unchanged otherwise
+func RedactedValue(value any) any {
+	if IsEncryptedSecret(value) {
+		return RedactedSecret
+	}
+	return value
+}
+
+// ----------------------------------------------------------------------
+// encryption
+
+// EncryptSecrets encrypts the values of secret parameters of a Source, Processor, Destination, or Configuration in
+// place, using the store to find the parameter definitions. Values encrypted with a previous key are re-encrypted with
+// the current key. If the value of a secret parameter is RedactedSecret, the value of the same parameter in the
+// previous version of the resource is kept. It returns true if any values were changed.
+func EncryptSecrets(resource Resource, previous Resource, store ResourceStore, keyring *SecretKeyring) (bool, error) {
+	e := &secretEncrypter{store: store, keyring: keyring}
+	switch r := resource.(type) {
+	case *Source:
+		e.encryptSpec(KindSource, r.Name(), &r.Spec, parameterizedSpec(previous))
+	case *Processor:
+		e.encryptSpec(KindProcessor, r.Name(), &r.Spec, parameterizedSpec(previous))
+	case *Destination:
+		e.encryptSpec(KindDestination, r.Name(), &r.Spec, parameterizedSpec(previous))
+	case *Configuration:
+		var prevSpec ConfigurationSpec
+		if prev, ok := previous.(*Configuration); ok && prev != nil {
+			prevSpec = prev.Spec
+		}
+		e.encryptResourceConfigurations(KindSource, r.Spec.Sources, prevSpec.Sources)
+		e.encryptResourceConfigurations(KindDestination, r.Spec.Destinations, prevSpec.Destinations)
+		e.encryptResourceConfigurations(KindProcessor, r.Spec.Processors, prevSpec.Processors)
+		e.encryptOverrides(&r.Spec, prevSpec.Overrides)
+		e.encryptReplacements(r, prevSpec.Replace)
+	}
+	return e.changed, e.errs
+}
+
+type secretEncrypter struct {
+	store   ResourceStore
+	keyring *SecretKeyring
+	changed bool
+	errs    error
+}
+
+// parameterizedSpec returns the spec of a Source, Processor, or Destination or nil for any other resource
+func parameterizedSpec(resource Resource) *ParameterizedSpec {
+	switch r := resource.(type) {
+	case *Source:
+		if r != nil {
+			return &r.Spec
+		}
+	case *Processor:
+		if r != nil {
+			return &r.Spec
+		}
+	case *Destination:
+		if r != nil {
+			return &r.Spec
+		}
+	}
+	return nil
+}
+
+func (e *secretEncrypter) encryptSpec(kind Kind, name string, spec *ParameterizedSpec, prev *ParameterizedSpec) {
+	var prevParameters []Parameter
+	var prevProcessors []ResourceConfiguration
+	if prev != nil {
+		prevParameters, prevProcessors = prev.Parameters, prev.Processors
+	}
+	rc := &ResourceConfiguration{Type: spec.Type, Parameters: spec.Parameters}
+	_, resourceType, err := findResourceAndType(kind, rc, name, e.store)
+	if err != nil || resourceType == nil {
+		// validation will report the unknown type
+		return
+	}
+	e.encryptParameters(resourceType, spec.Parameters, prevParameters)
+	e.encryptResourceConfigurations(KindProcessor, spec.Processors, prevProcessors)
+}
+
+func (e *secretEncrypter) encryptResourceConfigurations(kind Kind, resources []ResourceConfiguration, prev []ResourceConfiguration) {
+	for i := range resources {
+		var prevParameters []Parameter
+		var prevProcessors []ResourceConfiguration
+		// inline resources are matched with the previous version of the configuration by position
+		if i < len(prev) {
+			prevParameters, prevProcessors = prev[i].Parameters, prev[i].Processors
+		}
+		rc := &resources[i]
+		_, resourceType, err := findResourceAndType(kind, rc, string(kind), e.store)
+		if err == nil && resourceType != nil {
+			e.encryptParameters(resourceType, rc.Parameters, prevParameters)
+		}
+		e.encryptResourceConfigurations(KindProcessor, rc.Processors, prevProcessors)
+	}
+}
+
+func (e *secretEncrypter) encryptOverrides(spec *ConfigurationSpec, prev []ParameterOverride) {
+	for i, override := range spec.Overrides {
+		var prevParameters []Parameter
+		// overrides are matched with the previous version of the configuration by position
+		if i < len(prev) {
+			prevParameters = prev[i].Parameters
+		}
+		kind, name := override.target()
+		target := spec.resourceConfiguration(kind, name)
+		if target == nil {
+			// validation will report the unknown source or destination
+			continue
+		}
+		_, resourceType, err := findResourceAndType(kind, target, string(kind), e.store)
+		if err == nil && resourceType != nil {
+			e.encryptParameters(resourceType, override.Parameters, prevParameters)
+		}
+	}
+}
+
+func (e *secretEncrypter) encryptReplacements(configuration *Configuration, prev []InheritedParameters) {
+	if len(configuration.Spec.Replace) == 0 {
+		return
+	}
+	// resolving only the base configurations keeps the inherited sources and destinations at the positions used to
+	// reference them in Replace
+	inherited, err := NewConfigurationWithSpec(configuration.Name(), ConfigurationSpec{Extends: configuration.Spec.Extends}).Resolve(e.store)
+	if err != nil {
+		// validation will report the missing or invalid base configuration
+		return
+	}
+	for i, replacement := range configuration.Spec.Replace {
+		var prevParameters []Parameter
+		if i < len(prev) {
+			prevParameters = prev[i].Parameters
+		}
+		kind, name := KindSource, replacement.Source
+		if name == "" {
+			kind, name = KindDestination, replacement.Destination
+		}
+		target := inherited.Spec.resourceConfiguration(kind, name)
+		if target == nil {
+			continue
+		}
+		_, resourceType, err := findResourceAndType(kind, target, string(kind), e.store)
+		if err == nil && resourceType != nil {
+			e.encryptParameters(resourceType, replacement.Parameters, prevParameters)
+		}
+	}
+}
+
+func (e *secretEncrypter) encryptParameters(resourceType *ResourceType, parameters []Parameter, prev []Parameter) {
+	for i, parameter := range parameters {
+		def := resourceType.Spec.ParameterDefinition(parameter.Name)
+		if def == nil || def.Type != secretType {
+			continue
+		}
+		value, ok := parameter.Value.(string)
+		if !ok || IsSecretReference(value) {
+			// a reference only names the secret, it is resolved when the configuration is rendered
+			continue
+		}
+		encrypted, err := e.encryptValue(parameter.Name, value, prev)
+		if err != nil {
+			e.errs = multierror.Append(e.errs, err)
+			continue
+		}
+		if encrypted != value {
+			parameters[i].Value = encrypted
+			e.changed = true
+		}
+	}
+}
+
+func (e *secretEncrypter) encryptValue(name string, value string, prev []Parameter) (string, error) {
+	previous := previousSecret(name, prev)
+	if value == RedactedSecret {
+		// keep the existing secret
+		if previous == "" {
+			return "", fmt.Errorf("parameter %s is redacted and there is no existing secret to keep", name)
+		}
+		value = previous
+	}
+	if !IsEncryptedSecret(value) {
+		// keep the existing ciphertext if the secret has not changed so that applying the same resource again does not
+		// modify it
+		if previous != "" && !e.keyring.NeedsRotation(previous) {
+			if plaintext, err := e.keyring.Decrypt(previous); err == nil && plaintext == value {
+				return previous, nil
+			}
+		}
+		return e.keyring.Encrypt(value)
+	}
+	if !e.keyring.NeedsRotation(value) {
+		return value, nil
+	}
+	// re-encrypt with the current key
+	plaintext, err := e.keyring.Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("parameter %s: %w", name, err)
+	}
+	return e.keyring.Encrypt(plaintext)
+}
+
+// previousSecret returns the value of the named secret parameter in the previous version of the resource or "" if
+// there is none
+func previousSecret(name string, prev []Parameter) string {
+	for _, p := range prev {
+		if p.Name == name {
+			value, _ := p.Value.(string)
+			return value
+		}
+	}
+	return ""
+}
+
+// RotateSecrets returns a copy of a Source, Processor, Destination, or Configuration with the values of secrets
+// encrypted with a previous key re-encrypted with the current key. It returns true if any values were re-encrypted.
+// The original resource is not modified.
+func RotateSecrets[T Resource](resource T, store ResourceStore, keyring *SecretKeyring) (T, bool, error) {
+	rotated := mapParameters(resource, copyParameters)
+	changed, err := EncryptSecrets(rotated, resource, store, keyring)
+	return rotated, changed, err
+}
+
+// ----------------------------------------------------------------------
+// redaction
+
+// RedactSecrets returns a copy of a Source, Processor, Destination, or Configuration with the values of encrypted
+// secrets replaced by RedactedSecret. Other resources are returned unchanged.
+func RedactSecrets[T Resource](resource T) T {
+	return mapParameters(resource, redactParameters)
+}
+
+// RedactAllSecrets returns a copy of the list of resources with secrets redacted by RedactSecrets
+func RedactAllSecrets[T Resource](resources []T) []T {
+	if resources == nil {
+		return nil
+	}
+	result := make([]T, len(resources))
+	for i, resource := range resources {
+		result[i] = RedactSecrets(resource)
+	}
+	return result
+}
+
+// RedactResourceStatusSecrets returns a copy of the list of resource statuses with secrets redacted by RedactSecrets
+func RedactResourceStatusSecrets(statuses []ResourceStatus) []ResourceStatus {
+	result := make([]ResourceStatus, len(statuses))
+	for i, status := range statuses {
+		result[i] = status
+		result[i].Resource = RedactSecrets(status.Resource)
+	}
+	return result
+}
+
+func redactParameters(parameters []Parameter) []Parameter {
+	if parameters == nil {
+		return nil
+	}
+	result := make([]Parameter, len(parameters))
+	for i, p := range parameters {
+		result[i] = Parameter{Name: p.Name, Value: RedactedValue(p.Value)}
+	}
+	return result
+}
+
+func copyParameters(parameters []Parameter) []Parameter {
+	if parameters == nil {
+		return nil
+	}
+	result := make([]Parameter, len(parameters))
+	copy(result, parameters)
+	return result
+}
+
+// ----------------------------------------------------------------------
+
+// mapParameters returns a copy of a Source, Processor, Destination, or Configuration with every list of parameters
+// replaced by the result of the mapper. Other resources are returned unchanged.
+func mapParameters[T Resource](resource T, mapper func([]Parameter) []Parameter) T {
+	var result any = resource
+	switch r := any(resource).(type) {
+	case *Source:
+		if r != nil {
+			mapped := *r
+			mapped.Spec = r.Spec.mapParameters(mapper)
+			result = &mapped
+		}
+	case *Processor:
+		if r != nil {
+			mapped := *r
+			mapped.Spec = r.Spec.mapParameters(mapper)
+			result = &mapped
+		}
+	case *Destination:
+		if r != nil {
+			mapped := *r
+			mapped.Spec = r.Spec.mapParameters(mapper)
+			result = &mapped
+		}
+	case *Configuration:
+		if r != nil {
+			mapped := *r
+			mapped.Spec.Sources = mapResourceConfigurationParameters(r.Spec.Sources, mapper)
+			mapped.Spec.Destinations = mapResourceConfigurationParameters(r.Spec.Destinations, mapper)
+			mapped.Spec.Processors = mapResourceConfigurationParameters(r.Spec.Processors, mapper)
+			mapped.Spec.Overrides = mapOverrideParameters(r.Spec.Overrides, mapper)
+			mapped.Spec.Replace = mapReplacementParameters(r.Spec.Replace, mapper)
+			result = &mapped
+		}
+	}
+	return result.(T)
+}
+
+func (s ParameterizedSpec) mapParameters(mapper func([]Parameter) []Parameter) ParameterizedSpec {
+	s.Parameters = mapper(s.Parameters)
+	s.Processors = mapResourceConfigurationParameters(s.Processors, mapper)
+	return s
+}
+
+func mapResourceConfigurationParameters(resources []ResourceConfiguration, mapper func([]Parameter) []Parameter) []ResourceConfiguration {
+	if resources == nil {
+		return nil
+	}
+	result := make([]ResourceConfiguration, len(resources))
+	for i, rc := range resources {
+		result[i] = rc
+		result[i].Parameters = mapper(rc.Parameters)
+		result[i].Processors = mapResourceConfigurationParameters(rc.Processors, mapper)
+	}
+	return result
+}
+
+func mapOverrideParameters(overrides []ParameterOverride, mapper func([]Parameter) []Parameter) []ParameterOverride {
+	if overrides == nil {
+		return nil
+	}
+	result := make([]ParameterOverride, len(overrides))
+	for i, override := range overrides {
+		result[i] = override
+		result[i].Parameters = mapper(override.Parameters)
+	}
+	return result
+}
+
+func mapReplacementParameters(replacements []InheritedParameters, mapper func([]Parameter) []Parameter) []InheritedParameters {
+	if replacements == nil {
+		return nil
+	}
+	result := make([]InheritedParameters, len(replacements))
+	for i, replacement := range replacements {
+		result[i] = replacement
+		result[i].Parameters = mapper(replacement.Parameters)
+	}
+	return result
+}
@@ -0,0 +1,213 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// SecretProvider resolves references to secrets that are stored outside of BindPlane. A secret parameter value of the
+// form ${bindplane:provider:name} references the secret with the specified name in the provider registered with that
+// prefix. The bindplane: prefix distinguishes references from the ${env:NAME} syntax expanded by the agent.
+type SecretProvider interface {
+	// Resolve returns the value of the secret with the specified name or an error if it does not exist
+	Resolve(name string) (string, error)
+}
+
+// SecretProviders maps the prefix used in secret references to the SecretProvider that resolves them
+type SecretProviders map[string]SecretProvider
+
+const (
+	// EnvSecretProviderName is the prefix of references resolved by EnvSecretProvider, e.g. ${bindplane:env:NAME}
+	EnvSecretProviderName = "env"
+
+	// FileSecretProviderName is the prefix of references resolved by FileSecretProvider, e.g. ${bindplane:file:/path}
+	FileSecretProviderName = "file"
+)
+
+// NewSecretProviders returns the SecretProviders enabled by the server configuration. Environment variables can be
+// referenced if their names start with one of the envPrefixes and files can be referenced if they are in one of the
+// directories in pathPrefixes. A provider without prefixes is not enabled.
+func NewSecretProviders(envPrefixes []string, pathPrefixes []string) SecretProviders {
+	providers := SecretProviders{}
+	if len(envPrefixes) > 0 {
+		providers[EnvSecretProviderName] = &EnvSecretProvider{AllowedPrefixes: envPrefixes}
+	}
+	if len(pathPrefixes) > 0 {
+		providers[FileSecretProviderName] = &FileSecretProvider{AllowedPaths: pathPrefixes}
+	}
+	return providers
+}
+
+// EnvSecretProvider resolves secrets from environment variables of the server
+type EnvSecretProvider struct {
+	// AllowedPrefixes are the prefixes of the names of environment variables that can be referenced, e.g.
+	// BINDPLANE_SECRET_. Other environment variables, like the configuration of the server, cannot be referenced.
+	AllowedPrefixes []string
+
+	// LookupEnv is used to find environment variables. If nil, os.LookupEnv is used.
+	LookupEnv func(name string) (string, bool)
+}
+
+var _ SecretProvider = (*EnvSecretProvider)(nil)
+
+// Resolve returns the value of the environment variable with the specified name
+func (p *EnvSecretProvider) Resolve(name string) (string, error) {
+	if slices.IndexFunc(p.AllowedPrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) < 0 {
+		return "", fmt.Errorf("environment variable %s is not allowed", name)
+	}
+	lookupEnv := p.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+	value, ok := lookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// FileSecretProvider resolves secrets from the contents of files on the server. Trailing newlines are removed from the
+// contents.
+type FileSecretProvider struct {
+	// AllowedPaths are the directories containing files that can be referenced, e.g. /etc/bindplane/secrets
+	AllowedPaths []string
+
+	// ReadFile is used to read files. If nil, os.ReadFile is used.
+	ReadFile func(path string) ([]byte, error)
+}
+
+var _ SecretProvider = (*FileSecretProvider)(nil)
+
+// Resolve returns the contents of the file at the specified absolute path
+func (p *FileSecretProvider) Resolve(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("file %s must be an absolute path", path)
+	}
+	if slices.IndexFunc(p.AllowedPaths, func(dir string) bool { return pathInDirectory(path, dir) }) < 0 {
+		return "", fmt.Errorf("file %s is not allowed", path)
+	}
+	readFile := p.ReadFile
+	if readFile == nil {
+		readFile = os.ReadFile
+	}
+	contents, err := readFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(contents), "\r\n"), nil
+}
+
+// pathInDirectory returns true if the cleaned path is in the directory or one of its subdirectories
+func pathInDirectory(path, dir string) bool {
+	rel, err := filepath.Rel(filepath.Clean(dir), filepath.Clean(path))
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// ----------------------------------------------------------------------
+
+// SecretReference is a reference to a secret in a parameter value, e.g. ${bindplane:env:NAME}
+type SecretReference struct {
+	Provider string
+	Name     string
+}
+
+var secretReferenceRegexp = regexp.MustCompile(`\$\{bindplane:([a-zA-Z][a-zA-Z0-9_]*):([^}]+)\}`)
+
+func (r SecretReference) String() string {
+	return fmt.Sprintf("${bindplane:%s:%s}", r.Provider, r.Name)
+}
+
+// SecretReferences returns the secret references contained in a parameter value. Only string values can contain
+// references.
+func SecretReferences(value any) []SecretReference {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	var references []SecretReference
+	for _, match := range secretReferenceRegexp.FindAllStringSubmatch(s, -1) {
+		references = append(references, SecretReference{Provider: match[1], Name: match[2]})
+	}
+	return references
+}
+
+// HasSecretReferences returns true if the parameter value contains any secret references
+func HasSecretReferences(value any) bool {
+	s, ok := value.(string)
+	return ok && secretReferenceRegexp.MatchString(s)
+}
+
+// IsSecretReference returns true if the entire parameter value is a single secret reference. Such values do not
+// contain secrets themselves, so secret parameters with these values are stored and displayed without encryption.
+func IsSecretReference(value any) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	loc := secretReferenceRegexp.FindStringIndex(s)
+	return loc != nil && loc[0] == 0 && loc[1] == len(s)
+}
+
+// resolve replaces all of the secret references in the value with the values of the secrets
+func (p SecretProviders) resolve(value string) (string, error) {
+	var errs []string
+	result := secretReferenceRegexp.ReplaceAllStringFunc(value, func(match string) string {
+		reference := SecretReferences(match)[0]
+		resolved, err := p.resolveReference(reference)
+		if err != nil {
+			errs = append(errs, err.Error())
+			return match
+		}
+		return resolved
+	})
+	if len(errs) > 0 {
+		return "", errors.New(strings.Join(errs, ", "))
+	}
+	return result, nil
+}
+
+func (p SecretProviders) resolveReference(reference SecretReference) (string, error) {
+	provider, ok := p[reference.Provider]
+	if !ok {
+		return "", fmt.Errorf("secret provider %s is not enabled in %s", reference.Provider, reference)
+	}
+	value, err := provider.Resolve(reference.Name)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve %s: %w", reference, err)
+	}
+	return value, nil
+}
+
+// ----------------------------------------------------------------------
+
+// parameterReferences returns name=reference descriptions of the parameters with secret references, used for printing
+// a table of resources. Secret parameters that are a single reference are stored without encryption and are included.
+func parameterReferences(parameters []Parameter) string {
+	var references []string
+	for _, p := range parameters {
+		if HasSecretReferences(p.Value) {
+			references = append(references, fmt.Sprintf("%s=%v", p.Name, p.Value))
+		}
+	}
+	return strings.Join(references, ",")
+}
@@ -0,0 +1,264 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/stretchr/testify/require"
+)
+
+func testSecretProviders() SecretProviders {
+	env := map[string]string{
+		"CABIN_SECRET_KEY": testSecretKey,
+		"BINDPLANE_SECRET": "server",
+	}
+	files := map[string]string{
+		"/etc/bindplane/cabin-key": testSecretKey + "\n",
+		"/etc/passwd":              "root",
+	}
+	return SecretProviders{
+		EnvSecretProviderName: &EnvSecretProvider{
+			AllowedPrefixes: []string{"CABIN_"},
+			LookupEnv: func(name string) (string, bool) {
+				value, ok := env[name]
+				return value, ok
+			},
+		},
+		FileSecretProviderName: &FileSecretProvider{
+			AllowedPaths: []string{"/etc/bindplane"},
+			ReadFile: func(path string) ([]byte, error) {
+				value, ok := files[path]
+				if !ok {
+					return nil, errors.New("file not found")
+				}
+				return []byte(value), nil
+			},
+		},
+	}
+}
+
+func TestNewSecretProviders(t *testing.T) {
+	require.Empty(t, NewSecretProviders(nil, nil))
+
+	providers := NewSecretProviders([]string{"CABIN_"}, nil)
+	require.Contains(t, providers, EnvSecretProviderName)
+	require.NotContains(t, providers, FileSecretProviderName)
+
+	providers = NewSecretProviders(nil, []string{"/etc/bindplane"})
+	require.NotContains(t, providers, EnvSecretProviderName)
+	require.Contains(t, providers, FileSecretProviderName)
+}
+
+func TestSecretReferences(t *testing.T) {
+	tests := []struct {
+		value  any
+		expect []SecretReference
+	}{
+		{
+			value:  "plain",
+			expect: nil,
+		},
+		{
+			value:  1,
+			expect: nil,
+		},
+		{
+			value:  "${bindplane:env:NAME}",
+			expect: []SecretReference{{Provider: "env", Name: "NAME"}},
+		},
+		{
+			value: "${bindplane:file:/etc/user}:${bindplane:vault:secret/data/password}",
+			expect: []SecretReference{
+				{Provider: "file", Name: "/etc/user"},
+				{Provider: "vault", Name: "secret/data/password"},
+			},
+		},
+		{
+			// expanded by the agent
+			value:  "${env:NAME}",
+			expect: nil,
+		},
+		{
+			value:  "${NAME}",
+			expect: nil,
+		},
+	}
+	for _, test := range tests {
+		references := SecretReferences(test.value)
+		require.Equal(t, test.expect, references)
+		require.Equal(t, len(test.expect) > 0, HasSecretReferences(test.value))
+	}
+}
+
+func TestIsSecretReference(t *testing.T) {
+	require.True(t, IsSecretReference("${bindplane:env:NAME}"))
+	require.False(t, IsSecretReference("secret-${bindplane:env:NAME}"))
+	require.False(t, IsSecretReference("${bindplane:env:USER}:${bindplane:env:PASSWORD}"))
+	require.False(t, IsSecretReference("plain"))
+	require.False(t, IsSecretReference(1))
+}
+
+func TestSecretProvidersResolve(t *testing.T) {
+	providers := testSecretProviders()
+
+	tests := []struct {
+		name        string
+		value       string
+		expect      string
+		expectError string
+	}{
+		{
+			name:   "env",
+			value:  "${bindplane:env:CABIN_SECRET_KEY}",
+			expect: testSecretKey,
+		},
+		{
+			name:   "file without trailing newline",
+			value:  "${bindplane:file:/etc/bindplane/cabin-key}",
+			expect: testSecretKey,
+		},
+		{
+			name:   "embedded reference",
+			value:  "Bearer ${bindplane:env:CABIN_SECRET_KEY}",
+			expect: "Bearer " + testSecretKey,
+		},
+		{
+			name:   "agent reference",
+			value:  "${env:CABIN_SECRET_KEY}",
+			expect: "${env:CABIN_SECRET_KEY}",
+		},
+		{
+			name:        "missing env",
+			value:       "${bindplane:env:CABIN_MISSING}",
+			expectError: "unable to resolve ${bindplane:env:CABIN_MISSING}: environment variable CABIN_MISSING is not set",
+		},
+		{
+			name:        "env not allowed",
+			value:       "${bindplane:env:BINDPLANE_SECRET}",
+			expectError: "unable to resolve ${bindplane:env:BINDPLANE_SECRET}: environment variable BINDPLANE_SECRET is not allowed",
+		},
+		{
+			name:        "relative file",
+			value:       "${bindplane:file:cabin-key}",
+			expectError: "unable to resolve ${bindplane:file:cabin-key}: file cabin-key must be an absolute path",
+		},
+		{
+			name:        "file not allowed",
+			value:       "${bindplane:file:/etc/passwd}",
+			expectError: "unable to resolve ${bindplane:file:/etc/passwd}: file /etc/passwd is not allowed",
+		},
+		{
+			name:        "file outside of allowed path",
+			value:       "${bindplane:file:/etc/bindplane/../passwd}",
+			expectError: "unable to resolve ${bindplane:file:/etc/bindplane/../passwd}: file /etc/bindplane/../passwd is not allowed",
+		},
+		{
+			name:        "file with allowed path prefix",
+			value:       "${bindplane:file:/etc/bindplane-other/key}",
+			expectError: "unable to resolve ${bindplane:file:/etc/bindplane-other/key}: file /etc/bindplane-other/key is not allowed",
+		},
+		{
+			name:        "provider not enabled",
+			value:       "${bindplane:vault:secret}",
+			expectError: "secret provider vault is not enabled in ${bindplane:vault:secret}",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resolved, err := providers.resolve(test.value)
+			if test.expectError != "" {
+				require.EqualError(t, err, test.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expect, resolved)
+		})
+	}
+}
+
+func TestFileSecretProvider(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(path, []byte("password\n"), 0600))
+
+	provider := &FileSecretProvider{AllowedPaths: []string{dir}}
+	value, err := provider.Resolve(path)
+	require.NoError(t, err)
+	require.Equal(t, "password", value)
+
+	provider = &FileSecretProvider{}
+	_, err = provider.Resolve(path)
+	require.EqualError(t, err, fmt.Sprintf("file %s is not allowed", path))
+}
+
+func TestValidateSecretReferences(t *testing.T) {
+	store := testSecretStore(t)
+
+	destination := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	destination.Spec.Parameters[1].Value = "${bindplane:env:CABIN_SECRET_KEY}"
+
+	// without providers, references are not checked
+	require.NoError(t, destination.ValidateWithStore(store))
+
+	providers := testSecretProviders()
+	require.NoError(t, destination.ValidateWithStore(WithSecretProviders(store, providers)))
+
+	destination.Spec.Parameters[1].Value = "${bindplane:env:CABIN_MISSING}"
+	err := destination.ValidateWithStore(WithSecretProviders(store, providers))
+	require.EqualError(t, err, "1 error occurred:\n\t* parameter secret_key: unable to resolve ${bindplane:env:CABIN_MISSING}: environment variable CABIN_MISSING is not set\n\n")
+
+	// providers are disabled unless configured
+	destination.Spec.Parameters[1].Value = "${bindplane:env:CABIN_SECRET_KEY}"
+	err = destination.ValidateWithStore(WithSecretProviders(store, NewSecretProviders(nil, nil)))
+	require.EqualError(t, err, "1 error occurred:\n\t* parameter secret_key: secret provider env is not enabled in ${bindplane:env:CABIN_SECRET_KEY}\n\n")
+
+	// references in other parameters are not checked
+	destination.Spec.Parameters[0].Value = "${bindplane:env:CABIN_MISSING}"
+	destination.Spec.Parameters[1].Value = testSecretKey
+	require.NoError(t, destination.ValidateWithStore(WithSecretProviders(store, providers)))
+}
+
+func TestEvalSecretReferences(t *testing.T) {
+	keyring := testSecretKeyring(t, "key")
+	dt := testResource[*DestinationType](t, "destinationtype-cabin-secret.yaml")
+	d := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	d.Spec.Parameters[0].Value = "${bindplane:file:/etc/bindplane/cabin-key}"
+	d.Spec.Parameters[1].Value = "${bindplane:env:CABIN_SECRET_KEY}"
+
+	exporter := func(secrets secretResolver) map[string]any {
+		values := dt.evalOutput(&dt.Spec.Logs, d, nil, secrets, func(e error) {
+			require.NoError(t, e)
+		})
+		require.Len(t, values.Exporters, 1)
+		return values.Exporters[0][otel.NewComponentID("observiq", "observiq-cloud-secret__cabin-secret-logs")].(map[string]any)
+	}
+
+	// only secret parameters are resolved with providers
+	resolved := exporter(secretResolver{keyring: keyring, providers: testSecretProviders()})
+	require.Equal(t, "${bindplane:file:/etc/bindplane/cabin-key}", resolved["endpoint"])
+	require.Equal(t, testSecretKey, resolved["secret_key"])
+
+	// left unresolved without providers
+	unresolved := exporter(secretResolver{keyring: keyring})
+	require.Equal(t, "${bindplane:env:CABIN_SECRET_KEY}", unresolved["secret_key"])
+
+	require.Equal(t, "endpoint=${bindplane:file:/etc/bindplane/cabin-key},secret_key=${bindplane:env:CABIN_SECRET_KEY}", d.PrintableFieldValue("References"))
+}
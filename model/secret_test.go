@@ -0,0 +1,240 @@
+// Copyright  observIQ, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/observiq/bindplane-op/model/otel"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecretKey = "2c088c5e-2afc-483b-be52-e2b657fcff08"
+
+func testSecretStore(t *testing.T) *testResourceStore {
+	store := newTestResourceStore()
+	dt := testResource[*DestinationType](t, "destinationtype-cabin-secret.yaml")
+	store.destinationTypes[dt.Name()] = dt
+	return store
+}
+
+func testSecretKeyring(t *testing.T, current string, previous ...string) *SecretKeyring {
+	keyring, err := NewSecretKeyring(current, previous...)
+	require.NoError(t, err)
+	return keyring
+}
+
+func TestSecretKeyring(t *testing.T) {
+	oldKeyring := testSecretKeyring(t, "old")
+	keyring := testSecretKeyring(t, "new", "old")
+
+	encrypted, err := keyring.Encrypt("password")
+	require.NoError(t, err)
+	require.True(t, IsEncryptedSecret(encrypted))
+	require.NotContains(t, encrypted, "password")
+	require.False(t, keyring.NeedsRotation(encrypted))
+
+	again, err := keyring.Encrypt("password")
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, again, "expect a unique nonce for each encryption")
+
+	decrypted, err := keyring.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "password", decrypted)
+
+	// secrets encrypted with the old key can still be decrypted
+	old, err := oldKeyring.Encrypt("password")
+	require.NoError(t, err)
+	require.True(t, keyring.NeedsRotation(old))
+	decrypted, err = keyring.Decrypt(old)
+	require.NoError(t, err)
+	require.Equal(t, "password", decrypted)
+
+	// but the old keyring doesn't know about the new key
+	_, err = oldKeyring.Decrypt(encrypted)
+	require.ErrorIs(t, err, ErrSecretKeyMissing)
+
+	_, err = keyring.Decrypt("password")
+	require.Error(t, err)
+
+	_, err = NewSecretKeyring("")
+	require.Error(t, err)
+}
+
+func TestEncryptSecrets(t *testing.T) {
+	store := testSecretStore(t)
+	keyring := testSecretKeyring(t, "key")
+
+	destination := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	changed, err := EncryptSecrets(destination, nil, store, keyring)
+	require.NoError(t, err)
+	require.True(t, changed)
+
+	// only the secret parameter is encrypted
+	require.Equal(t, "https://nozzle.app.observiq.com", destination.Spec.Parameters[0].Value)
+	encrypted := destination.Spec.Parameters[1].Value
+	require.True(t, IsEncryptedSecret(encrypted))
+
+	tests := []struct {
+		name          string
+		value         string
+		previous      *Destination
+		expectChanged bool
+		expectValue   any
+		expectError   string
+	}{
+		{
+			name:          "same secret keeps the existing ciphertext",
+			value:         testSecretKey,
+			previous:      destination,
+			expectChanged: true,
+			expectValue:   encrypted,
+		},
+		{
+			name:          "redacted secret keeps the existing secret",
+			value:         RedactedSecret,
+			previous:      destination,
+			expectChanged: true,
+			expectValue:   encrypted,
+		},
+		{
+			name:        "redacted secret without an existing secret",
+			value:       RedactedSecret,
+			expectError: "parameter secret_key is redacted and there is no existing secret to keep",
+		},
+		{
+			name:          "changed secret is encrypted",
+			value:         "new-secret",
+			previous:      destination,
+			expectChanged: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			applied := testResource[*Destination](t, "destination-cabin-secret.yaml")
+			applied.Spec.Parameters[1].Value = test.value
+
+			changed, err := EncryptSecrets(applied, test.previous, store, keyring)
+			if test.expectError != "" {
+				require.ErrorContains(t, err, test.expectError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectChanged, changed)
+
+			value := applied.Spec.Parameters[1].Value
+			require.True(t, IsEncryptedSecret(value))
+			if test.expectValue != nil {
+				require.Equal(t, test.expectValue, value)
+			} else {
+				decrypted, err := keyring.Decrypt(value.(string))
+				require.NoError(t, err)
+				require.Equal(t, test.value, decrypted)
+			}
+		})
+	}
+
+	t.Run("secret reference is not encrypted", func(t *testing.T) {
+		applied := testResource[*Destination](t, "destination-cabin-secret.yaml")
+		applied.Spec.Parameters[1].Value = "${bindplane:env:CABIN_SECRET_KEY}"
+
+		changed, err := EncryptSecrets(applied, destination, store, keyring)
+		require.NoError(t, err)
+		require.False(t, changed)
+		require.Equal(t, "${bindplane:env:CABIN_SECRET_KEY}", applied.Spec.Parameters[1].Value)
+		require.Equal(t, "secret_key=${bindplane:env:CABIN_SECRET_KEY}", applied.PrintableFieldValue("References"))
+		require.Equal(t, applied, RedactSecrets(applied))
+	})
+}
+
+func TestRotateSecrets(t *testing.T) {
+	store := testSecretStore(t)
+	oldKeyring := testSecretKeyring(t, "old")
+	keyring := testSecretKeyring(t, "new", "old")
+
+	destination := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	_, err := EncryptSecrets(destination, nil, store, oldKeyring)
+	require.NoError(t, err)
+	original := destination.Spec.Parameters[1].Value
+
+	rotated, changed, err := RotateSecrets(destination, store, keyring)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, original, destination.Spec.Parameters[1].Value, "original should not be modified")
+
+	value := rotated.Spec.Parameters[1].Value.(string)
+	require.False(t, keyring.NeedsRotation(value))
+	decrypted, err := keyring.Decrypt(value)
+	require.NoError(t, err)
+	require.Equal(t, testSecretKey, decrypted)
+
+	_, changed, err = RotateSecrets(rotated, store, keyring)
+	require.NoError(t, err)
+	require.False(t, changed)
+}
+
+func TestRedactSecrets(t *testing.T) {
+	store := testSecretStore(t)
+	keyring := testSecretKeyring(t, "key")
+
+	destination := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	_, err := EncryptSecrets(destination, nil, store, keyring)
+	require.NoError(t, err)
+	encrypted := destination.Spec.Parameters[1].Value
+
+	redacted := RedactSecrets(destination)
+	require.Equal(t, "https://nozzle.app.observiq.com", redacted.Spec.Parameters[0].Value)
+	require.Equal(t, RedactedSecret, redacted.Spec.Parameters[1].Value)
+	require.Equal(t, encrypted, destination.Spec.Parameters[1].Value, "original should not be modified")
+
+	configuration := &Configuration{
+		ResourceMeta: ResourceMeta{Kind: KindConfiguration, Metadata: Metadata{Name: "secrets"}},
+		Spec: ConfigurationSpec{
+			Destinations: []ResourceConfiguration{
+				{
+					Type:       "observiq-cloud-secret",
+					Parameters: []Parameter{{Name: "secret_key", Value: encrypted}},
+				},
+			},
+		},
+	}
+	redactedConfiguration := RedactSecrets(configuration)
+	require.Equal(t, RedactedSecret, redactedConfiguration.Spec.Destinations[0].Parameters[0].Value)
+	require.Equal(t, encrypted, configuration.Spec.Destinations[0].Parameters[0].Value)
+}
+
+func TestEvalSecretDestination(t *testing.T) {
+	store := testSecretStore(t)
+	keyring := testSecretKeyring(t, "key")
+
+	dt := testResource[*DestinationType](t, "destinationtype-cabin-secret.yaml")
+	d := testResource[*Destination](t, "destination-cabin-secret.yaml")
+	_, err := EncryptSecrets(d, nil, store, keyring)
+	require.NoError(t, err)
+
+	exporter := func(keyring *SecretKeyring) any {
+		values := dt.evalOutput(&dt.Spec.Logs, d, nil, secretResolver{keyring: keyring}, func(e error) {
+			require.NoError(t, e)
+		})
+		require.Len(t, values.Exporters, 1)
+		config := values.Exporters[0][otel.NewComponentID("observiq", "observiq-cloud-secret__cabin-secret-logs")]
+		return config.(map[string]any)["secret_key"]
+	}
+
+	// secrets are only decrypted with a keyring
+	require.Equal(t, testSecretKey, exporter(keyring))
+	require.Equal(t, RedactedSecret, exporter(nil))
+}
@@ -36,19 +36,65 @@ type Selector struct {
 
 // AgentSelector specifies a selector to use to match resources to agents
 type AgentSelector struct {
-	MatchLabels `json:"matchLabels" yaml:"matchLabels" mapstructure:"matchLabels"`
+	MatchLabels      `json:"matchLabels" yaml:"matchLabels" mapstructure:"matchLabels"`
+	MatchExpressions []MatchExpression `json:"matchExpressions,omitempty" yaml:"matchExpressions,omitempty" mapstructure:"matchExpressions"`
 }
 
 // MatchLabels represents the labels used to match Pipelines with Agents.
 type MatchLabels map[string]string
 
-// SelectorFromString takes a string and returns a Selector and error
+// MatchExpressionOperator is the operator used by a MatchExpression to compare the label value with the values
+// specified.
+type MatchExpressionOperator string
+
+const (
+	// MatchExpressionOperatorIn matches if the label value is one of the values specified
+	MatchExpressionOperatorIn MatchExpressionOperator = "In"
+
+	// MatchExpressionOperatorNotIn matches if the label is missing or its value is not one of the values specified
+	MatchExpressionOperatorNotIn MatchExpressionOperator = "NotIn"
+
+	// MatchExpressionOperatorExists matches if the label is present, regardless of its value
+	MatchExpressionOperatorExists MatchExpressionOperator = "Exists"
+
+	// MatchExpressionOperatorDoesNotExist matches if the label is not present
+	MatchExpressionOperatorDoesNotExist MatchExpressionOperator = "DoesNotExist"
+)
+
+// MatchExpression is a label selector requirement, matching the matchExpressions of a Kubernetes label selector. Values
+// must be specified for the In and NotIn operators and must be empty for the Exists and DoesNotExist operators.
+type MatchExpression struct {
+	Key      string                  `json:"key" yaml:"key" mapstructure:"key"`
+	Operator MatchExpressionOperator `json:"operator" yaml:"operator" mapstructure:"operator"`
+	Values   []string                `json:"values,omitempty" yaml:"values,omitempty" mapstructure:"values"`
+}
+
+// requirement converts the MatchExpression to a labels.Requirement, returning an error if the expression is invalid.
+func (e MatchExpression) requirement() (*labels.Requirement, error) {
+	var op selection.Operator
+	switch e.Operator {
+	case MatchExpressionOperatorIn:
+		op = selection.In
+	case MatchExpressionOperatorNotIn:
+		op = selection.NotIn
+	case MatchExpressionOperatorExists:
+		op = selection.Exists
+	case MatchExpressionOperatorDoesNotExist:
+		op = selection.DoesNotExist
+	default:
+		return nil, fmt.Errorf("%s is not a valid operator for matchExpression %s", e.Operator, e.Key)
+	}
+	return labels.NewRequirement(e.Key, op, e.Values)
+}
+
+// SelectorFromString takes a string and returns a Selector and error. In addition to name=value pairs, the string may
+// contain set-based requirements, e.g. "env=prod,region notin (eu),!ephemeral".
 func SelectorFromString(selector string) (Selector, error) {
-	l, err := labels.ConvertSelectorToLabelsMap(selector)
+	s, err := labels.Parse(selector)
 	if err != nil {
 		return EmptySelector(), err
 	}
-	return Selector{l.AsSelector()}, nil
+	return Selector{s}, nil
 }
 
 // SelectorFromMap takes a map[string]string and returns a Selector and error.
@@ -81,16 +127,34 @@ func isResourceForAgent(hasAgentSelector HasAgentSelector, agent *Agent) bool {
 
 // Selector creates a Selector struct from an AgentSelector
 func (s AgentSelector) Selector() Selector {
-	selector, err := SelectorFromMap(s.MatchLabels)
+	selector, err := s.selector()
 	if err != nil {
 		return EmptySelector()
 	}
 	return selector
 }
 
+// selector combines the MatchLabels and MatchExpressions into a single Selector. All of the labels and expressions
+// must match for the Selector to match.
+func (s AgentSelector) selector() (Selector, error) {
+	selector, err := SelectorFromMap(s.MatchLabels)
+	if err != nil || len(s.MatchExpressions) == 0 {
+		return selector, err
+	}
+	requirements := make([]labels.Requirement, 0, len(s.MatchExpressions))
+	for _, expression := range s.MatchExpressions {
+		requirement, err := expression.requirement()
+		if err != nil {
+			return EmptySelector(), err
+		}
+		requirements = append(requirements, *requirement)
+	}
+	return Selector{selector.Add(requirements...)}, nil
+}
+
 // validate ensures that the selector is valid
 func (s AgentSelector) validate(errors validation.Errors) {
-	_, err := SelectorFromMap(s.MatchLabels)
+	_, err := s.selector()
 	if err != nil {
 		errors.Add(fmt.Errorf("selector is invalid: %w", err))
 	}
@@ -110,3 +110,118 @@ func TestMatchLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestAgentSelectorMatchExpressions(t *testing.T) {
+	agentLabels := map[string]string{
+		"env":    "prod",
+		"region": "us",
+	}
+
+	tests := []struct {
+		name        string
+		selector    AgentSelector
+		expectMatch bool
+		expectError bool
+	}{
+		{
+			name: "matchLabels only",
+			selector: AgentSelector{
+				MatchLabels: MatchLabels{"env": "prod"},
+			},
+			expectMatch: true,
+		},
+		{
+			name: "In matches",
+			selector: AgentSelector{
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorIn, Values: []string{"us", "ca"}},
+				},
+			},
+			expectMatch: true,
+		},
+		{
+			name: "NotIn excludes",
+			selector: AgentSelector{
+				MatchLabels: MatchLabels{"env": "prod"},
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorNotIn, Values: []string{"us"}},
+				},
+			},
+			expectMatch: false,
+		},
+		{
+			name: "Exists matches",
+			selector: AgentSelector{
+				MatchExpressions: []MatchExpression{
+					{Key: "env", Operator: MatchExpressionOperatorExists},
+				},
+			},
+			expectMatch: true,
+		},
+		{
+			name: "DoesNotExist excludes",
+			selector: AgentSelector{
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorDoesNotExist},
+				},
+			},
+			expectMatch: false,
+		},
+		{
+			name: "labels and expressions must all match",
+			selector: AgentSelector{
+				MatchLabels: MatchLabels{"env": "dev"},
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorIn, Values: []string{"us"}},
+				},
+			},
+			expectMatch: false,
+		},
+		{
+			name: "In requires values",
+			selector: AgentSelector{
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorIn},
+				},
+			},
+			expectMatch: false,
+			expectError: true,
+		},
+		{
+			name: "Exists does not allow values",
+			selector: AgentSelector{
+				MatchExpressions: []MatchExpression{
+					{Key: "region", Operator: MatchExpressionOperatorExists, Values: []string{"us"}},
+				},
+			},
+			expectMatch: false,
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := NewConfigurationWithSpec("test", ConfigurationSpec{Selector: test.selector})
+			agent := &Agent{ID: "1", Labels: LabelsFromValidatedMap(agentLabels)}
+			require.Equal(t, test.expectMatch, config.IsForAgent(agent))
+
+			err := config.Validate()
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSelectorFromStringExpressions(t *testing.T) {
+	selector := testSelectorFromString(t, "env=prod, region notin (eu), !ephemeral")
+	require.True(t, selector.Matches(LabelsFromValidatedMap(map[string]string{"env": "prod", "region": "us"})))
+	require.False(t, selector.Matches(LabelsFromValidatedMap(map[string]string{"env": "prod", "region": "eu"})))
+	require.False(t, selector.Matches(LabelsFromValidatedMap(map[string]string{"env": "prod", "ephemeral": "true"})))
+
+	labels, complete := selector.MatchLabels()
+	require.False(t, complete)
+	require.Equal(t, MatchLabels{"env": "prod"}, labels)
+}
@@ -108,7 +108,7 @@ func FindSource(source *ResourceConfiguration, defaultName string, store Resourc
 
 // PrintableFieldTitles returns the list of field titles, used for printing a table of resources
 func (s *Source) PrintableFieldTitles() []string {
-	return []string{"Name", "Type", "Description"}
+	return []string{"Name", "Type", "Description", "References"}
 }
 
 // PrintableFieldValue returns the field value for a title, used for printing a table of resources
@@ -122,6 +122,8 @@ func (s *Source) PrintableFieldValue(title string) string {
 		return s.ResourceTypeName()
 	case "Description":
 		return s.Metadata.Description
+	case "References":
+		return parameterReferences(s.Spec.Parameters)
 	default:
 		return "-"
 	}
@@ -32,6 +32,11 @@ func TestConfigurationValidate(t *testing.T) {
 			expectValidateWithStoreError: "1 error occurred:\n\t* configuration must specify raw or sources and destinations\n\n",
 		},
 
+		{
+			testfile:                     "configuration-raw-processors.yaml",
+			expectValidateError:          "1 error occurred:\n\t* configuration with raw cannot specify processors\n\n",
+			expectValidateWithStoreError: "2 errors occurred:\n\t* configuration with raw cannot specify processors\n\t* unknown ProcessorType: resource-attribute-transposer\n\n",
+		},
 		{
 			testfile:                     "configuration-raw-malformed.yaml",
 			expectValidateError:          "1 error occurred:\n\t* unable to parse spec.raw as yaml: yaml: line 29: did not find expected key\n\n",
@@ -63,6 +68,26 @@ func TestConfigurationValidate(t *testing.T) {
 			expectValidateError:          "1 error occurred:\n\t* selector is invalid: 1 error occurred:\n\t* bad key is not a valid label name: name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')\n\n\n\n",
 			expectValidateWithStoreError: "1 error occurred:\n\t* selector is invalid: 1 error occurred:\n\t* bad key is not a valid label name: name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')\n\n\n\n",
 		},
+		{
+			testfile:                     "configuration-bad-match-expressions.yaml",
+			expectValidateError:          "1 error occurred:\n\t* selector is invalid: Between is not a valid operator for matchExpression region\n\n",
+			expectValidateWithStoreError: "1 error occurred:\n\t* selector is invalid: Between is not a valid operator for matchExpression region\n\n",
+		},
+		{
+			testfile:                     "configuration-bad-routes.yaml",
+			expectValidateError:          "3 errors occurred:\n\t* route 0 references unknown source: source5\n\t* route 0 has invalid telemetry type events, must be one of [logs metrics traces]\n\t* route 1 must specify at least one source and one destination\n\n",
+			expectValidateWithStoreError: "3 errors occurred:\n\t* route 0 references unknown source: source5\n\t* route 0 has invalid telemetry type events, must be one of [logs metrics traces]\n\t* route 1 must specify at least one source and one destination\n\n",
+		},
+		{
+			testfile:                     "configuration-duplicate-names.yaml",
+			expectValidateError:          "1 error occurred:\n\t* destination cabin-production-logs is included more than once\n\n",
+			expectValidateWithStoreError: "1 error occurred:\n\t* destination cabin-production-logs is included more than once\n\n",
+		},
+		{
+			testfile:                     "configuration-bad-flow-control.yaml",
+			expectValidateError:          "2 errors occurred:\n\t* Source source0 flowControl samplingPercentage must be between 0 and 100\n\t* Destination cabin-production-logs flowControl samplingPercentage must be between 0 and 100\n\n",
+			expectValidateWithStoreError: "2 errors occurred:\n\t* Source source0 flowControl samplingPercentage must be between 0 and 100\n\t* Destination cabin-production-logs flowControl samplingPercentage must be between 0 and 100\n\n",
+		},
 		{
 			testfile:                     "configuration-ok.yaml",
 			expectValidateError:          "",
@@ -132,10 +157,18 @@ func TestSourceTypeValidate(t *testing.T) {
 			testfile:           "sourcetype-bad-parameter-definitions.yaml",
 			expectErrorMessage: "20 errors occurred:\n\t* missing type for 'no_type'\n\t* missing name for parameter\n\t* invalid name 'bad-name' for parameter\n\t* missing type for 'bad-name'\n\t* invalid type 'bad-type' for 'bad_type'\n\t* parameter of type 'enum' or 'enums' must have 'validValues' specified\n\t* validValues is undefined for parameter of type 'strings'\n\t* default value for 'bad_string_default' must be a string\n\t* default value for 'bad_bool_default' must be a bool\n\t* default value for 'bad_strings_default' must be an array of strings\n\t* default value for 'bad_int_default' must be an integer\n\t* default value for 'bad_int_default_as_float' must be an integer\n\t* default value for 'bad_enum_default' must be one of [1 2 3]\n\t* relevantIf for 'bad_relevant_if_2' must have a name\n\t* relevantIf for 'bad_relevant_if_2' refers to nonexistant parameter 'does_not_exist'\n\t* relevantIf 'string_default_1' for 'bad_relevant_if_2': relevantIf value for 'string_default_1' must be a string\n\t* relevantIf 'string_default_2' for 'bad_relevant_if_2' must have an operator\n\t* relevantIf 'string_default_3' for 'bad_relevant_if_2' must have a value\n\t* relevantIf 'bad_enum_default' for 'bad_relevant_if_2': relevantIf value for 'bad_enum_default' must be one of [1 2 3]\n\t* relevantIf 'bad_bool_default' for 'bad_relevant_if_2': relevantIf value for 'bad_bool_default' must be a bool\n\n",
 		},
+		{
+			testfile:           "sourcetype-bad-constraints.yaml",
+			expectErrorMessage: "11 errors occurred:\n\t* min and max constraint is undefined for parameter of type 'bool'\n\t* min constraint for 'port' is greater than max\n\t* default value for 'port' must be at least 100\n\t* regex constraint for 'pattern' is invalid: error parsing regexp: missing closing ]: `[a-z`\n\t* invalid format 'ip' for 'endpoint'\n\t* minItems constraint is undefined for parameter of type 'string'\n\t* relevantIf 'enabled' for 'bad_relevant_if_operators': unknown operator 'like'\n\t* relevantIf 'pattern' for 'bad_relevant_if_operators': value of operator in must be a list\n\t* relevantIf 'pattern' for 'bad_relevant_if_operators': operator containsAny requires a parameter of type 'strings' or 'enums'\n\t* relevantIf 'pattern' for 'bad_relevant_if_operators': operator greaterThan requires a parameter of type 'int'\n\t* relevantIf 'port' for 'bad_relevant_if_operators': relevantIf value for 'port' must be an integer\n\n",
+		},
 		{
 			testfile:           "sourcetype-bad-templates.yaml",
 			expectErrorMessage: "2 errors occurred:\n\t* template: logs.receivers:6: unexpected \"}\" in operand\n\t* template: logs.processors:1:5: executing \"logs.processors\" at <.not_a_variable>: map has no entry for key \"not_a_variable\"\n\n",
 		},
+		{
+			testfile:           "sourcetype-bad-platforms.yaml",
+			expectErrorMessage: "2 errors occurred:\n\t* platforms.macos is not one of the supportedPlatforms\n\t* template: platforms.windows.logs.receivers:2:17: executing \"platforms.windows.logs.receivers\" at <.not_a_variable>: map has no entry for key \"not_a_variable\"\n\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -179,6 +212,11 @@ func TestSourceValidate(t *testing.T) {
 			expectValidateError:          "",
 			expectValidateWithStoreError: "3 errors occurred:\n\t* parameter value for 'install_log_path' must be a string\n\t* parameter value for 'start_at' must be one of [beginning end]\n\t* parameter unknown not defined in type MacOS\n\n",
 		},
+		{
+			testfile:                     "source-bad-constraints.yaml",
+			expectValidateError:          "",
+			expectValidateWithStoreError: "4 errors occurred:\n\t* parameter value 'localhost' for 'grpc_endpoint' must be a valid host:port: address localhost: missing port in address\n\t* parameter value for 'max_connections' must be at least 1\n\t* parameter value '30' for 'timeout' must be a valid duration: time: missing unit in duration \"30\"\n\t* parameter value for 'include' must have at least 1 items\n\n",
+		},
 		{
 			testfile:                     "source-bad-processor-type.yaml",
 			expectValidateError:          "",
@@ -201,6 +239,10 @@ func TestSourceValidate(t *testing.T) {
 	macos := testResource[*SourceType](t, "sourcetype-macos.yaml")
 	store.sourceTypes[macos.Name()] = macos
 
+	constraints := testResource[*SourceType](t, "sourcetype-constraints.yaml")
+	require.NoError(t, constraints.Validate())
+	store.sourceTypes[constraints.Name()] = constraints
+
 	for _, test := range tests {
 		t.Run(test.testfile, func(t *testing.T) {
 			src := validateResource[*Source](t, test.testfile)
@@ -43,7 +43,12 @@ export const ParameterInput: React.FC<ParamInputProps<any>> = (props) => {
   }
   switch (props.definition.type) {
     case ParameterType.String:
+    case ParameterType.Duration:
       return <StringParamInput classes={classes} {...props} />;
+    case ParameterType.Secret:
+      return <SecretParamInput classes={classes} {...props} />;
+    case ParameterType.Text:
+      return <TextParamInput classes={classes} {...props} />;
     case ParameterType.Strings:
       return <StringsInput classes={classes} {...props} />;
     case ParameterType.Enum:
@@ -54,10 +59,16 @@ export const ParameterInput: React.FC<ParamInputProps<any>> = (props) => {
       return <BoolParamInput classes={classes} {...props} />;
     case ParameterType.Int:
       return <IntParamInput classes={classes} {...props} />;
+    case ParameterType.Float:
+      return <FloatParamInput classes={classes} {...props} />;
+    case ParameterType.Timezone:
+      return <TimezoneParamInput classes={classes} {...props} />;
     case ParameterType.Map:
       return <MapParamInput classes={classes} {...props} />;
     case ParameterType.Yaml:
       return <YamlParamInput classes={classes} {...props} />;
+    case ParameterType.Objects:
+      return <ObjectsParamInput classes={classes} {...props} />;
   }
 };
 
@@ -88,6 +99,61 @@ export const StringParamInput: React.FC<ParamInputProps<string>> = ({
   );
 };
 
+export const SecretParamInput: React.FC<ParamInputProps<string>> = ({
+  classes,
+  definition,
+  value,
+  onValueChange,
+}) => {
+  return (
+    <TextField
+      classes={classes}
+      value={value}
+      onChange={(e: ChangeEvent<HTMLInputElement>) =>
+        isFunction(onValueChange) && onValueChange(e.target.value)
+      }
+      name={definition.name}
+      fullWidth
+      size="small"
+      label={definition.label}
+      helperText={definition.description}
+      required={definition.required}
+      type="password"
+      autoComplete="new-password"
+    />
+  );
+};
+
+export const TextParamInput: React.FC<ParamInputProps<string>> = ({
+  classes,
+  definition,
+  value,
+  onValueChange,
+}) => {
+  return (
+    <TextField
+      classes={classes}
+      value={value ?? ""}
+      onChange={(e: ChangeEvent<HTMLInputElement>) =>
+        isFunction(onValueChange) && onValueChange(e.target.value)
+      }
+      name={definition.name}
+      fullWidth
+      multiline
+      minRows={4}
+      size="small"
+      label={definition.label}
+      helperText={definition.description}
+      required={definition.required}
+      inputProps={{ className: styles.text }}
+      autoComplete="off"
+      autoCorrect="off"
+      autoCapitalize="off"
+      spellCheck="false"
+    />
+  );
+};
+
 export const EnumParamInput: React.FC<ParamInputProps<string>> = ({
   classes,
   definition,
@@ -488,6 +554,153 @@ export const IntParamInput: React.FC<ParamInputProps<number>> = ({
   );
 };
 
+export const FloatParamInput: React.FC<ParamInputProps<number>> = ({
+  classes,
+  definition,
+  value,
+  onValueChange,
+}) => {
+  return (
+    <TextField
+      classes={classes}
+      value={value}
+      onChange={(e: ChangeEvent<HTMLInputElement>) =>
+        isFunction(onValueChange) && onValueChange(Number(e.target.value))
+      }
+      name={definition.name}
+      fullWidth
+      size="small"
+      label={definition.label}
+      helperText={definition.description}
+      required={definition.required}
+      autoComplete="off"
+      autoCorrect="off"
+      autoCapitalize="off"
+      spellCheck="false"
+      type={"number"}
+      inputProps={{ step: "any" }}
+    />
+  );
+};
+
+export const TimezoneParamInput: React.FC<ParamInputProps<string>> = ({
+  classes,
+  definition,
+  value,
+  onValueChange,
+}) => {
+  const options = useMemo(() => timezones(), []);
+
+  const label = definition.required
+    ? `${definition.label} *`
+    : `${definition.label}`;
+
+  return (
+    <Autocomplete
+      options={options}
+      freeSolo
+      classes={classes}
+      value={value ?? ""}
+      onChange={(e, v: string | null) =>
+        isFunction(onValueChange) && onValueChange(v ?? "")
+      }
+      onInputChange={(e, v: string) =>
+        isFunction(onValueChange) && onValueChange(v)
+      }
+      renderInput={(params) => (
+        <TextField
+          {...params}
+          name={definition.name}
+          label={label}
+          size={"small"}
+          helperText={definition.description}
+        />
+      )}
+    />
+  );
+};
+
+export const ObjectsParamInput: React.FC<
+  ParamInputProps<Record<string, any>[]>
+> = ({ definition, value, onValueChange }) => {
+  const objects = value ?? [];
+  const properties = definition.properties ?? [];
+
+  function handleValueChange(newValue: Record<string, any>[]) {
+    isFunction(onValueChange) && onValueChange(newValue);
+  }
+
+  function handlePropertyChange(index: number, name: string, v: any) {
+    const newValue = [...objects];
+    newValue[index] = { ...newValue[index], [name]: v };
+    handleValueChange(newValue);
+  }
+
+  function handleAddObject() {
+    const object: Record<string, any> = {};
+    for (const property of properties) {
+      object[property.name] = property.default;
+    }
+    handleValueChange([...objects, object]);
+  }
+
+  function handleDeleteObject(index: number) {
+    const newValue = [...objects];
+    newValue.splice(index, 1);
+    handleValueChange(newValue);
+  }
+
+  return (
+    <>
+      <label aria-required={definition.required} htmlFor={definition.name}>
+        {definition.label}
+        {definition.required && " *"}
+      </label>
+
+      <FormHelperText>{definition.description}</FormHelperText>
+
+      <Stack spacing={2} marginY={1}>
+        {objects.map((object, index) => (
+          <Stack
+            key={`${definition.name}-${index}`}
+            direction="row"
+            spacing={1}
+            alignItems="flex-start"
+            data-testid={`${definition.name}-${index}-object`}
+          >
+            <Stack spacing={2} className={styles.object}>
+              {properties.map((property) => (
+                <ParameterInput
+                  key={`${definition.name}-${index}-${property.name}`}
+                  definition={property}
+                  value={object[property.name]}
+                  onValueChange={(v) =>
+                    handlePropertyChange(index, property.name, v)
+                  }
+                />
+              ))}
+            </Stack>
+
+            <IconButton
+              size={"small"}
+              onClick={() => handleDeleteObject(index)}
+              data-testid={`${definition.name}-${index}-remove-button`}
+            >
+              <TrashIcon width={18} />
+            </IconButton>
+          </Stack>
+        ))}
+      </Stack>
+
+      <Box marginLeft={1} marginTop={1}>
+        <Button startIcon={<PlusCircleIcon />} onClick={handleAddObject}>
+          New Item
+        </Button>
+      </Box>
+    </>
+  );
+};
+
 interface ResourceNameInputProps
   extends Omit<ParamInputProps<string>, "definition"> {
   existingNames?: string[];
@@ -565,6 +778,16 @@ export function tupleArrayToMap(tuples: Tuple[]): Record<string, string> {
   return mapValue;
 }
 
+// timezones returns the timezone names supported by the browser, if any.
+// Other timezone names can still be entered.
+function timezones(): string[] {
+  const intl = Intl as any;
+  if (isFunction(intl.supportedValuesOf)) {
+    return ["UTC", ...intl.supportedValuesOf("timeZone")];
+  }
+  return ["UTC"];
+}
+
 function addRow(tuples: Tuple[]): Tuple[] {
   const newTuples = [...tuples];
   newTuples.push(["", ""]);
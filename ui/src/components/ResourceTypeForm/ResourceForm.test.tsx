@@ -425,3 +425,104 @@ describe("YamlParameter", () => {
     expect(tree).toMatchSnapshot();
   });
 });
+
+describe("TextParamInput", () => {
+  it("edits multiline text", () => {
+    const textParameter: ParameterDefinition = {
+      required: true,
+      label: "Configuration",
+      description: "description",
+      type: ParameterType.Text,
+      default: "",
+      name: "configuration",
+    };
+
+    let value: string = "";
+    render(
+      <ParameterInput
+        definition={textParameter}
+        value={value}
+        onValueChange={(v) => (value = v)}
+      />
+    );
+
+    fireEvent.change(screen.getByRole("textbox"), {
+      target: { value: "receivers:\n  otlp:" },
+    });
+    expect(value).toEqual("receivers:\n  otlp:");
+  });
+});
+
+describe("ObjectsParamInput", () => {
+  const objectsParameter: ParameterDefinition = {
+    required: false,
+    label: "Headers",
+    description: "description",
+    type: ParameterType.Objects,
+    name: "headers",
+    properties: [
+      {
+        required: true,
+        label: "Name",
+        description: "",
+        type: ParameterType.String,
+        default: "default-name",
+        name: "name",
+      },
+      {
+        required: false,
+        label: "Value",
+        description: "",
+        type: ParameterType.String,
+        default: "",
+        name: "value",
+      },
+    ],
+  };
+
+  it("adds an object with property defaults", () => {
+    let value: Record<string, any>[] = [];
+    render(
+      <ParameterInput
+        definition={objectsParameter}
+        value={value}
+        onValueChange={(v) => (value = v)}
+      />
+    );
+
+    screen.getByText("New Item").click();
+    expect(value).toEqual([{ name: "default-name", value: "" }]);
+  });
+
+  it("edits and removes objects", () => {
+    let value: Record<string, any>[] = [
+      { name: "one", value: "1" },
+      { name: "two", value: "2" },
+    ];
+    const { rerender } = render(
+      <ParameterInput
+        definition={objectsParameter}
+        value={value}
+        onValueChange={(v) => (value = v)}
+      />
+    );
+
+    fireEvent.change(screen.getByDisplayValue("2"), {
+      target: { value: "3" },
+    });
+    expect(value).toEqual([
+      { name: "one", value: "1" },
+      { name: "two", value: "3" },
+    ]);
+
+    rerender(
+      <ParameterInput
+        definition={objectsParameter}
+        value={value}
+        onValueChange={(v) => (value = v)}
+      />
+    );
+    screen.getByTestId("headers-0-remove-button").click();
+    expect(value).toEqual([{ name: "two", value: "3" }]);
+  });
+});
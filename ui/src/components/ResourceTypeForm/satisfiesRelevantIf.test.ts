@@ -0,0 +1,85 @@
+import {
+  ParameterDefinition,
+  ParameterType,
+  RelevantIfOperatorType,
+} from "../../graphql/generated";
+import { satisfiesCondition, satisfiesRelevantIf } from "./satisfiesRelevantIf";
+
+function condition(operator: RelevantIfOperatorType, value: any) {
+  return { name: "other", operator, value };
+}
+
+describe("satisfiesCondition", () => {
+  it("equals", () => {
+    const c = condition(RelevantIfOperatorType.Equals, true);
+    expect(satisfiesCondition(true, c)).toBe(true);
+    expect(satisfiesCondition("true", c)).toBe(true);
+    expect(satisfiesCondition(false, c)).toBe(false);
+    expect(satisfiesCondition(undefined, c)).toBe(false);
+  });
+
+  it("notEquals", () => {
+    const c = condition(RelevantIfOperatorType.NotEquals, "tcp");
+    expect(satisfiesCondition("udp", c)).toBe(true);
+    expect(satisfiesCondition(undefined, c)).toBe(true);
+    expect(satisfiesCondition("tcp", c)).toBe(false);
+  });
+
+  it("in", () => {
+    const c = condition(RelevantIfOperatorType.In, ["tcp", "udp"]);
+    expect(satisfiesCondition("udp", c)).toBe(true);
+    expect(satisfiesCondition("http", c)).toBe(false);
+
+    // the value of in must be a list
+    const notList = condition(RelevantIfOperatorType.In, "udp");
+    expect(satisfiesCondition("udp", notList)).toBe(false);
+  });
+
+  it("notIn", () => {
+    const c = condition(RelevantIfOperatorType.NotIn, ["tcp", "udp"]);
+    expect(satisfiesCondition("http", c)).toBe(true);
+    expect(satisfiesCondition("tcp", c)).toBe(false);
+  });
+
+  it("containsAny", () => {
+    const c = condition(RelevantIfOperatorType.ContainsAny, ["logs", "traces"]);
+    expect(satisfiesCondition(["metrics", "traces"], c)).toBe(true);
+    expect(satisfiesCondition("logs", c)).toBe(true);
+    expect(satisfiesCondition(["metrics"], c)).toBe(false);
+    expect(satisfiesCondition(undefined, c)).toBe(false);
+  });
+
+  it("greaterThan", () => {
+    const c = condition(RelevantIfOperatorType.GreaterThan, 10);
+    expect(satisfiesCondition(11, c)).toBe(true);
+    expect(satisfiesCondition("10.5", c)).toBe(true);
+    expect(satisfiesCondition(10, c)).toBe(false);
+    expect(satisfiesCondition("", c)).toBe(false);
+    expect(satisfiesCondition(undefined, c)).toBe(false);
+  });
+});
+
+describe("satisfiesRelevantIf", () => {
+  const definition: ParameterDefinition = {
+    name: "port",
+    label: "Port",
+    description: "",
+    required: false,
+    type: ParameterType.Int,
+    relevantIf: [
+      condition(RelevantIfOperatorType.In, ["tcp", "udp"]),
+      { name: "enabled", operator: RelevantIfOperatorType.Equals, value: true },
+    ],
+  };
+
+  it("requires every condition", () => {
+    const tcp = { other: "tcp", enabled: true };
+    expect(satisfiesRelevantIf(tcp, definition)).toBe(true);
+
+    const disabled = { other: "tcp", enabled: false };
+    expect(satisfiesRelevantIf(disabled, definition)).toBe(false);
+
+    const http = { other: "http", enabled: true };
+    expect(satisfiesRelevantIf(http, definition)).toBe(false);
+  });
+});
@@ -1,5 +1,9 @@
 import { isEqual } from "lodash";
-import { ParameterDefinition } from "../../graphql/generated";
+import {
+  ParameterDefinition,
+  RelevantIfCondition,
+  RelevantIfOperatorType,
+} from "../../graphql/generated";
 
 export function satisfiesRelevantIf(
   formValues: { [name: string]: any },
@@ -12,12 +16,73 @@ export function satisfiesRelevantIf(
   const relaventIf = definition.relevantIf;
 
   for (const condition of relaventIf) {
-    // TODO (dsvanlani) Right now we only support and expect the "equals" operator
-    // Add a capability to satisfy other operators like "less than" or "greater than".
-    if (!isEqual(formValues[condition.name], condition.value)) {
+    if (!satisfiesCondition(formValues[condition.name], condition)) {
       return false;
     }
   }
 
   return true;
 }
+
+// satisfiesCondition evaluates the condition the same way as the server
+// so that the form shows the parameters that will be used.
+export function satisfiesCondition(
+  value: any,
+  condition: RelevantIfCondition
+): boolean {
+  switch (condition.operator) {
+    case RelevantIfOperatorType.Equals:
+      return relevantIfEquals(value, condition.value);
+    case RelevantIfOperatorType.NotEquals:
+      return !relevantIfEquals(value, condition.value);
+    case RelevantIfOperatorType.In:
+      return relevantIfIn(value, condition.value);
+    case RelevantIfOperatorType.NotIn:
+      return !relevantIfIn(value, condition.value);
+    case RelevantIfOperatorType.ContainsAny:
+      return relevantIfContainsAny(value, condition.value);
+    case RelevantIfOperatorType.GreaterThan:
+      return relevantIfGreaterThan(value, condition.value);
+    default:
+      return false;
+  }
+}
+
+// relevantIfEquals compares the printed values so that 4 and "4" or
+// true and "true" are equal.
+function relevantIfEquals(value: any, expected: any): boolean {
+  if (value == null || expected == null) {
+    return value == null && expected == null;
+  }
+  return isEqual(value, expected) || String(value) === String(expected);
+}
+
+function relevantIfIn(value: any, list: any): boolean {
+  if (!Array.isArray(list)) {
+    return false;
+  }
+  return list.some((v) => relevantIfEquals(value, v));
+}
+
+// relevantIfContainsAny treats a single string as a list with one value.
+function relevantIfContainsAny(value: any, list: any): boolean {
+  const values = Array.isArray(value) ? value : [value];
+  return values.some((v) => typeof v === "string" && relevantIfIn(v, list));
+}
+
+function relevantIfGreaterThan(value: any, threshold: any): boolean {
+  const number = numericValue(value);
+  const thresholdNumber = numericValue(threshold);
+  return number != null && thresholdNumber != null && number > thresholdNumber;
+}
+
+function numericValue(value: any): number | null {
+  if (typeof value === "number") {
+    return value;
+  }
+  if (typeof value === "string" && value.trim() !== "") {
+    const number = Number(value);
+    return isNaN(number) ? null : number;
+  }
+  return null;
+}
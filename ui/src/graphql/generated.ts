@@ -21,6 +21,7 @@ export type Scalars = {
 export type Agent = {
   __typename?: 'Agent';
   architecture?: Maybe<Scalars['String']>;
+  attributes?: Maybe<AgentAttributes>;
   configuration?: Maybe<AgentConfiguration>;
   configurationResource?: Maybe<Configuration>;
   connectedAt?: Maybe<Scalars['Time']>;
@@ -40,6 +41,12 @@ export type Agent = {
   version?: Maybe<Scalars['String']>;
 };
 
+export type AgentAttributes = {
+  __typename?: 'AgentAttributes';
+  identifying?: Maybe<Scalars['Map']>;
+  nonIdentifying?: Maybe<Scalars['Map']>;
+};
+
 export type AgentChange = {
   __typename?: 'AgentChange';
   agent: Agent;
@@ -61,6 +68,7 @@ export type AgentConfiguration = {
 
 export type AgentSelector = {
   __typename?: 'AgentSelector';
+  matchExpressions?: Maybe<Array<MatchExpression>>;
   matchLabels?: Maybe<Scalars['Map']>;
 };
 
@@ -91,11 +99,25 @@ export type ConfigurationChange = {
   eventType: EventType;
 };
 
+export type ConfigurationRemovals = {
+  __typename?: 'ConfigurationRemovals';
+  destinations?: Maybe<Array<Scalars['String']>>;
+  processors?: Maybe<Array<Scalars['String']>>;
+  sources?: Maybe<Array<Scalars['String']>>;
+};
+
 export type ConfigurationSpec = {
   __typename?: 'ConfigurationSpec';
   contentType?: Maybe<Scalars['String']>;
   destinations?: Maybe<Array<ResourceConfiguration>>;
+  extends?: Maybe<Array<Scalars['String']>>;
+  fragment?: Maybe<Scalars['String']>;
+  overrides?: Maybe<Array<ParameterOverride>>;
+  processors?: Maybe<Array<ResourceConfiguration>>;
   raw?: Maybe<Scalars['String']>;
+  remove?: Maybe<ConfigurationRemovals>;
+  replace?: Maybe<Array<InheritedParameters>>;
+  routes?: Maybe<Array<Route>>;
   selector?: Maybe<AgentSelector>;
   sources?: Maybe<Array<ResourceConfiguration>>;
 };
@@ -135,6 +157,26 @@ export enum EventType {
   Update = 'UPDATE'
 }
 
+export type FlowControl = {
+  __typename?: 'FlowControl';
+  paused: Scalars['Boolean'];
+  samplingPercentage?: Maybe<Scalars['Float']>;
+};
+
+export type InheritedParameters = {
+  __typename?: 'InheritedParameters';
+  destination?: Maybe<Scalars['String']>;
+  parameters: Array<Parameter>;
+  source?: Maybe<Scalars['String']>;
+};
+
+export type MatchExpression = {
+  __typename?: 'MatchExpression';
+  key: Scalars['String'];
+  operator: Scalars['String'];
+  values?: Maybe<Array<Scalars['String']>>;
+};
+
 export type Metadata = {
   __typename?: 'Metadata';
   description?: Maybe<Scalars['String']>;
@@ -151,26 +193,52 @@ export type Parameter = {
   value: Scalars['Any'];
 };
 
+export type ParameterConstraints = {
+  __typename?: 'ParameterConstraints';
+  format?: Maybe<Scalars['String']>;
+  max?: Maybe<Scalars['Float']>;
+  min?: Maybe<Scalars['Float']>;
+  minItems: Scalars['Int'];
+  regex?: Maybe<Scalars['String']>;
+};
+
 export type ParameterDefinition = {
   __typename?: 'ParameterDefinition';
+  constraints?: Maybe<ParameterConstraints>;
   default?: Maybe<Scalars['Any']>;
   description: Scalars['String'];
   label: Scalars['String'];
   name: Scalars['String'];
+  properties?: Maybe<Array<ParameterDefinition>>;
   relevantIf?: Maybe<Array<RelevantIfCondition>>;
   required: Scalars['Boolean'];
   type: ParameterType;
   validValues?: Maybe<Array<Scalars['String']>>;
 };
 
+export type ParameterOverride = {
+  __typename?: 'ParameterOverride';
+  agentId?: Maybe<Scalars['String']>;
+  destination?: Maybe<Scalars['String']>;
+  parameters: Array<Parameter>;
+  selector?: Maybe<AgentSelector>;
+  source?: Maybe<Scalars['String']>;
+};
+
 export enum ParameterType {
   Bool = 'bool',
+  Duration = 'duration',
   Enum = 'enum',
   Enums = 'enums',
+  Float = 'float',
   Int = 'int',
   Map = 'map',
+  Objects = 'objects',
+  Secret = 'secret',
   String = 'string',
   Strings = 'strings',
+  Text = 'text',
+  Timezone = 'timezone',
   Yaml = 'yaml'
 }
 
@@ -289,11 +357,17 @@ export type RelevantIfCondition = {
 };
 
 export enum RelevantIfOperatorType {
-  Equals = 'equals'
+  ContainsAny = 'containsAny',
+  Equals = 'equals',
+  GreaterThan = 'greaterThan',
+  In = 'in',
+  NotEquals = 'notEquals',
+  NotIn = 'notIn'
 }
 
 export type ResourceConfiguration = {
   __typename?: 'ResourceConfiguration';
+  flowControl?: Maybe<FlowControl>;
   name?: Maybe<Scalars['String']>;
   parameters?: Maybe<Array<Parameter>>;
   processors?: Maybe<Array<ResourceConfiguration>>;
@@ -308,6 +382,13 @@ export type ResourceTypeSpec = {
   version: Scalars['String'];
 };
 
+export type Route = {
+  __typename?: 'Route';
+  destinations: Array<Scalars['String']>;
+  sources: Array<Scalars['String']>;
+  telemetryTypes?: Maybe<Array<PipelineType>>;
+};
+
 export type Source = {
   __typename?: 'Source';
   apiVersion: Scalars['String'];
@@ -416,14 +497,14 @@ export type DestinationTypeQueryVariables = Exact<{
 }>;
 
 
-export type DestinationTypeQuery = { __typename?: 'Query', destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', displayName?: string | null, name: string, icon?: string | null, description?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } } | null };
+export type DestinationTypeQuery = { __typename?: 'Query', destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', displayName?: string | null, name: string, icon?: string | null, description?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null, properties?: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null }> | null }> } } | null };
 
 export type GetDestinationWithTypeQueryVariables = Exact<{
   name: Scalars['String'];
 }>;
 
 
-export type GetDestinationWithTypeQuery = { __typename?: 'Query', destinationWithType: { __typename?: 'DestinationWithType', destination?: { __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string, id: string, labels?: any | null }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } } | null, destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', name: string, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } } | null } };
+export type GetDestinationWithTypeQuery = { __typename?: 'Query', destinationWithType: { __typename?: 'DestinationWithType', destination?: { __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string, id: string, labels?: any | null }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } } | null, destinationType?: { __typename?: 'DestinationType', metadata: { __typename?: 'Metadata', name: string, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', parameters: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null, properties?: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null }> | null }> } } | null } };
 
 export type SourceTypeQueryVariables = Exact<{
   name: Scalars['String'];
@@ -442,12 +523,12 @@ export type GetConfigurationQuery = { __typename?: 'Query', configuration?: { __
 export type DestinationsAndTypesQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type DestinationsAndTypesQuery = { __typename?: 'Query', destinationTypes: Array<{ __typename?: 'DestinationType', kind: string, apiVersion: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', version: string, supportedPlatforms: Array<string>, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', label: string, type: ParameterType, name: string, description: string, default?: any | null, validValues?: Array<string> | null, required: boolean, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, value: any, operator: RelevantIfOperatorType }> | null }> } }>, destinations: Array<{ __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } }> };
+export type DestinationsAndTypesQuery = { __typename?: 'Query', destinationTypes: Array<{ __typename?: 'DestinationType', kind: string, apiVersion: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', version: string, supportedPlatforms: Array<string>, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', label: string, type: ParameterType, name: string, description: string, default?: any | null, validValues?: Array<string> | null, required: boolean, properties?: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null }> | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, value: any, operator: RelevantIfOperatorType }> | null }> } }>, destinations: Array<{ __typename?: 'Destination', metadata: { __typename?: 'Metadata', name: string }, spec: { __typename?: 'ParameterizedSpec', type: string, parameters?: Array<{ __typename?: 'Parameter', name: string, value: any }> | null } }> };
 
 export type SourceTypesQueryVariables = Exact<{ [key: string]: never; }>;
 
 
-export type SourceTypesQuery = { __typename?: 'Query', sourceTypes: Array<{ __typename?: 'SourceType', apiVersion: string, kind: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', supportedPlatforms: Array<string>, version: string, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', name: string, label: string, description: string, required: boolean, type: ParameterType, validValues?: Array<string> | null, default?: any | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null }> } }> };
+export type SourceTypesQuery = { __typename?: 'Query', sourceTypes: Array<{ __typename?: 'SourceType', apiVersion: string, kind: string, metadata: { __typename?: 'Metadata', id: string, name: string, displayName?: string | null, description?: string | null, icon?: string | null }, spec: { __typename?: 'ResourceTypeSpec', supportedPlatforms: Array<string>, version: string, telemetryTypes: Array<PipelineType>, parameters: Array<{ __typename?: 'ParameterDefinition', name: string, label: string, description: string, required: boolean, type: ParameterType, validValues?: Array<string> | null, default?: any | null, relevantIf?: Array<{ __typename?: 'RelevantIfCondition', name: string, operator: RelevantIfOperatorType, value: any }> | null, properties?: Array<{ __typename?: 'ParameterDefinition', label: string, name: string, description: string, required: boolean, type: ParameterType, default?: any | null, validValues?: Array<string> | null }> | null }> } }> };
 
 export type GetConfigNamesQueryVariables = Exact<{ [key: string]: never; }>;
 
@@ -926,6 +1007,15 @@ export const DestinationTypeDocument = gql`
           value
         }
         validValues
+        properties {
+          label
+          name
+          description
+          required
+          type
+          default
+          validValues
+        }
       }
     }
   }
@@ -995,6 +1085,15 @@ export const GetDestinationWithTypeDocument = gql`
             value
           }
           validValues
+          properties {
+            label
+            name
+            description
+            required
+            type
+            default
+            validValues
+          }
         }
       }
     }
@@ -1151,6 +1250,15 @@ export const DestinationsAndTypesDocument = gql`
         description
         default
         validValues
+        properties {
+          label
+          name
+          description
+          required
+          type
+          default
+          validValues
+        }
         relevantIf {
           name
           value
@@ -1228,6 +1336,15 @@ export const SourceTypesDocument = gql`
         required
         type
         validValues
+        properties {
+          label
+          name
+          description
+          required
+          type
+          default
+          validValues
+        }
         default
       }
       supportedPlatforms
@@ -39,6 +39,15 @@ gql`
             value
           }
           validValues
+          properties {
+            label
+            name
+            description
+            required
+            type
+            default
+            validValues
+          }
         }
       }
     }
@@ -50,6 +50,15 @@ gql`
               value
             }
             validValues
+            properties {
+              label
+              name
+              description
+              required
+              type
+              default
+              validValues
+            }
           }
         }
       }
@@ -42,6 +42,7 @@ const dummySourceType: SourceTypesQuery["sourceTypes"][0] = {
         default: true,
         required: false,
         validValues: null,
+        properties: null,
         relevantIf: null,
       },
       {
@@ -53,6 +54,7 @@ const dummySourceType: SourceTypesQuery["sourceTypes"][0] = {
         default: "default-value",
         required: false,
         validValues: null,
+        properties: null,
         relevantIf: null,
       },
     ],
@@ -86,6 +88,7 @@ const dummyDestinationType: DestinationsAndTypesQuery["destinationTypes"][0] = {
         default: true,
         required: false,
         validValues: null,
+        properties: null,
         relevantIf: null,
       },
       {
@@ -97,6 +100,7 @@ const dummyDestinationType: DestinationsAndTypesQuery["destinationTypes"][0] = {
         default: "default-value",
         required: false,
         validValues: null,
+        properties: null,
         relevantIf: null,
       },
     ],
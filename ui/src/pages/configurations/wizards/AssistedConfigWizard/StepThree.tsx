@@ -66,6 +66,15 @@ gql`
           description
           default
           validValues
+          properties {
+            label
+            name
+            description
+            required
+            type
+            default
+            validValues
+          }
           relevantIf {
             name
             value
@@ -58,6 +58,15 @@ gql`
           required
           type
           validValues
+          properties {
+            label
+            name
+            description
+            required
+            type
+            default
+            validValues
+          }
           default
         }
         supportedPlatforms